@@ -0,0 +1,136 @@
+package app
+
+import (
+	"time"
+
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/alerting"
+	"github.com/talkincode/logsight/common/batchq"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+var alertEngine = alerting.NewEngine()
+
+// LoadAlertRules Reload the enabled alert rules and the active silences from the database
+func (a *Application) LoadAlertRules() {
+	var items []models.AlertRule
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Find(&items).Error; err != nil {
+		log.Errorf("load alert rules error %s", err.Error())
+		return
+	}
+	rules := make([]alerting.Rule, 0, len(items))
+	for _, item := range items {
+		rule, err := a.AlertRuleWithSearch(item)
+		if err != nil {
+			log.Errorf("alert rule error %s", err.Error())
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	for _, err := range alertEngine.SetRules(rules) {
+		log.Errorf("alert rule error %s", err.Error())
+	}
+
+	var silences []models.AlertSilence
+	if err := a.gormDB.Where("end_time > ?", time.Now()).Find(&silences).Error; err != nil {
+		log.Errorf("load alert silences error %s", err.Error())
+		return
+	}
+	active := make([]alerting.Silence, 0, len(silences))
+	for _, s := range silences {
+		active = append(active, alerting.Silence{RuleID: s.RuleID, Hostname: s.Hostname, Start: s.StartTime, End: s.EndTime})
+	}
+	alertEngine.SetSilences(active)
+}
+
+// AlertRuleToAlerting Convert a stored rule to an alerting rule
+func AlertRuleToAlerting(item models.AlertRule) alerting.Rule {
+	rule := alerting.Rule{
+		ID:        item.ID,
+		Name:      item.Name,
+		Type:      item.RuleType,
+		Level:     item.Level,
+		Hostname:  item.Hostname,
+		Appname:   item.Appname,
+		Pattern:   item.Pattern,
+		Severity:  item.Severity,
+		GroupBy:   item.GroupBy,
+		Threshold: item.Threshold,
+		Window:    time.Duration(item.Window) * time.Second,
+		Dedup:     time.Duration(item.Dedup) * time.Second,
+	}
+	if item.RuleType == alerting.TypeSearch {
+		rule.StructuredData, rule.Search = SplitAlertSearch(item.Pattern)
+	}
+	return rule
+}
+
+// AlertStats Alert engine counters
+func (a *Application) AlertStats() alerting.Stats {
+	return alertEngine.Stats()
+}
+
+// initAlertQueue Fired alerts are written to the history table in batches
+func (a *Application) initAlertQueue() {
+	a.alertQueue = batchq.New[*models.AlertHistory](batchq.Config{
+		QueueSize:     10000,
+		BatchSize:     100,
+		FlushInterval: time.Second,
+		Flushers:      1,
+		Policy:        batchq.DropOldest,
+	}, func(items []*models.AlertHistory) error {
+		err := a.gormDB.CreateInBatches(items, len(items)).Error
+		if err != nil {
+			log.Errorf("alert history insert error %s", err.Error())
+		}
+		return err
+	})
+	a.alertQueue.Start()
+}
+
+// SyslogAlertEvent The alert event of a received record, nil without rules. It must be
+// taken before the record is queued, the queue owns the record afterwards
+func (a *Application) SyslogAlertEvent(logdata *models.TsSyslog) *alerting.Event {
+	if alertEngine.Len() == 0 {
+		return nil
+	}
+	return &alerting.Event{
+		Time:           logdata.ReceivedAt,
+		Hostname:       logdata.Hostname,
+		Appname:        logdata.Appname,
+		Severity:       int(logdata.Severity),
+		Message:        logdata.Message,
+		StructuredData: logdata.StructuredData,
+	}
+}
+
+// evaluateAlerts Run the alert rules on an event taken by SyslogAlertEvent. It runs after
+// the record is queued, a failing rule never loses the record
+func (a *Application) evaluateAlerts(event *alerting.Event) {
+	if event == nil {
+		return
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			log.Errorf("alert evaluation error %v", err)
+		}
+	}()
+	alerts := alertEngine.Evaluate(*event)
+	for _, alert := range alerts {
+		a.alertQueue.Push(&models.AlertHistory{
+			ID:       common.UUIDint64(),
+			RuleID:   alert.RuleID,
+			RuleName: alert.RuleName,
+			RuleType: alert.RuleType,
+			Level:    alert.Level,
+			GroupKey: alert.GroupKey,
+			Hostname: alert.Hostname,
+			Appname:  alert.Appname,
+			Count:    alert.Count,
+			Message:  alert.Message,
+			FiredAt:  alert.FiredAt,
+		})
+		a.notifyAlert(alert)
+	}
+}
@@ -12,6 +12,9 @@ import (
 	"github.com/robfig/cron/v3"
 	"github.com/spf13/cast"
 	"github.com/talkincode/logsight/assets"
+	"github.com/talkincode/logsight/common/batchq"
+	"github.com/talkincode/logsight/common/multiline"
+	"github.com/talkincode/logsight/common/pubsub"
 	"github.com/talkincode/logsight/common/zaplog"
 	"github.com/talkincode/logsight/common/zaplog/log"
 	"github.com/talkincode/logsight/config"
@@ -22,9 +25,16 @@ import (
 var app *Application
 
 type Application struct {
-	appConfig *config.AppConfig
-	gormDB    *gorm.DB
-	sched     *cron.Cron
+	appConfig   *config.AppConfig
+	gormDB      *gorm.DB
+	sched       *cron.Cron
+	syslogQueue *batchq.BatchQueue[*models.TsSyslog]
+	syslogHub   *pubsub.Hub[*models.TsSyslog]
+	alertQueue  *batchq.BatchQueue[*models.AlertHistory]
+	// syslogAssembler Multi-line events still buffered are flushed on release
+	syslogAssembler *multiline.Assembler[*models.TsSyslog]
+	// retentionJob Cron entry of the syslog retention manager
+	retentionJob cron.EntryID
 }
 
 func GApp() *Application {
@@ -93,8 +103,20 @@ func (a *Application) Init(cfg *config.AppConfig) {
 		time.Sleep(3 * time.Second)
 		a.checkSuper()
 		a.checkSettings()
+		a.checkReports()
+		a.LoadSyslogClockPolicies()
+		a.LoadSyslogRules()
+		a.LoadAlertRules()
+		a.LoadNotifyChannels()
+		a.LoadReports()
+		a.LoadRadiusNas()
+		a.LoadSnmpDevices()
+		a.RollupRadiusAccounting()
 	}()
 
+	a.initSyslogQueue()
+	a.syslogHub = pubsub.NewHub[*models.TsSyslog]()
+	a.initAlertQueue()
 	a.initJob()
 }
 
@@ -116,6 +138,8 @@ func (a *Application) MigrateDB(track bool) (err error) {
 	} else {
 		log.ErrorIf(a.gormDB.Migrator().AutoMigrate(models.Tables...))
 	}
+	a.setupSyslogFullText()
+	a.removeSyslogRetentionPolicy()
 	return nil
 }
 
@@ -192,5 +216,10 @@ func (a *Application) checkAppVersion() {
 
 func Release() {
 	app.sched.Stop()
+	if app.syslogAssembler != nil {
+		app.syslogAssembler.Stop()
+	}
+	app.syslogQueue.Stop()
+	app.alertQueue.Stop()
 	zaplog.Release()
 }
@@ -0,0 +1,71 @@
+package app
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/talkincode/logsight/common/fts"
+	"github.com/talkincode/logsight/common/zaplog/log"
+)
+
+// syslogFullText Whether ts_syslog.message has a text search index
+var syslogFullText atomic.Bool
+
+// setupSyslogFullText Create the text search index on ts_syslog.message and,
+// when the pg_trgm extension can be installed, a trigram index that speeds up
+// the ILIKE fallback. Without the index searches fall back to ILIKE.
+// On a large ts_syslog the first build takes a while, it runs in the background
+// without blocking inserts and searches use ILIKE until it is ready
+func (a *Application) setupSyslogFullText() {
+	syslogFullText.Store(a.syslogIndexValid("idx_ts_syslog_message_fts"))
+	go func() {
+		err := a.createSyslogIndex("idx_ts_syslog_message_fts", "gin ("+fts.TsVector("message")+")")
+		if err != nil {
+			log.Warnf("syslog full-text index unavailable, search falls back to ILIKE: %s", err.Error())
+		}
+		syslogFullText.Store(a.syslogIndexValid("idx_ts_syslog_message_fts"))
+
+		if a.gormDB.Exec("CREATE EXTENSION IF NOT EXISTS pg_trgm").Error == nil {
+			log.ErrorIf(a.createSyslogIndex("idx_ts_syslog_message_trgm", "gin (message gin_trgm_ops)"))
+		} else {
+			log.Warn("pg_trgm extension unavailable, message ILIKE search is not indexed")
+		}
+	}()
+}
+
+// createSyslogIndex Build an index of ts_syslog unless a valid one exists. A plain table is
+// indexed concurrently, a hypertable one chunk at a time so only that chunk is locked
+func (a *Application) createSyslogIndex(name, using string) error {
+	if a.syslogIndexValid(name) {
+		return nil
+	}
+	// left invalid by an interrupted build
+	if err := a.gormDB.Exec("DROP INDEX IF EXISTS " + name).Error; err != nil {
+		return err
+	}
+	sql := "CREATE INDEX CONCURRENTLY IF NOT EXISTS " + name + " ON ts_syslog USING " + using
+	if a.isHypertable("ts_syslog") {
+		sql = "CREATE INDEX IF NOT EXISTS " + name + " ON ts_syslog USING " + using +
+			" WITH (timescaledb.transaction_per_chunk)"
+	}
+	start := time.Now()
+	log.Infof("building syslog index %s", name)
+	if err := a.gormDB.Exec(sql).Error; err != nil {
+		return err
+	}
+	log.Infof("syslog index %s built in %s", name, time.Since(start).Round(time.Second))
+	return nil
+}
+
+// syslogIndexValid Whether an index of ts_syslog exists and has finished building
+func (a *Application) syslogIndexValid(name string) bool {
+	var valid []bool
+	a.gormDB.Raw("SELECT i.indisvalid FROM pg_index i JOIN pg_class c ON c.oid = i.indexrelid "+
+		"WHERE c.relname = ? AND i.indrelid = 'ts_syslog'::regclass", name).Scan(&valid)
+	return len(valid) > 0 && valid[0]
+}
+
+// SyslogFullTextEnabled Whether message searches use the text search index
+func (a *Application) SyslogFullTextEnabled() bool {
+	return syslogFullText.Load()
+}
@@ -49,3 +49,41 @@ func (a *Application) checkSettings() {
 		}
 	}
 }
+
+// checkReports Create the default reports on a new database: a daily summary
+// of the hosts with the most errors and a weekly RADIUS usage report
+func (a *Application) checkReports() {
+	var count int64
+	a.gormDB.Model(&models.Report{}).Count(&count)
+	if count > 0 {
+		return
+	}
+	a.gormDB.Create(&[]models.Report{
+		{
+			ID:         common.UUIDint64(),
+			Name:       "每日错误主机排行",
+			ReportType: ReportTopHosts,
+			TimeRange:  "24h",
+			Format:     ReportFormatHtml,
+			Schedule:   "0 8 * * *",
+			TopN:       20,
+			KeepRuns:   reportDefaultKeep,
+			Status:     common.ENABLED,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		},
+		{
+			ID:         common.UUIDint64(),
+			Name:       "每周 RADIUS 使用报告",
+			ReportType: ReportRadiusUsage,
+			TimeRange:  "7d",
+			Format:     ReportFormatHtml,
+			Schedule:   "0 8 * * 1",
+			TopN:       50,
+			KeepRuns:   reportDefaultKeep,
+			Status:     common.ENABLED,
+			CreatedAt:  time.Now(),
+			UpdatedAt:  time.Now(),
+		},
+	})
+}
@@ -2,6 +2,7 @@ package app
 
 import (
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/nakabonne/tstorage"
@@ -9,6 +10,7 @@ import (
 	"github.com/shirou/gopsutil/cpu"
 	"github.com/shirou/gopsutil/mem"
 	"github.com/shirou/gopsutil/process"
+	"github.com/talkincode/logsight/common/notify"
 	"github.com/talkincode/logsight/common/zaplog"
 	"github.com/talkincode/logsight/common/zaplog/log"
 	"github.com/talkincode/logsight/models"
@@ -28,14 +30,41 @@ func (a *Application) initJob() {
 		go a.SchedProcessMonitorTask()
 	})
 
+	_, err = a.sched.AddFunc("@every 60s", func() {
+		a.LoadSyslogClockPolicies()
+		a.LoadSyslogRules()
+		a.LoadAlertRules()
+		a.LoadNotifyChannels()
+		a.LoadReports()
+		a.LoadRadiusNas()
+		a.LoadSnmpDevices()
+		alertEngine.Gc(time.Now())
+	})
+
+	// radius sessions that stopped sending interim updates
+	_, err = a.sched.AddFunc("@every 60s", a.ReapRadiusSessions)
+	_, err = a.sched.AddFunc("@every 10m", a.RollupRadiusAccounting)
+
+	a.retentionJob, err = a.sched.AddFunc(a.syslogRetentionSchedule(), func() {
+		_ = a.RunSyslogRetention()
+	})
+	if err != nil {
+		log.Errorf("syslog retention schedule %s error %s", a.syslogRetentionSchedule(), err.Error())
+	}
+
 	// database backup
 	_, err = a.sched.AddFunc("@daily", func() {
 		err := app.BackupDatabase()
 		if err != nil {
 			log.Errorf("database backup err %s", err.Error())
+			a.NotifySystemEvent(notify.LevelCritical, "Database backup failed", err.Error())
 		}
 	})
 
+	_, err = a.sched.AddFunc("@hourly", func() {
+		a.CleanExportFiles(time.Hour)
+	})
+
 	_, err = a.sched.AddFunc("@daily", func() {
 		a.gormDB.
 			Where("opt_time < ? ", time.Now().
@@ -49,6 +78,29 @@ func (a *Application) initJob() {
 	a.sched.Start()
 }
 
+// CleanExportFiles Remove the export files older than maxAge the file
+// exports leave in the data directory when a download is interrupted
+func (a *Application) CleanExportFiles(maxAge time.Duration) {
+	dir := a.appConfig.GetDataDir()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		ext := filepath.Ext(entry.Name())
+		if entry.IsDir() || (ext != ".xlsx" && ext != ".csv") {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+		if err = os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			log.Errorf("remove export file %s error %s", entry.Name(), err.Error())
+		}
+	}
+}
+
 // SchedSystemMonitorTask system monitor
 func (a *Application) SchedSystemMonitorTask() {
 	defer func() {
@@ -0,0 +1,188 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/alerting"
+	"github.com/talkincode/logsight/common/notify"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+type notifyEntry struct {
+	name      string
+	updatedAt time.Time
+	channel   *notify.Channel
+}
+
+// notifyChannels Entries are kept across reloads so that rate limits survive
+var notifyChannels = struct {
+	sync.RWMutex
+	items map[int64]*notifyEntry
+}{items: make(map[int64]*notifyEntry)}
+
+// LoadNotifyChannels Reload the enabled notification channels from the database
+func (a *Application) LoadNotifyChannels() {
+	var items []models.NotifyChannel
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Find(&items).Error; err != nil {
+		log.Errorf("load notify channels error %s", err.Error())
+		return
+	}
+	notifyChannels.Lock()
+	defer notifyChannels.Unlock()
+	current := make(map[int64]*notifyEntry, len(items))
+	for _, item := range items {
+		if entry, ok := notifyChannels.items[item.ID]; ok && entry.updatedAt.Equal(item.UpdatedAt) {
+			current[item.ID] = entry
+			continue
+		}
+		channel, err := NewNotifyChannel(item)
+		if err != nil {
+			log.Errorf("notify channel %s error %s", item.Name, err.Error())
+			continue
+		}
+		current[item.ID] = &notifyEntry{name: item.Name, updatedAt: item.UpdatedAt, channel: channel}
+	}
+	notifyChannels.items = current
+}
+
+// NewNotifyChannel Create a channel from its stored configuration
+func NewNotifyChannel(item models.NotifyChannel) (*notify.Channel, error) {
+	headers := make(map[string]string)
+	for _, line := range strings.Split(item.Headers, "\n") {
+		if k, v, ok := strings.Cut(line, ":"); ok && strings.TrimSpace(k) != "" {
+			headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+	}
+	notifier, err := notify.New(notify.Config{
+		Type:         item.ChannelType,
+		Webhook:      item.Webhook,
+		Secret:       item.Secret,
+		Method:       item.Method,
+		Headers:      headers,
+		BodyTemplate: item.BodyTemplate,
+		SmtpHost:     item.SmtpHost,
+		SmtpPort:     item.SmtpPort,
+		SmtpUser:     item.SmtpUser,
+		SmtpPwd:      item.SmtpPwd,
+		SmtpFrom:     item.SmtpFrom,
+		SmtpTls:      item.SmtpTls == 1,
+		Receivers:    splitTrim(item.Receivers),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return notify.NewChannel(notifier, notify.ChannelOptions{
+		RatePerMinute: item.RateLimit,
+		Retries:       item.Retries,
+		Levels:        splitTrim(item.Levels),
+	}), nil
+}
+
+func splitTrim(s string) []string {
+	var result []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+// Notify Send a message to all channels accepting its level, does not block
+func (a *Application) Notify(msg notify.Message) {
+	if msg.Time.IsZero() {
+		msg.Time = time.Now()
+	}
+	notifyChannels.RLock()
+	entries := make([]*notifyEntry, 0, len(notifyChannels.items))
+	for _, entry := range notifyChannels.items {
+		if entry.channel.Accept(msg.Level) {
+			entries = append(entries, entry)
+		}
+	}
+	notifyChannels.RUnlock()
+	for _, entry := range entries {
+		go func(entry *notifyEntry) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute*2)
+			defer cancel()
+			if err := entry.channel.Send(ctx, msg); err != nil {
+				log.Errorf("notify channel %s send error %s", entry.name, err.Error())
+			}
+		}(entry)
+	}
+}
+
+// NotifyChannel Send a message through one enabled channel whatever its levels and wait for the result
+func (a *Application) NotifyChannel(id int64, msg notify.Message) error {
+	notifyChannels.RLock()
+	entry, ok := notifyChannels.items[id]
+	notifyChannels.RUnlock()
+	if !ok {
+		return fmt.Errorf("notify channel %d is not enabled", id)
+	}
+	if msg.Time.IsZero() {
+		msg.Time = time.Now()
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*2)
+	defer cancel()
+	return entry.channel.Send(ctx, msg)
+}
+
+// SendNotifyTest Send a test message through a channel and wait for the result
+func (a *Application) SendNotifyTest(item models.NotifyChannel) error {
+	// retries and rate limits are not wanted when testing
+	item.Retries = 0
+	item.RateLimit = 0
+	item.Levels = ""
+	channel, err := NewNotifyChannel(item)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	return channel.Send(ctx, notify.Message{
+		Title:  "LogSight test message",
+		Text:   fmt.Sprintf("This is a test message from notify channel %s", item.Name),
+		Level:  notify.LevelInfo,
+		Source: a.appConfig.System.Appid,
+		Time:   time.Now(),
+	})
+}
+
+// notifyAlert Send a fired alert to the notification channels
+func (a *Application) notifyAlert(alert alerting.Alert) {
+	fields := map[string]string{
+		"rule":     alert.RuleName,
+		"hostname": alert.Hostname,
+		"appname":  alert.Appname,
+	}
+	if alert.RuleType == alerting.TypeThreshold {
+		fields["count"] = fmt.Sprint(alert.Count)
+		fields["group"] = alert.GroupKey
+	}
+	a.Notify(notify.Message{
+		Title:  fmt.Sprintf("Alert %s on %s", alert.RuleName, alert.Hostname),
+		Text:   alert.Message,
+		Level:  alert.Level,
+		Source: "alert",
+		Time:   alert.FiredAt,
+		Fields: fields,
+	})
+}
+
+// NotifySystemEvent Report a system event such as a failed job
+func (a *Application) NotifySystemEvent(level, title, text string) {
+	a.Notify(notify.Message{
+		Title:  title,
+		Text:   text,
+		Level:  level,
+		Source: a.appConfig.System.Appid,
+		Fields: map[string]string{"appid": a.appConfig.System.Appid},
+	})
+}
@@ -0,0 +1,241 @@
+package app
+
+import (
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/radius"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+// radiusNasSecrets Shared secrets of the enabled NAS devices by source address
+var radiusNasSecrets = struct {
+	sync.RWMutex
+	items map[string][]byte
+}{items: make(map[string][]byte)}
+
+// LoadRadiusNas Reload the NAS devices allowed to send accounting
+func (a *Application) LoadRadiusNas() {
+	var items []models.RadiusNas
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Find(&items).Error; err != nil {
+		log.Errorf("load radius nas error %s", err.Error())
+		return
+	}
+	current := make(map[string][]byte, len(items))
+	for _, item := range items {
+		ip := net.ParseIP(item.Ipaddr)
+		if ip == nil {
+			log.Errorf("radius nas %s has a bad address %s", item.Name, item.Ipaddr)
+			continue
+		}
+		current[ip.String()] = []byte(item.Secret)
+	}
+	radiusNasSecrets.Lock()
+	radiusNasSecrets.items = current
+	radiusNasSecrets.Unlock()
+}
+
+// RadiusNasSecret The shared secret of the NAS sending from addr
+func (a *Application) RadiusNasSecret(addr *net.UDPAddr) ([]byte, bool) {
+	radiusNasSecrets.RLock()
+	defer radiusNasSecrets.RUnlock()
+	secret, ok := radiusNasSecrets.items[addr.IP.String()]
+	return secret, ok
+}
+
+// StartRadiusServer Serve RADIUS accounting on the configured UDP port
+func (a *Application) StartRadiusServer() error {
+	cfg := a.appConfig.Radiusd
+	server := &radius.Server{
+		Secret:  a.RadiusNasSecret,
+		Handler: a.HandleRadiusAccounting,
+		Workers: cfg.Workers,
+		OnError: func(addr net.Addr, err error) {
+			if cfg.Debug {
+				log.Errorf("radius accounting from %s dropped: %s", addr, err.Error())
+			}
+		},
+	}
+	addr := net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.AcctPort))
+	log.Infof("Radius accounting server started on udp %s", addr)
+	return server.ListenAndServe(addr)
+}
+
+// HandleRadiusAccounting Store an accounting request in ts_radius_accounting.
+// A session is one row keyed by username, Acct-Session-Id and NAS address: Start
+// creates it, Interim-Update refreshes the counters and Stop closes it. Requests
+// are retransmitted until answered, so every step is idempotent.
+func (a *Application) HandleRadiusAccounting(req *radius.Request) error {
+	acct := req.Accounting
+	if a.appConfig.Radiusd.Debug {
+		log.Infof("radius accounting %s from %s user %s session %s",
+			radius.StatusName(acct.StatusType), req.Addr, acct.Username, acct.SessionID)
+	}
+	switch acct.StatusType {
+	case radius.StatusStart:
+		return a.radiusSessionStart(req)
+	case radius.StatusInterimUpdate, radius.StatusStop:
+		return a.radiusSessionUpdate(req)
+	case radius.StatusAccountingOn, radius.StatusAccountingOff:
+		return a.radiusNasRestart(req)
+	}
+	// answered so that the NAS does not retransmit what is never stored
+	log.Warnf("radius accounting status %s from %s ignored", radius.StatusName(acct.StatusType), req.Addr)
+	return nil
+}
+
+// radiusNasAddr The NAS-IP-Address, or the source address when it is not sent
+func radiusNasAddr(req *radius.Request) string {
+	if req.Accounting.NasIP != nil {
+		return req.Accounting.NasIP.String()
+	}
+	return req.Addr.IP.String()
+}
+
+func radiusIPString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+// radiusAccountingRecord Map a request onto a session row
+func radiusAccountingRecord(req *radius.Request) *models.TsRadiusAccounting {
+	acct := req.Accounting
+	return &models.TsRadiusAccounting{
+		ID:                  common.UUID(),
+		Username:            acct.Username,
+		AcctSessionId:       acct.SessionID,
+		AcctStartTime:       acct.StartTime(req.Received),
+		NasId:               acct.NasID,
+		NasAddr:             radiusNasAddr(req),
+		NasPaddr:            req.Addr.IP.String(),
+		SessionTimeout:      int(acct.SessionTimeout),
+		FramedIpaddr:        radiusIPString(acct.FramedIP),
+		FramedNetmask:       radiusIPString(acct.FramedNetmask),
+		MacAddr:             acct.MacAddr,
+		NasPort:             int64(acct.NasPort),
+		NasClass:            acct.Class,
+		NasPortId:           acct.NasPortID,
+		NasPortType:         int(acct.NasPortType),
+		ServiceType:         int(acct.ServiceType),
+		AcctSessionTime:     int(acct.SessionTime),
+		AcctInputTotal:      int64(acct.InputOctets),
+		AcctOutputTotal:     int64(acct.OutputOctets),
+		AcctInputPackets:    int(acct.InputPackets),
+		AcctOutputPackets:   int(acct.OutputPackets),
+		AcctInterimInterval: int(acct.InterimInterval),
+		LastUpdate:          time.Now(),
+	}
+}
+
+// radiusStartSlack How far the start time of a session computed from a later request may
+// be off the stored one, packets delayed in the NAS or a NAS clock that was adjusted
+const radiusStartSlack = 24 * time.Hour
+
+// radiusSessionQuery The rows of the session of a request. The bound on the start time
+// lets TimescaleDB skip the chunks of older sessions
+func (a *Application) radiusSessionQuery(req *radius.Request) *gorm.DB {
+	acct := req.Accounting
+	return a.gormDB.Model(&models.TsRadiusAccounting{}).
+		Where("username = ? AND acct_session_id = ? AND nas_addr = ?", acct.Username, acct.SessionID, radiusNasAddr(req)).
+		Where("acct_start_time >= ?", acct.StartTime(req.Received).Add(-radiusStartSlack))
+}
+
+// radiusSessionCount Number of rows of the session of a request
+func (a *Application) radiusSessionCount(req *radius.Request) (int64, error) {
+	var count int64
+	err := a.radiusSessionQuery(req).Count(&count).Error
+	return count, err
+}
+
+func (a *Application) radiusSessionStart(req *radius.Request) error {
+	count, err := a.radiusSessionCount(req)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		// a retransmitted Start, or an Interim-Update got here first
+		return nil
+	}
+	return a.gormDB.Create(radiusAccountingRecord(req)).Error
+}
+
+func (a *Application) radiusSessionUpdate(req *radius.Request) error {
+	acct := req.Accounting
+	values := map[string]interface{}{
+		"acct_session_time":   int(acct.SessionTime),
+		"acct_input_total":    int64(acct.InputOctets),
+		"acct_output_total":   int64(acct.OutputOctets),
+		"acct_input_packets":  int(acct.InputPackets),
+		"acct_output_packets": int(acct.OutputPackets),
+		"nas_paddr":           req.Addr.IP.String(),
+		"last_update":         time.Now(),
+	}
+	if acct.FramedIP != nil {
+		values["framed_ipaddr"] = acct.FramedIP.String()
+	}
+	if acct.MacAddr != "" {
+		values["mac_addr"] = acct.MacAddr
+	}
+	if acct.InterimInterval > 0 {
+		values["acct_interim_interval"] = int(acct.InterimInterval)
+	}
+	query := a.radiusSessionQuery(req)
+	if acct.StatusType == radius.StatusStop {
+		values["acct_stop_time"] = acct.Time(req.Received)
+		values["acct_terminate_cause"] = int(acct.TerminateCause)
+	} else if causes := acct.ReopenCauses(); len(causes) > 0 {
+		// a late Interim-Update must not reopen a stopped session, except one
+		// ReapRadiusSessions closed while the NAS updates were lost
+		query = query.Where("(acct_stop_time = ? OR acct_terminate_cause IN ?)", time.Time{}, causes)
+		values["acct_stop_time"] = time.Time{}
+		values["acct_terminate_cause"] = 0
+	} else {
+		query = query.Where("acct_stop_time = ?", time.Time{})
+	}
+	result := query.Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	count, err := a.radiusSessionCount(req)
+	if err != nil || count > 0 {
+		return err
+	}
+	// the Start was lost, the session started session time ago
+	record := radiusAccountingRecord(req)
+	if acct.StatusType == radius.StatusStop {
+		record.AcctStopTime = acct.Time(req.Received)
+		record.AcctTerminateCause = int(acct.TerminateCause)
+	}
+	return a.gormDB.Create(record).Error
+}
+
+// radiusNasRestart Accounting-On and Accounting-Off mean the NAS has no sessions
+// left, so its open sessions are closed
+func (a *Application) radiusNasRestart(req *radius.Request) error {
+	nasAddr := radiusNasAddr(req)
+	result := a.gormDB.Model(&models.TsRadiusAccounting{}).
+		Where("nas_addr = ? AND acct_stop_time = ?", nasAddr, time.Time{}).
+		Updates(map[string]interface{}{
+			"acct_stop_time":       req.Accounting.Time(req.Received),
+			"acct_terminate_cause": radius.CauseNasReboot,
+			"last_update":          time.Now(),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		log.Infof("radius %s from %s closed %d sessions",
+			radius.StatusName(req.Accounting.StatusType), nasAddr, result.RowsAffected)
+	}
+	return nil
+}
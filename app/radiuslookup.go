@@ -0,0 +1,56 @@
+package app
+
+import (
+	"time"
+
+	"github.com/talkincode/logsight/models"
+)
+
+// radiusLookupNearby How far from the lookup time sessions of the same IP are listed
+const radiusLookupNearby = 24 * time.Hour
+
+// RadiusIPLookup The sessions that held a framed IP at a time
+type RadiusIPLookup struct {
+	IP       string                      `json:"ip"`
+	Time     time.Time                   `json:"time"`
+	Sessions []models.TsRadiusAccounting `json:"sessions"`
+	// Nearby Other sessions of the IP within a day, to spot clock skew of the log source
+	Nearby []models.TsRadiusAccounting `json:"nearby"`
+}
+
+// LookupRadiusIP Who had the framed IP at a time. A session held it from its
+// start until its stop, or while it kept sending interim updates when it has
+// not stopped. Retransmitted or duplicate sessions can yield several matches.
+func (a *Application) LookupRadiusIP(ip string, at time.Time) (*RadiusIPLookup, error) {
+	result := &RadiusIPLookup{IP: ip, Time: at, Sessions: []models.TsRadiusAccounting{}, Nearby: []models.TsRadiusAccounting{}}
+	window, windowArgs := a.radiusStaleWindow()
+	args := []interface{}{at, time.Time{}, at}
+	args = append(args, windowArgs...)
+	err := a.gormDB.
+		Where("framed_ipaddr = ? AND acct_start_time <= ?", ip, at).
+		Where("(acct_stop_time >= ? OR (acct_stop_time = ? AND last_update >= ?::timestamptz - "+window+"))", args...).
+		Order("acct_start_time desc").
+		Find(&result.Sessions).Error
+	if err != nil {
+		return nil, err
+	}
+	matched := make(map[string]bool, len(result.Sessions))
+	for _, item := range result.Sessions {
+		matched[item.ID] = true
+	}
+	var nearby []models.TsRadiusAccounting
+	err = a.gormDB.
+		Where("framed_ipaddr = ? AND acct_start_time <= ? AND last_update >= ?",
+			ip, at.Add(radiusLookupNearby), at.Add(-radiusLookupNearby)).
+		Order("acct_start_time desc").Limit(20).
+		Find(&nearby).Error
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range nearby {
+		if !matched[item.ID] {
+			result.Nearby = append(result.Nearby, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"time"
+
+	"github.com/talkincode/logsight/common/radius"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+// RadiusOnlineCount Number of online sessions of a NAS or a user
+type RadiusOnlineCount struct {
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+// RadiusOnlineStats Online sessions right now
+type RadiusOnlineStats struct {
+	Sessions int64               `json:"sessions"`
+	Users    int64               `json:"users"`
+	Nas      []RadiusOnlineCount `json:"nas"`
+	TopUsers []RadiusOnlineCount `json:"top_users"` // users with the most concurrent sessions
+}
+
+// radiusStaleWindow The SQL interval after which a session without interim updates
+// is stale, StaleMultiple times its interim interval or the configured default
+func (a *Application) radiusStaleWindow() (string, []interface{}) {
+	interval := a.appConfig.Radiusd.InterimInterval
+	if interval <= 0 {
+		interval = 300
+	}
+	multiple := a.appConfig.Radiusd.StaleMultiple
+	if multiple <= 0 {
+		multiple = 3
+	}
+	return "(CASE WHEN acct_interim_interval > 0 THEN acct_interim_interval ELSE ? END) * ? * INTERVAL '1 second'",
+		[]interface{}{interval, multiple}
+}
+
+// RadiusOnlineScope Restrict a ts_radius_accounting query to the online sessions:
+// not stopped and updated within the stale window
+func (a *Application) RadiusOnlineScope(db *gorm.DB) *gorm.DB {
+	window, args := a.radiusStaleWindow()
+	return db.Where("acct_stop_time = ?", time.Time{}).
+		Where("last_update >= NOW() - "+window, args...)
+}
+
+// RadiusOnlineStats Count the online sessions by NAS and by user
+func (a *Application) RadiusOnlineStats(topn int) (*RadiusOnlineStats, error) {
+	online := func() *gorm.DB {
+		return a.RadiusOnlineScope(a.gormDB.Model(&models.TsRadiusAccounting{}))
+	}
+	stats := &RadiusOnlineStats{Nas: []RadiusOnlineCount{}, TopUsers: []RadiusOnlineCount{}}
+	if err := online().Count(&stats.Sessions).Error; err != nil {
+		return nil, err
+	}
+	if err := online().Distinct("username").Count(&stats.Users).Error; err != nil {
+		return nil, err
+	}
+	err := online().
+		Select("nas_addr AS name, COALESCE(MAX(radius_nas.name), '') AS label, count(*) AS count").
+		Joins("LEFT JOIN radius_nas ON radius_nas.ipaddr = ts_radius_accounting.nas_addr").
+		Group("nas_addr").Order("count desc").
+		Scan(&stats.Nas).Error
+	if err != nil {
+		return nil, err
+	}
+	err = online().
+		Select("username AS name, count(*) AS count").
+		Group("username").Order("count desc, username").Limit(topn).
+		Scan(&stats.TopUsers).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ReapRadiusSessions Close the sessions whose NAS stopped sending interim updates,
+// they are stopped at their last update with Lost-Service as terminate cause and
+// reopened by a later Interim-Update
+func (a *Application) ReapRadiusSessions() {
+	window, args := a.radiusStaleWindow()
+	result := a.gormDB.Model(&models.TsRadiusAccounting{}).
+		Where("acct_stop_time = ?", time.Time{}).
+		Where("last_update < NOW() - "+window, args...).
+		Updates(map[string]interface{}{
+			"acct_stop_time":       gorm.Expr("last_update"),
+			"acct_terminate_cause": radius.CauseLostService,
+			// the rollup counts the stop
+			"last_update": time.Now(),
+		})
+	if result.Error != nil {
+		log.Errorf("reap radius sessions error %s", result.Error.Error())
+		return
+	}
+	if result.RowsAffected > 0 {
+		log.Infof("reaped %d stale radius sessions", result.RowsAffected)
+	}
+}
@@ -0,0 +1,348 @@
+package app
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+// RadiusLengthBucket A session length bucket of the duration distribution
+type RadiusLengthBucket struct {
+	Label string
+	Max   int // seconds, 0 for the last open bucket
+}
+
+// RadiusLengthBuckets The buckets of RadiusSessionLength by index
+var RadiusLengthBuckets = []RadiusLengthBucket{
+	{"<5m", 300},
+	{"5-30m", 1800},
+	{"30m-1h", 3600},
+	{"1-4h", 4 * 3600},
+	{"4-12h", 12 * 3600},
+	{"12-24h", 24 * 3600},
+	{">24h", 0},
+}
+
+const (
+	// radiusConcurrencyStep Interval of the concurrent session samples
+	radiusConcurrencyStep = 10 * time.Minute
+	// radiusRollupBackfill How far back the first rollup reaches
+	radiusRollupBackfill = 366 * 24 * time.Hour
+	// radiusConcurrencyBackfill How far back concurrency is sampled, it joins every sample with the sessions
+	radiusConcurrencyBackfill = 31 * 24 * time.Hour
+)
+
+// radiusRollup Sessions updated after the watermark have not been rolled up
+var radiusRollup struct {
+	sync.Mutex
+	watermark time.Time
+}
+
+// radiusRollupOverlap Rows updated by accounting requests still in flight when a rollup
+// starts are not visible to it, the next run looks this far back before its watermark
+const radiusRollupOverlap = time.Minute
+
+// radiusLengthBucketExpr CASE expression mapping acct_session_time onto a bucket index
+func radiusLengthBucketExpr() string {
+	var sb strings.Builder
+	sb.WriteString("CASE")
+	for i, bucket := range RadiusLengthBuckets {
+		if bucket.Max > 0 {
+			sb.WriteString(fmt.Sprintf(" WHEN acct_session_time < %d THEN %d", bucket.Max, i))
+		} else {
+			sb.WriteString(fmt.Sprintf(" ELSE %d", i))
+		}
+	}
+	sb.WriteString(" END")
+	return sb.String()
+}
+
+// RollupRadiusAccounting Add the sessions updated since the last run to the analytics
+// rollups. Each session remembers the counters already added, so a run only adds
+// the increments of the sessions that changed, booked on the day and hour they
+// were reported, and only touches the days and hours they fall in.
+func (a *Application) RollupRadiusAccounting() {
+	if !radiusRollup.TryLock() {
+		return
+	}
+	defer radiusRollup.Unlock()
+
+	started := time.Now()
+	if radiusRollup.watermark.IsZero() {
+		watermark, err := a.radiusRollupWatermark(started)
+		if err != nil {
+			log.Errorf("radius rollup error %s", err.Error())
+			return
+		}
+		radiusRollup.watermark = watermark
+	}
+	var booked int64
+	err := a.gormDB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		booked, err = a.rollupRadiusSince(tx, radiusRollup.watermark, started)
+		return err
+	})
+	if err != nil {
+		log.Errorf("radius rollup error %s", err.Error())
+		return
+	}
+	log.Infof("radius rollup of %d sessions since %s done in %s",
+		booked, radiusRollup.watermark.Format(time.DateTime), time.Since(started))
+	radiusRollup.watermark = started.Add(-radiusRollupOverlap)
+}
+
+// radiusRollupWatermark Where the first run after a start continues. Rollups built
+// before the sessions kept their rolled up counters are rebuilt from a year back
+func (a *Application) radiusRollupWatermark(now time.Time) (time.Time, error) {
+	var last sql.NullTime
+	if err := a.gormDB.Model(&models.RadiusUserDaily{}).Select("max(updated_at)").Row().Scan(&last); err != nil {
+		return time.Time{}, err
+	}
+	if !last.Valid {
+		return now.Add(-radiusRollupBackfill), nil
+	}
+	var recent struct {
+		Total  int64
+		Rolled int64
+	}
+	err := a.gormDB.Model(&models.TsRadiusAccounting{}).
+		Select("count(*) AS total, count(*) FILTER (WHERE rollup_state > 0) AS rolled").
+		Where("last_update >= ?", last.Time.Add(-24*time.Hour)).
+		Scan(&recent).Error
+	if err != nil {
+		return time.Time{}, err
+	}
+	if recent.Total == 0 || recent.Rolled > 0 {
+		return last.Time.Add(-radiusRollupOverlap), nil
+	}
+	log.Info("radius rollups predate the rolled up counters, rebuilding them")
+	for _, table := range []string{"radius_user_daily", "radius_nas_hourly", "radius_session_length"} {
+		if err := a.gormDB.Exec("DELETE FROM " + table).Error; err != nil {
+			return time.Time{}, err
+		}
+	}
+	return now.Add(-radiusRollupBackfill), nil
+}
+
+// rollupRadiusSince Roll up the sessions changed since from, returns their number
+func (a *Application) rollupRadiusSince(tx *gorm.DB, from, now time.Time) (int64, error) {
+	// take the increments of the changed sessions and mark them as rolled up in one statement,
+	// the FROM row has the counters added by the previous run
+	err := tx.Exec("CREATE TEMP TABLE radius_rollup (username text, nas_addr text, " +
+		"acct_start_time timestamptz, acct_stop_time timestamptz, acct_session_time bigint, last_update timestamptz, " +
+		"new_session boolean, new_stop boolean, session_time bigint, input_total bigint, output_total bigint) " +
+		"ON COMMIT DROP").Error
+	if err != nil {
+		return 0, err
+	}
+	result := tx.Exec("WITH booked AS (UPDATE ts_radius_accounting a SET "+
+		"rollup_session_time = a.acct_session_time, rollup_input_total = a.acct_input_total, "+
+		"rollup_output_total = a.acct_output_total, "+
+		"rollup_state = CASE WHEN a.acct_stop_time <> ? THEN 2 ELSE 1 END "+
+		"FROM ts_radius_accounting o "+
+		"WHERE o.id = a.id AND o.acct_start_time = a.acct_start_time AND a.acct_start_time >= ? "+
+		"AND o.last_update >= ? AND o.acct_start_time >= ? "+
+		"AND (o.rollup_state = 0 OR (o.acct_stop_time <> ? AND o.rollup_state < 2) "+
+		"OR o.acct_session_time <> o.rollup_session_time OR o.acct_input_total <> o.rollup_input_total "+
+		"OR o.acct_output_total <> o.rollup_output_total) "+
+		"RETURNING a.username, a.nas_addr, a.acct_start_time, a.acct_stop_time, a.acct_session_time, a.last_update, "+
+		"o.rollup_state = 0, a.acct_stop_time <> ? AND o.rollup_state < 2, "+
+		"greatest(a.acct_session_time - o.rollup_session_time, 0), "+
+		"greatest(a.acct_input_total - o.rollup_input_total, 0), "+
+		"greatest(a.acct_output_total - o.rollup_output_total, 0)) "+
+		"INSERT INTO radius_rollup SELECT * FROM booked",
+		time.Time{}, now.Add(-radiusRollupBackfill), from, now.Add(-radiusRollupBackfill), time.Time{}, time.Time{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	statements := []struct {
+		sql  string
+		args []interface{}
+	}{
+		// sessions in the day they started, traffic in the day it was reported
+		{"INSERT INTO radius_user_daily (day, username, sessions, session_time, input_total, output_total, updated_at) " +
+			"SELECT day, username, sum(sessions), sum(session_time), sum(input_total), sum(output_total), ? FROM (" +
+			"SELECT date_trunc('day', acct_start_time) AS day, username, count(*) AS sessions, " +
+			"0 AS session_time, 0 AS input_total, 0 AS output_total FROM radius_rollup WHERE new_session GROUP BY 1, 2 " +
+			"UNION ALL SELECT date_trunc('day', last_update), username, 0, " +
+			"sum(session_time), sum(input_total), sum(output_total) FROM radius_rollup GROUP BY 1, 2) d GROUP BY 1, 2 " +
+			"ON CONFLICT (day, username) DO UPDATE SET sessions = radius_user_daily.sessions + excluded.sessions, " +
+			"session_time = radius_user_daily.session_time + excluded.session_time, " +
+			"input_total = radius_user_daily.input_total + excluded.input_total, " +
+			"output_total = radius_user_daily.output_total + excluded.output_total, updated_at = excluded.updated_at",
+			[]interface{}{now}},
+
+		{"INSERT INTO radius_nas_hourly (hour, nas_addr, sessions, users, input_total, output_total, updated_at) " +
+			"SELECT hour, nas_addr, sum(sessions), 0, sum(input_total), sum(output_total), ? FROM (" +
+			"SELECT date_trunc('hour', acct_start_time) AS hour, nas_addr, count(*) AS sessions, " +
+			"0 AS input_total, 0 AS output_total FROM radius_rollup WHERE new_session GROUP BY 1, 2 " +
+			"UNION ALL SELECT date_trunc('hour', last_update), nas_addr, 0, " +
+			"sum(input_total), sum(output_total) FROM radius_rollup GROUP BY 1, 2) h GROUP BY 1, 2 " +
+			"ON CONFLICT (hour, nas_addr) DO UPDATE SET sessions = radius_nas_hourly.sessions + excluded.sessions, " +
+			"input_total = radius_nas_hourly.input_total + excluded.input_total, " +
+			"output_total = radius_nas_hourly.output_total + excluded.output_total, updated_at = excluded.updated_at",
+			[]interface{}{now}},
+		// distinct users are not additive, they are counted again in the hours new sessions started in
+		{"UPDATE radius_nas_hourly h SET users = u.users FROM (" +
+			"SELECT n.hour, n.nas_addr, count(DISTINCT a.username) AS users FROM (" +
+			"SELECT DISTINCT date_trunc('hour', acct_start_time) AS hour, nas_addr FROM radius_rollup WHERE new_session) n " +
+			"JOIN ts_radius_accounting a ON a.nas_addr = n.nas_addr " +
+			"AND a.acct_start_time >= n.hour AND a.acct_start_time < n.hour + interval '1 hour' GROUP BY 1, 2) u " +
+			"WHERE h.hour = u.hour AND h.nas_addr = u.nas_addr", nil},
+
+		{"INSERT INTO radius_session_length (day, bucket, sessions, updated_at) " +
+			"SELECT date_trunc('day', acct_start_time), " + radiusLengthBucketExpr() + ", count(*), ? " +
+			"FROM radius_rollup WHERE new_stop GROUP BY 1, 2 " +
+			"ON CONFLICT (day, bucket) DO UPDATE SET sessions = radius_session_length.sessions + excluded.sessions, " +
+			"updated_at = excluded.updated_at", []interface{}{now}},
+	}
+	for _, stmt := range statements {
+		if err := tx.Exec(stmt.sql, stmt.args...).Error; err != nil {
+			return 0, err
+		}
+	}
+
+	// a session is online at a sample from its start until its stop, open
+	// sessions until they miss StaleMultiple interim updates
+	sampleFrom := from.Truncate(radiusConcurrencyStep)
+	if limit := now.Add(-radiusConcurrencyBackfill).Truncate(radiusConcurrencyStep); sampleFrom.Before(limit) {
+		sampleFrom = limit
+	}
+	sampleTo := now.Truncate(radiusConcurrencyStep)
+	if err := tx.Exec("DELETE FROM radius_concurrency WHERE time >= ?", sampleFrom).Error; err != nil {
+		return 0, err
+	}
+	window, windowArgs := a.radiusStaleWindow()
+	args := []interface{}{now, sampleFrom, sampleTo, fmt.Sprintf("%d seconds", int(radiusConcurrencyStep/time.Second)), time.Time{}}
+	args = append(args, windowArgs...)
+	args = append(args, sampleFrom)
+	err = tx.Exec("INSERT INTO radius_concurrency (time, nas_addr, sessions, updated_at) "+
+		"SELECT s.t, a.nas_addr, count(*), ? "+
+		"FROM generate_series(?::timestamptz, ?::timestamptz, ?::interval) AS s(t) "+
+		"JOIN ts_radius_accounting a ON a.acct_start_time <= s.t "+
+		"AND (a.acct_stop_time > s.t OR (a.acct_stop_time = ? AND a.last_update >= s.t - "+window+")) "+
+		"WHERE a.last_update >= ?::timestamptz - interval '1 day' GROUP BY 1, 2", args...).Error
+	return result.RowsAffected, err
+}
+
+// RadiusDailyTraffic Traffic and online time of a day
+type RadiusDailyTraffic struct {
+	Day         time.Time `json:"day"`
+	Sessions    int64     `json:"sessions"`
+	SessionTime int64     `json:"session_time"`
+	InputTotal  int64     `json:"input_total"`
+	OutputTotal int64     `json:"output_total"`
+}
+
+// RadiusTrafficDaily Traffic per day of all users, or of one user when username is set
+func (a *Application) RadiusTrafficDaily(start, end time.Time, username string) ([]RadiusDailyTraffic, error) {
+	query := a.gormDB.Model(&models.RadiusUserDaily{}).
+		Select("day, sum(sessions) AS sessions, sum(session_time) AS session_time, "+
+			"sum(input_total) AS input_total, sum(output_total) AS output_total").
+		Where("day >= date_trunc('day', ?::timestamptz) AND day < ?", start, end)
+	if username != "" {
+		query = query.Where("username = ?", username)
+	}
+	var items []RadiusDailyTraffic
+	err := query.Group("day").Order("day").Scan(&items).Error
+	return items, err
+}
+
+// RadiusUserBytes Traffic of a user
+type RadiusUserBytes struct {
+	Name        string `json:"name"`
+	InputTotal  int64  `json:"input_total"`
+	OutputTotal int64  `json:"output_total"`
+	Bytes       int64  `json:"bytes"`
+}
+
+// RadiusTopUsers The users with the most traffic
+func (a *Application) RadiusTopUsers(start, end time.Time, limit int) ([]RadiusUserBytes, error) {
+	var items []RadiusUserBytes
+	err := a.gormDB.Model(&models.RadiusUserDaily{}).
+		Select("username AS name, sum(input_total) AS input_total, sum(output_total) AS output_total, "+
+			"sum(input_total + output_total) AS bytes").
+		Where("day >= date_trunc('day', ?::timestamptz) AND day < ?", start, end).
+		Group("username").Order("bytes desc").Limit(limit).
+		Scan(&items).Error
+	return items, err
+}
+
+// RadiusConcurrencyPoint Online sessions at a sample
+type RadiusConcurrencyPoint struct {
+	Time     time.Time `json:"time"`
+	Sessions int64     `json:"sessions"`
+}
+
+// RadiusConcurrency Online sessions over time, of all NAS or of one
+func (a *Application) RadiusConcurrency(start, end time.Time, nasAddr string) ([]RadiusConcurrencyPoint, error) {
+	query := a.gormDB.Model(&models.RadiusConcurrency{}).
+		Select("time, sum(sessions) AS sessions").
+		Where("time >= ? AND time < ?", start, end)
+	if nasAddr != "" {
+		query = query.Where("nas_addr = ?", nasAddr)
+	}
+	var items []RadiusConcurrencyPoint
+	err := query.Group("time").Order("time").Scan(&items).Error
+	return items, err
+}
+
+// RadiusLengthCount Stopped sessions in a length bucket
+type RadiusLengthCount struct {
+	Bucket   int   `json:"bucket"`
+	Sessions int64 `json:"sessions"`
+}
+
+// RadiusLengthDistribution Stopped sessions by length, one item per bucket
+func (a *Application) RadiusLengthDistribution(start, end time.Time) ([]RadiusLengthCount, error) {
+	var rows []RadiusLengthCount
+	err := a.gormDB.Model(&models.RadiusSessionLength{}).
+		Select("bucket, sum(sessions) AS sessions").
+		Where("day >= date_trunc('day', ?::timestamptz) AND day < ?", start, end).
+		Group("bucket").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	items := make([]RadiusLengthCount, len(RadiusLengthBuckets))
+	for i := range items {
+		items[i].Bucket = i
+	}
+	for _, row := range rows {
+		if row.Bucket >= 0 && row.Bucket < len(items) {
+			items[row.Bucket].Sessions = row.Sessions
+		}
+	}
+	return items, nil
+}
+
+// RadiusNasLoad Sessions, traffic and peak concurrency of a NAS
+type RadiusNasLoad struct {
+	NasAddr     string `json:"nas_addr"`
+	Label       string `json:"label"`
+	Sessions    int64  `json:"sessions"`
+	InputTotal  int64  `json:"input_total"`
+	OutputTotal int64  `json:"output_total"`
+	Peak        int64  `json:"peak"`
+}
+
+// RadiusNasLoads The load of each NAS, busiest first
+func (a *Application) RadiusNasLoads(start, end time.Time) ([]RadiusNasLoad, error) {
+	var items []RadiusNasLoad
+	err := a.gormDB.Raw("SELECT h.nas_addr, COALESCE(max(n.name), '') AS label, sum(h.sessions) AS sessions, "+
+		"sum(h.input_total) AS input_total, sum(h.output_total) AS output_total, "+
+		"COALESCE((SELECT max(c.sessions) FROM radius_concurrency c "+
+		"WHERE c.nas_addr = h.nas_addr AND c.time >= ? AND c.time < ?), 0) AS peak "+
+		"FROM radius_nas_hourly h LEFT JOIN radius_nas n ON n.ipaddr = h.nas_addr "+
+		"WHERE h.hour >= date_trunc('hour', ?::timestamptz) AND h.hour < ? "+
+		"GROUP BY h.nas_addr ORDER BY sessions DESC", start, end, start, end).
+		Scan(&items).Error
+	return items, err
+}
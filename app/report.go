@@ -0,0 +1,501 @@
+package app
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	sdcommon "github.com/influxdata/go-syslog/v3/common"
+	"github.com/robfig/cron/v3"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/export"
+	"github.com/talkincode/logsight/common/notify"
+	"github.com/talkincode/logsight/common/report"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+const (
+	ReportSyslog      = "syslog"
+	ReportTopHosts    = "syslog_top_hosts"
+	ReportRadiusUsage = "radius_usage"
+
+	ReportFormatHtml = "html"
+
+	ReportTriggerSchedule = "schedule"
+	ReportTriggerManual   = "manual"
+
+	ReportRunSuccess = "success"
+	ReportRunFailure = "failure"
+
+	reportDefaultRange = "24h"
+	reportDefaultKeep  = 30
+	// reportChartItems Items drawn in a ranking chart, the table lists all rows
+	reportChartItems = 30
+	// reportErrorSeverity Top error host reports without a saved search count err and more severe
+	reportErrorSeverity = 3
+)
+
+// SyslogExportColumns The columns of syslog exports and reports
+var SyslogExportColumns = []string{
+	"timestamp", "received_at", "hostname", "source_ip", "appname",
+	"proc_id", "msg_id", "facility", "severity", "message", "structured_data", "tags",
+}
+
+// SyslogExportRow The values of a message in the order of SyslogExportColumns
+func SyslogExportRow(item *models.TsSyslog) []interface{} {
+	return []interface{}{
+		item.Timestamp, item.ReceivedAt, item.Hostname, item.SourceIp, item.Appname,
+		item.ProcID, item.MsgID, item.FacilityMessage, item.SeverityMessage,
+		item.Message, item.StructuredData, item.Tags,
+	}
+}
+
+type reportJob struct {
+	schedule string
+	entry    cron.EntryID
+}
+
+// reportJobs Cron entries of the enabled reports
+var reportJobs = struct {
+	sync.Mutex
+	items map[int64]reportJob
+}{items: make(map[int64]reportJob)}
+
+// reportRunning Reports being generated, a report does not run twice at a time
+var reportRunning sync.Map
+
+// ValidReportFormat Whether format is a report format
+func ValidReportFormat(format string) bool {
+	return format == ReportFormatHtml || export.Supported(format)
+}
+
+// ValidateSchedule Check a cron expression of the job scheduler
+func ValidateSchedule(spec string) error {
+	_, err := cronParser.Parse(spec)
+	return err
+}
+
+// LoadReports Schedule the enabled reports, entries of changed reports are replaced
+func (a *Application) LoadReports() {
+	var items []models.Report
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Find(&items).Error; err != nil {
+		log.Errorf("load reports error %s", err.Error())
+		return
+	}
+	reportJobs.Lock()
+	defer reportJobs.Unlock()
+	current := make(map[int64]reportJob, len(items))
+	for _, item := range items {
+		if job, ok := reportJobs.items[item.ID]; ok && job.schedule == item.Schedule {
+			current[item.ID] = job
+			delete(reportJobs.items, item.ID)
+			continue
+		}
+		id := item.ID
+		entry, err := a.sched.AddFunc(item.Schedule, func() {
+			if _, err := a.RunReport(id, ReportTriggerSchedule); err != nil {
+				log.Errorf("report %d error %s", id, err.Error())
+			}
+		})
+		if err != nil {
+			log.Errorf("report %s schedule %s error %s", item.Name, item.Schedule, err.Error())
+			continue
+		}
+		current[item.ID] = reportJob{schedule: item.Schedule, entry: entry}
+	}
+	// what is left was removed, disabled or rescheduled
+	for _, job := range reportJobs.items {
+		a.sched.Remove(job.entry)
+	}
+	reportJobs.items = current
+}
+
+// ReportNextRun The next scheduled run of a report, zero when it is not scheduled
+func (a *Application) ReportNextRun(id int64) time.Time {
+	reportJobs.Lock()
+	job, ok := reportJobs.items[id]
+	reportJobs.Unlock()
+	if !ok {
+		return time.Time{}
+	}
+	return a.sched.Entry(job.entry).Next
+}
+
+// ReportRange The period a report covers at now, a saved search brings its own range
+func ReportRange(item models.Report, search *models.SyslogSearch, now time.Time) (time.Time, time.Time) {
+	if search != nil {
+		return SyslogSearchRange(*search, now)
+	}
+	span, err := ParseSearchRange(item.TimeRange)
+	if err != nil {
+		span, _ = ParseSearchRange(reportDefaultRange)
+	}
+	return now.Add(-span), now
+}
+
+// ReportFile The path of the file of a run
+func (a *Application) ReportFile(run models.ReportRun) string {
+	return filepath.Join(a.appConfig.GetReportDir(), filepath.Base(run.Filename))
+}
+
+// RunReport Generate a report, record the run and tell the report's notify channel
+func (a *Application) RunReport(id int64, trigger string) (*models.ReportRun, error) {
+	var item models.Report
+	if err := a.gormDB.Where("id = ?", id).First(&item).Error; err != nil {
+		return nil, err
+	}
+	if _, busy := reportRunning.LoadOrStore(id, true); busy {
+		return nil, fmt.Errorf("report %s is already running", item.Name)
+	}
+	defer reportRunning.Delete(id)
+
+	now := time.Now()
+	run := &models.ReportRun{
+		ID:         common.UUIDint64(),
+		ReportID:   item.ID,
+		ReportName: item.Name,
+		Trigger:    trigger,
+		Status:     ReportRunSuccess,
+		CreatedAt:  now,
+	}
+	err := a.generateReport(item, run, now)
+	run.Duration = time.Since(now).Milliseconds()
+	if err != nil {
+		run.Status = ReportRunFailure
+		run.Message = err.Error()
+		if run.Filename != "" {
+			_ = os.Remove(a.ReportFile(*run))
+			run.Filename = ""
+			run.Size = 0
+		}
+	}
+	if cerr := a.gormDB.Create(run).Error; cerr != nil {
+		log.Errorf("save report run error %s", cerr.Error())
+	}
+	a.gormDB.Model(&models.Report{}).Where("id = ?", item.ID).Update("last_run_at", now)
+	a.pruneReportRuns(item)
+	a.deliverReport(item, run)
+	return run, err
+}
+
+// DeleteReportRun Remove a run and its file
+func (a *Application) DeleteReportRun(run models.ReportRun) error {
+	if run.Filename != "" {
+		if err := os.Remove(a.ReportFile(run)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return a.gormDB.Delete(&models.ReportRun{}, run.ID).Error
+}
+
+// pruneReportRuns Keep the latest KeepRuns runs of a report
+func (a *Application) pruneReportRuns(item models.Report) {
+	keep := item.KeepRuns
+	if keep <= 0 {
+		keep = reportDefaultKeep
+	}
+	var runs []models.ReportRun
+	a.gormDB.Where("report_id = ?", item.ID).Order("created_at desc").Offset(keep).Find(&runs)
+	for _, run := range runs {
+		if err := a.DeleteReportRun(run); err != nil {
+			log.Errorf("delete report run %d error %s", run.ID, err.Error())
+		}
+	}
+}
+
+// deliverReport Tell the report's channel where to download the run
+func (a *Application) deliverReport(item models.Report, run *models.ReportRun) {
+	if item.ChannelID == 0 {
+		return
+	}
+	msg := notify.Message{
+		Title:  fmt.Sprintf("Report %s is ready", item.Name),
+		Text:   fmt.Sprintf("%s - %s", run.StartTime.Format("2006-01-02 15:04"), run.EndTime.Format("2006-01-02 15:04")),
+		Level:  notify.LevelInfo,
+		Source: "report",
+		Time:   run.CreatedAt,
+		Fields: map[string]string{
+			"rows":     fmt.Sprint(run.Rows),
+			"file":     run.Filename,
+			"download": fmt.Sprintf("/admin/reports/runs/download?id=%d", run.ID),
+		},
+	}
+	if run.Status == ReportRunFailure {
+		msg.Title = fmt.Sprintf("Report %s failed", item.Name)
+		msg.Text = run.Message
+		msg.Level = notify.LevelWarning
+		msg.Fields = nil
+	}
+	if err := a.NotifyChannel(item.ChannelID, msg); err != nil {
+		log.Errorf("report %s notify error %s", item.Name, err.Error())
+	}
+}
+
+// generateReport Write the report file of a run
+func (a *Application) generateReport(item models.Report, run *models.ReportRun, now time.Time) (err error) {
+	if !ValidReportFormat(item.Format) {
+		return fmt.Errorf("unsupported report format %s", item.Format)
+	}
+	var search *models.SyslogSearch
+	if item.SearchID != 0 && item.ReportType != ReportRadiusUsage {
+		if search, err = a.GetSyslogSearch(item.SearchID); err != nil {
+			return fmt.Errorf("saved search %d not found", item.SearchID)
+		}
+	}
+	run.StartTime, run.EndTime = ReportRange(item, search, now)
+	run.Filename = fmt.Sprintf("report-%d-%s.%s", item.ID, now.Format("20060102150405"), item.Format)
+
+	filename := a.ReportFile(*run)
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	switch item.ReportType {
+	case ReportSyslog:
+		run.Rows, err = a.writeSyslogReport(w, item, search, run, now)
+	case ReportTopHosts:
+		run.Rows, err = a.writeTopHostsReport(w, item, search, run, now)
+	case ReportRadiusUsage:
+		run.Rows, err = a.writeRadiusUsageReport(w, item, run)
+	default:
+		err = fmt.Errorf("unknown report type %s", item.ReportType)
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if info, serr := os.Stat(filename); serr == nil {
+		run.Size = info.Size()
+	}
+	return err
+}
+
+// reportSyslogQuery The messages a syslog report covers
+func (a *Application) reportSyslogQuery(search *models.SyslogSearch, run *models.ReportRun, now time.Time) (*gorm.DB, error) {
+	tx := a.gormDB.Model(&models.TsSyslog{})
+	if search != nil {
+		return a.ApplySyslogSearch(tx, *search, now)
+	}
+	return tx.Where("timestamp >= ? AND timestamp <= ?", run.StartTime, run.EndTime), nil
+}
+
+func reportDocument(item models.Report, run *models.ReportRun) *report.Document {
+	return &report.Document{
+		Title:       item.Name,
+		Subtitle:    run.StartTime.Format("2006-01-02 15:04") + " - " + run.EndTime.Format("2006-01-02 15:04"),
+		GeneratedAt: run.CreatedAt,
+	}
+}
+
+// rankingChart A bar chart of the first rows of a ranking table
+func rankingChart(table *report.Table, label, value int) *report.Chart {
+	chart := table.ChartOf(report.ChartBar, label, value)
+	if len(chart.Items) > reportChartItems {
+		chart.Items = chart.Items[:reportChartItems]
+	}
+	return chart
+}
+
+// writeTable Write a ranking table, html reports draw it as a bar chart above the table
+func writeTable(w io.Writer, item models.Report, run *models.ReportRun, title string, table *report.Table, chartValue int) (int64, error) {
+	if item.Format != ReportFormatHtml {
+		return table.WriteTo(w, item.Format)
+	}
+	doc := reportDocument(item, run)
+	doc.Sections = append(doc.Sections, report.Section{Title: title, Chart: rankingChart(table, 0, chartValue), Table: table})
+	return int64(len(table.Rows)), doc.WriteHTML(w)
+}
+
+// writeSyslogReport The messages as a file or an html summary of them
+func (a *Application) writeSyslogReport(w io.Writer, item models.Report, search *models.SyslogSearch, run *models.ReportRun, now time.Time) (int64, error) {
+	if item.Format == ReportFormatHtml {
+		return a.writeSyslogSummary(w, item, search, run, now)
+	}
+	query, err := a.reportSyslogQuery(search, run, now)
+	if err != nil {
+		return 0, err
+	}
+	rows, err := query.Order("timestamp desc").Rows()
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+	ew, err := export.New(item.Format, w, SyslogExportColumns)
+	if err != nil {
+		return 0, err
+	}
+	var count int64
+	for rows.Next() {
+		var msg models.TsSyslog
+		if err = query.ScanRows(rows, &msg); err != nil {
+			return count, err
+		}
+		if err = ew.WriteRow(SyslogExportRow(&msg)); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err = rows.Err(); err != nil {
+		return count, err
+	}
+	return count, ew.Close()
+}
+
+// writeSyslogSummary Totals, a timeline and the busiest hosts and apps of the messages
+func (a *Application) writeSyslogSummary(w io.Writer, item models.Report, search *models.SyslogSearch, run *models.ReportRun, now time.Time) (int64, error) {
+	if _, err := a.reportSyslogQuery(search, run, now); err != nil {
+		return 0, err
+	}
+	query := func() *gorm.DB {
+		tx, _ := a.reportSyslogQuery(search, run, now)
+		return tx
+	}
+	var totals struct {
+		Total  int64
+		Hosts  int64
+		Apps   int64
+		Errors int64
+	}
+	err := query().Select("count(*) AS total, count(DISTINCT hostname) AS hosts, count(DISTINCT appname) AS apps, " +
+		"count(*) FILTER (WHERE severity <= 3) AS errors").Scan(&totals).Error
+	if err != nil {
+		return 0, err
+	}
+	doc := reportDocument(item, run)
+	overview := &report.Table{Columns: []string{"messages", "hosts", "apps", "errors"}}
+	overview.AddRow(totals.Total, totals.Hosts, totals.Apps, totals.Errors)
+	doc.Sections = append(doc.Sections, report.Section{Title: "Overview", Table: overview})
+
+	bucket := SyslogBucketSize(run.StartTime, run.EndTime)
+	expr, args := a.syslogBucketExpr(bucket)
+	var buckets []struct {
+		Bucket time.Time
+		Count  int64
+	}
+	err = query().Select(expr+" AS bucket, count(*) AS count", args...).
+		Group("bucket").Order("bucket").Scan(&buckets).Error
+	if err != nil {
+		return 0, err
+	}
+	timeline := &report.Chart{Kind: report.ChartColumn}
+	for _, b := range buckets {
+		timeline.Items = append(timeline.Items, report.ChartItem{Label: b.Bucket.Format("01-02 15:04"), Value: float64(b.Count)})
+	}
+	doc.Sections = append(doc.Sections, report.Section{Title: "Messages per " + bucket.String(), Chart: timeline})
+
+	var severities []SyslogNameCount
+	err = query().Select("severity::text AS name, count(*) AS count").
+		Group("severity").Order("severity").Scan(&severities).Error
+	if err != nil {
+		return 0, err
+	}
+	bySeverity := &report.Table{Columns: []string{"severity", "messages"}}
+	for _, s := range severities {
+		var code uint8
+		name := s.Name
+		if _, err := fmt.Sscan(s.Name, &code); err == nil && sdcommon.SeverityLevelsShort[code] != "" {
+			name = sdcommon.SeverityLevelsShort[code]
+		}
+		bySeverity.AddRow(name, s.Count)
+	}
+	doc.Sections = append(doc.Sections, report.Section{Title: "Severities", Chart: rankingChart(bySeverity, 0, 1), Table: bySeverity})
+
+	for _, field := range []struct{ title, column string }{{"Top hosts", "hostname"}, {"Top apps", "appname"}} {
+		var items []SyslogNameCount
+		err = query().Select(field.column + " AS name, count(*) AS count").
+			Group(field.column).Order("count DESC").Limit(reportTopN(item)).Scan(&items).Error
+		if err != nil {
+			return 0, err
+		}
+		table := &report.Table{Columns: []string{field.column, "messages"}}
+		for _, v := range items {
+			table.AddRow(v.Name, v.Count)
+		}
+		doc.Sections = append(doc.Sections, report.Section{Title: field.title, Chart: rankingChart(table, 0, 1), Table: table})
+	}
+	return totals.Total, doc.WriteHTML(w)
+}
+
+// reportTopN Rows of ranking reports, TopN 0 lists all
+func reportTopN(item models.Report) int {
+	if item.TopN <= 0 {
+		return -1
+	}
+	return item.TopN
+}
+
+// writeTopHostsReport Hosts ranked by messages, without a saved search the err and more severe ones
+func (a *Application) writeTopHostsReport(w io.Writer, item models.Report, search *models.SyslogSearch, run *models.ReportRun, now time.Time) (int64, error) {
+	query, err := a.reportSyslogQuery(search, run, now)
+	if err != nil {
+		return 0, err
+	}
+	if search == nil {
+		query = query.Where("severity <= ?", reportErrorSeverity)
+	}
+	var items []struct {
+		Hostname string
+		Count    int64
+		Apps     int64
+		LastSeen time.Time
+	}
+	err = query.Select("hostname, count(*) AS count, count(DISTINCT appname) AS apps, max(timestamp) AS last_seen").
+		Group("hostname").Order("count DESC").Limit(reportTopN(item)).Scan(&items).Error
+	if err != nil {
+		return 0, err
+	}
+	table := &report.Table{Columns: []string{"hostname", "messages", "apps", "last_seen"}}
+	for _, v := range items {
+		table.AddRow(v.Hostname, v.Count, v.Apps, v.LastSeen)
+	}
+	return writeTable(w, item, run, "Hosts by error messages", table, 1)
+}
+
+// writeRadiusUsageReport Users ranked by traffic in the period, taken from the daily
+// rollups: sessions started and traffic and online time reported in the days of the
+// period, a long session only counts with the part reported in them
+func (a *Application) writeRadiusUsageReport(w io.Writer, item models.Report, run *models.ReportRun) (int64, error) {
+	var items []struct {
+		Username    string
+		Sessions    int64
+		SessionTime int64
+		InputTotal  int64
+		OutputTotal int64
+		LastDay     time.Time
+	}
+	err := a.gormDB.Model(&models.RadiusUserDaily{}).
+		Select("username, sum(sessions) AS sessions, sum(session_time) AS session_time, "+
+			"sum(input_total) AS input_total, sum(output_total) AS output_total, max(day) AS last_day").
+		Where("day >= date_trunc('day', ?::timestamptz) AND day < ?", run.StartTime, run.EndTime).
+		Group("username").Order("sum(input_total + output_total) DESC").
+		Limit(reportTopN(item)).Scan(&items).Error
+	if err != nil {
+		return 0, err
+	}
+	table := &report.Table{Columns: []string{
+		"username", "sessions", "session_hours", "input_mb", "output_mb", "total_mb", "last_day"}}
+	for _, v := range items {
+		table.AddRow(v.Username, v.Sessions, roundTo(float64(v.SessionTime)/3600),
+			megabytes(v.InputTotal), megabytes(v.OutputTotal), megabytes(v.InputTotal+v.OutputTotal),
+			v.LastDay.Format(time.DateOnly))
+	}
+	return writeTable(w, item, run, "Users by traffic (MB)", table, 5)
+}
+
+func megabytes(bytes int64) float64 {
+	return roundTo(float64(bytes) / 1024 / 1024)
+}
+
+// roundTo Round to two decimals
+func roundTo(v float64) float64 {
+	return float64(int64(v*100+0.5)) / 100
+}
@@ -0,0 +1,230 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/notify"
+	"github.com/talkincode/logsight/common/retention"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+// retentionBatchSize Rows removed per DELETE statement, keeps transactions and locks short
+const retentionBatchSize = 10000
+
+// Defaults for a configuration without the retention settings
+const (
+	defaultRetentionDays     = 180
+	defaultRetentionSchedule = "0 30 3 * * *"
+)
+
+// RetentionStatus State of the syslog retention manager
+type RetentionStatus struct {
+	Running      bool      `json:"running"`
+	DefaultDays  int       `json:"default_days"`
+	Schedule     string    `json:"schedule"`
+	NextRunAt    time.Time `json:"next_run_at"`
+	LastRunAt    time.Time `json:"last_run_at"`
+	LastDuration string    `json:"last_duration"`
+	LastDeleted  int64     `json:"last_deleted"`
+	LastError    string    `json:"last_error"`
+	Hypertable   bool      `json:"hypertable"`
+}
+
+// TableSize Disk usage of a table, hypertables include all chunks
+type TableSize struct {
+	Name       string `json:"name"`
+	TotalBytes int64  `json:"total_bytes"`
+	TotalSize  string `json:"total_size"`
+	Rows       int64  `json:"rows"`
+	Hypertable bool   `json:"hypertable"`
+}
+
+var retentionStatus = struct {
+	sync.Mutex
+	RetentionStatus
+}{}
+
+// SyslogRetentionRules The enabled retention rules in evaluation order
+func (a *Application) SyslogRetentionRules() ([]models.SyslogRetention, error) {
+	var items []models.SyslogRetention
+	err := a.gormDB.Where("status = ?", common.ENABLED).Order("sort asc").Find(&items).Error
+	return items, err
+}
+
+// SyslogRetentionToRule Convert a stored rule to a retention rule
+func SyslogRetentionToRule(item models.SyslogRetention) retention.Rule {
+	return retention.Rule{
+		ID:       item.ID,
+		Hostname: item.Hostname,
+		Appname:  item.Appname,
+		Severity: item.Severity,
+		Days:     item.Days,
+	}
+}
+
+// RunSyslogRetention Purge the syslog records that are past their retention
+func (a *Application) RunSyslogRetention() error {
+	retentionStatus.Lock()
+	if retentionStatus.Running {
+		retentionStatus.Unlock()
+		return fmt.Errorf("syslog retention is already running")
+	}
+	retentionStatus.Running = true
+	retentionStatus.Unlock()
+
+	start := time.Now()
+	deleted, err := a.purgeSyslog(start)
+
+	retentionStatus.Lock()
+	retentionStatus.Running = false
+	retentionStatus.LastRunAt = start
+	retentionStatus.LastDuration = time.Since(start).Round(time.Millisecond).String()
+	retentionStatus.LastDeleted = deleted
+	retentionStatus.LastError = ""
+	if err != nil {
+		retentionStatus.LastError = err.Error()
+	}
+	retentionStatus.Unlock()
+
+	if err != nil {
+		log.Errorf("syslog retention error %s", err.Error())
+		a.NotifySystemEvent(notify.LevelWarning, "Syslog retention failed", err.Error())
+		return err
+	}
+	log.Infof("syslog retention removed %d records in %s", deleted, time.Since(start))
+	return nil
+}
+
+func (a *Application) purgeSyslog(now time.Time) (int64, error) {
+	items, err := a.SyslogRetentionRules()
+	if err != nil {
+		return 0, err
+	}
+	rules := make([]retention.Rule, 0, len(items))
+	for _, item := range items {
+		rules = append(rules, SyslogRetentionToRule(item))
+	}
+	defaultDays := a.syslogRetentionDays()
+
+	// whole chunks older than the longest retention are dropped without scanning them
+	if a.isHypertable("ts_syslog") {
+		if days := retention.MaxRetention(rules, defaultDays); days > 0 {
+			err = a.gormDB.Exec("SELECT drop_chunks('ts_syslog', older_than => ?::timestamptz)",
+				now.AddDate(0, 0, -days)).Error
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var total int64
+	for _, step := range retention.Plan(rules, defaultDays, "timestamp", now) {
+		var deleted int64
+		for {
+			// compressed TimescaleDB chunks accept deletes from version 2.11
+			result := a.gormDB.Exec(
+				fmt.Sprintf("DELETE FROM ts_syslog WHERE (id, timestamp) IN "+
+					"(SELECT id, timestamp FROM ts_syslog WHERE %s LIMIT %d)", step.Where, retentionBatchSize),
+				step.Args...)
+			if result.Error != nil {
+				return total + deleted, result.Error
+			}
+			deleted += result.RowsAffected
+			if result.RowsAffected < retentionBatchSize {
+				break
+			}
+		}
+		total += deleted
+		if step.RuleID != 0 {
+			a.gormDB.Model(&models.SyslogRetention{}).Where("id = ?", step.RuleID).Updates(map[string]interface{}{
+				"last_run_at":  now,
+				"last_deleted": deleted,
+			})
+		}
+	}
+	return total, nil
+}
+
+// syslogRetentionDays The default retention in days, 0 when records are kept forever.
+// An unset retention_days uses defaultRetentionDays, a negative one keeps records forever
+func (a *Application) syslogRetentionDays() int {
+	days := a.appConfig.Syslogd.RetentionDays
+	switch {
+	case days == 0:
+		return defaultRetentionDays
+	case days < 0:
+		return 0
+	}
+	return days
+}
+
+// syslogRetentionSchedule The cron spec of the retention manager
+func (a *Application) syslogRetentionSchedule() string {
+	if a.appConfig.Syslogd.RetentionSchedule == "" {
+		return defaultRetentionSchedule
+	}
+	return a.appConfig.Syslogd.RetentionSchedule
+}
+
+// removeSyslogRetentionPolicy Databases created before the retention manager carry a fixed
+// TimescaleDB policy on ts_syslog, which would drop records kept by longer rules
+func (a *Application) removeSyslogRetentionPolicy() {
+	if !a.isHypertable("ts_syslog") {
+		return
+	}
+	err := a.gormDB.Exec("SELECT remove_retention_policy('ts_syslog', if_exists => true)").Error
+	if err != nil {
+		log.Errorf("remove ts_syslog retention policy error %s", err.Error())
+	}
+}
+
+// isHypertable Whether a table is a TimescaleDB hypertable, false on plain Postgres
+func (a *Application) isHypertable(table string) bool {
+	var installed bool
+	if a.gormDB.Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'timescaledb')").
+		Scan(&installed).Error != nil || !installed {
+		return false
+	}
+	var count int64
+	if a.gormDB.Raw("SELECT count(*) FROM timescaledb_information.hypertables WHERE hypertable_name = ?", table).
+		Scan(&count).Error != nil {
+		return false
+	}
+	return count > 0
+}
+
+// GetRetentionStatus State of the retention manager including the next scheduled run
+func (a *Application) GetRetentionStatus() RetentionStatus {
+	retentionStatus.Lock()
+	status := retentionStatus.RetentionStatus
+	retentionStatus.Unlock()
+	status.DefaultDays = a.syslogRetentionDays()
+	status.Schedule = a.syslogRetentionSchedule()
+	if a.retentionJob != 0 {
+		status.NextRunAt = a.sched.Entry(a.retentionJob).Next
+	}
+	status.Hypertable = a.isHypertable("ts_syslog")
+	return status
+}
+
+// TableSizes Disk usage of the log tables
+func (a *Application) TableSizes() []TableSize {
+	var result []TableSize
+	for _, table := range []string{"ts_syslog", "ts_radius_accounting", "alert_history", "sys_opr_log"} {
+		item := TableSize{Name: table, Hypertable: a.isHypertable(table)}
+		if item.Hypertable {
+			a.gormDB.Raw("SELECT hypertable_size(?::regclass)", table).Scan(&item.TotalBytes)
+			a.gormDB.Raw("SELECT approximate_row_count(?::regclass)", table).Scan(&item.Rows)
+		} else {
+			a.gormDB.Raw("SELECT pg_total_relation_size(?::regclass)", table).Scan(&item.TotalBytes)
+			a.gormDB.Raw("SELECT reltuples::bigint FROM pg_class WHERE oid = ?::regclass", table).Scan(&item.Rows)
+		}
+		a.gormDB.Raw("SELECT pg_size_pretty(?::bigint)", item.TotalBytes).Scan(&item.TotalSize)
+		result = append(result, item)
+	}
+	return result
+}
@@ -0,0 +1,374 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/nakabonne/tstorage"
+	"github.com/robfig/cron/v3"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/golimit"
+	"github.com/talkincode/logsight/common/snmp"
+	"github.com/talkincode/logsight/common/zaplog"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+const (
+	SnmpPollSuccess = "success"
+	SnmpPollFailure = "failure"
+
+	// SnmpMinPollInterval Shortest poll interval in seconds, a full poll walks every interface
+	SnmpMinPollInterval = 30
+	// SnmpDefaultPollInterval Poll interval of devices without one
+	SnmpDefaultPollInterval = 300
+
+	// Interface traffic series in bits per second, labelled with device and ifindex
+	SnmpIfInBpsMetric  = "snmp_if_in_bps"
+	SnmpIfOutBpsMetric = "snmp_if_out_bps"
+)
+
+// ErrSnmpPollRunning A poll of the device has not finished yet
+var ErrSnmpPollRunning = errors.New("device poll is running")
+
+type snmpPollJob struct {
+	interval int
+	entry    cron.EntryID
+}
+
+// snmpPoller The scheduled devices, their jobs and the polls in progress. A poll
+// that overruns its interval is skipped rather than started twice.
+var snmpPoller = struct {
+	sync.Mutex
+	jobs    map[int64]snmpPollJob
+	running map[int64]bool
+	limit   *golimit.GoLimit
+}{jobs: make(map[int64]snmpPollJob), running: make(map[int64]bool)}
+
+// SnmpPollInterval The effective poll interval of a device in seconds
+func SnmpPollInterval(interval int) int {
+	if interval <= 0 {
+		return SnmpDefaultPollInterval
+	}
+	if interval < SnmpMinPollInterval {
+		return SnmpMinPollInterval
+	}
+	return interval
+}
+
+// LoadSnmpDevices Schedule a poll job for each enabled device, new devices are
+// polled right away. The trap server learns the device names and credentials
+func (a *Application) LoadSnmpDevices() {
+	if !a.appConfig.Snmp.Enabled && !a.appConfig.Snmp.TrapEnabled {
+		return
+	}
+	var items []models.SnmpDevice
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Find(&items).Error; err != nil {
+		log.Errorf("load snmp devices error %s", err.Error())
+		return
+	}
+	a.loadSnmpTrapDevices(items)
+	if !a.appConfig.Snmp.Enabled {
+		return
+	}
+	snmpPoller.Lock()
+	defer snmpPoller.Unlock()
+	if snmpPoller.limit == nil {
+		workers := a.appConfig.Snmp.Workers
+		if workers <= 0 {
+			workers = 16
+		}
+		snmpPoller.limit = golimit.NewGoLimit(workers)
+	}
+	current := make(map[int64]snmpPollJob, len(items))
+	for _, item := range items {
+		interval := SnmpPollInterval(item.PollInterval)
+		if job, ok := snmpPoller.jobs[item.ID]; ok && job.interval == interval {
+			current[item.ID] = job
+			delete(snmpPoller.jobs, item.ID)
+			continue
+		}
+		id := item.ID
+		entry, err := a.sched.AddFunc(fmt.Sprintf("@every %ds", interval), func() {
+			a.pollSnmpDeviceJob(id)
+		})
+		if err != nil {
+			log.Errorf("snmp device %s schedule error %s", item.Name, err.Error())
+			continue
+		}
+		current[item.ID] = snmpPollJob{interval: interval, entry: entry}
+		go a.pollSnmpDeviceJob(id)
+	}
+	// what is left was removed, disabled or rescheduled
+	for _, job := range snmpPoller.jobs {
+		a.sched.Remove(job.entry)
+	}
+	snmpPoller.jobs = current
+}
+
+func (a *Application) pollSnmpDeviceJob(id int64) {
+	if err := a.PollSnmpDevice(id); err != nil && a.appConfig.Snmp.Debug {
+		log.Errorf("snmp device %d poll error %s", id, err.Error())
+	}
+}
+
+// PollSnmpDevice Collect a device and store the result, at most Workers devices
+// are polled at the same time
+func (a *Application) PollSnmpDevice(id int64) error {
+	snmpPoller.Lock()
+	if snmpPoller.running[id] {
+		snmpPoller.Unlock()
+		return ErrSnmpPollRunning
+	}
+	snmpPoller.running[id] = true
+	limit := snmpPoller.limit
+	snmpPoller.Unlock()
+	defer func() {
+		snmpPoller.Lock()
+		delete(snmpPoller.running, id)
+		snmpPoller.Unlock()
+	}()
+	if limit != nil {
+		limit.Add()
+		defer limit.Done()
+	}
+
+	var device models.SnmpDevice
+	if err := a.gormDB.Where("id = ?", id).First(&device).Error; err != nil {
+		return err
+	}
+	result, err := a.collectSnmpDevice(&device)
+	if err != nil {
+		updateErr := a.gormDB.Model(&models.SnmpDevice{}).Where("id = ?", id).Updates(map[string]interface{}{
+			"poll_status":  SnmpPollFailure,
+			"last_error":   err.Error(),
+			"last_poll_at": time.Now(),
+		}).Error
+		if updateErr != nil {
+			log.Errorf("update snmp device %d error %s", id, updateErr.Error())
+		}
+		return err
+	}
+	return a.saveSnmpPoll(&device, result)
+}
+
+// SnmpDeviceAuth The address and credentials of a device
+func SnmpDeviceAuth(device *models.SnmpDevice) snmp.SnmpAuth {
+	return snmp.SnmpAuth{
+		Ipaddr:        device.Ipaddr,
+		Port:          device.Port,
+		Community:     device.Community,
+		Version:       device.SnmpVersion,
+		SecurityLevel: device.SecurityLevel,
+		Username:      device.Username,
+		AuthProtocol:  device.AuthProtocol,
+		AuthPassword:  device.AuthPassword,
+		PrivProtocol:  device.PrivProtocol,
+		PrivPassword:  device.PrivPassword,
+	}
+}
+
+func (a *Application) collectSnmpDevice(device *models.SnmpDevice) (result *snmp.SnmpDevice, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("snmp collect panic: %v", e)
+		}
+	}()
+	auth := SnmpDeviceAuth(device)
+	client, err := snmp.NewSnmpClient(auth)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	vendorCode := device.VendorCode
+	if vendorCode == "" || vendorCode == snmp.VendorAuto {
+		if vendorCode, err = client.DetectVendor(); err != nil {
+			return nil, err
+		}
+	}
+	result = snmp.NewVendorDevice(vendorCode)
+	result.SnmpAuth = auth
+	if err = client.CollectDevice(result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// saveSnmpPoll Store the result of a poll: the latest state on the device, the
+// interface counters, the sensors and transceivers, and the device metrics in
+// the time series storage
+func (a *Application) saveSnmpPoll(device *models.SnmpDevice, result *snmp.SnmpDevice) error {
+	now := time.Now()
+	sn := result.Sn
+	if sn == "" {
+		sn = result.SoftwareId
+	}
+	values := map[string]interface{}{
+		"system_name":  result.SystemName,
+		"model":        result.Model,
+		"sn":           sn,
+		"uptime":       result.Uptime,
+		"cpu_load":     common.ReplaceNaN(result.CpuLoad, 0),
+		"mem_percent":  common.ReplaceNaN(result.MemPercent, 0),
+		"disk_percent": common.ReplaceNaN(result.DiskPercent, 0),
+		"vendor_name":  result.VendorName,
+		"temperature":  common.ReplaceNaN(result.Temperature, 0),
+		"fan_status":   result.SensorStatus(snmp.SensorFan),
+		"power_status": result.SensorStatus(snmp.SensorPower),
+		"iface_count":  len(result.IfOctetsMap),
+		"poll_status":  SnmpPollSuccess,
+		"last_error":   "",
+		"last_poll_at": now,
+	}
+	var rates map[int64]snmp.IfRate
+	err := a.gormDB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.SnmpDevice{}).Where("id = ?", device.ID).Updates(values).Error; err != nil {
+			return err
+		}
+		if err := saveSnmpHardware(tx, device.ID, result, now); err != nil {
+			return err
+		}
+		// the counters of the last successful poll give the traffic since then
+		var previous []models.SnmpDeviceIface
+		if err := tx.Where("device_id = ?", device.ID).Find(&previous).Error; err != nil {
+			return err
+		}
+		rates = snmp.IfRates(snmpIfCounters(device.Uptime, previous), snmp.IfCounters{
+			Time:   now,
+			Uptime: result.Uptime,
+			Ifaces: result.IfOctetsMap,
+		})
+		// interfaces are replaced as a whole, they come and go with line cards and tunnels
+		if err := tx.Where("device_id = ?", device.ID).Delete(&models.SnmpDeviceIface{}).Error; err != nil {
+			return err
+		}
+		ifaces := make([]models.SnmpDeviceIface, 0, len(result.IfOctetsMap))
+		for index, octets := range result.IfOctetsMap {
+			ifaces = append(ifaces, models.SnmpDeviceIface{
+				DeviceId:    device.ID,
+				IfIndex:     index,
+				Name:        octets.Name,
+				IfType:      octets.Type,
+				InOctets:    int64(octets.InOctets),
+				OutOctets:   int64(octets.OutOctets),
+				CounterBits: octets.CounterBits,
+				InBps:       rates[index].InBps,
+				OutBps:      rates[index].OutBps,
+				UpdatedAt:   now,
+			})
+		}
+		if len(ifaces) == 0 {
+			return nil
+		}
+		return tx.CreateInBatches(ifaces, 500).Error
+	})
+	if err != nil {
+		return err
+	}
+
+	deviceId := fmt.Sprint(device.ID)
+	labels := []tstorage.Label{{Name: "device", Value: deviceId}}
+	var rows []tstorage.Row
+	for metric, value := range map[string]float64{
+		"snmp_cpuload":     result.CpuLoad,
+		"snmp_mempercent":  result.MemPercent,
+		"snmp_diskpercent": result.DiskPercent,
+		"snmp_temperature": result.Temperature,
+	} {
+		if math.IsNaN(value) {
+			continue
+		}
+		rows = append(rows, tstorage.Row{
+			Metric:    metric,
+			Labels:    labels,
+			DataPoint: tstorage.DataPoint{Value: value, Timestamp: now.Unix()},
+		})
+	}
+	for index, rate := range rates {
+		ifLabels := []tstorage.Label{{Name: "device", Value: deviceId}, {Name: "ifindex", Value: fmt.Sprint(index)}}
+		rows = append(rows,
+			tstorage.Row{
+				Metric:    SnmpIfInBpsMetric,
+				Labels:    ifLabels,
+				DataPoint: tstorage.DataPoint{Value: rate.InBps, Timestamp: now.Unix()},
+			},
+			tstorage.Row{
+				Metric:    SnmpIfOutBpsMetric,
+				Labels:    ifLabels,
+				DataPoint: tstorage.DataPoint{Value: rate.OutBps, Timestamp: now.Unix()},
+			})
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	if err = zaplog.TSDB().InsertRows(rows); err != nil {
+		log.Error("add timeseries data error:", err.Error())
+	}
+	return nil
+}
+
+// saveSnmpHardware Replace the sensors and transceivers of a device, like the
+// interfaces they are only those of the last poll
+func saveSnmpHardware(tx *gorm.DB, deviceId int64, result *snmp.SnmpDevice, now time.Time) error {
+	if err := tx.Where("device_id = ?", deviceId).Delete(&models.SnmpDeviceSensor{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("device_id = ?", deviceId).Delete(&models.SnmpDeviceOptical{}).Error; err != nil {
+		return err
+	}
+	if len(result.Sensors) > 0 {
+		sensors := make([]models.SnmpDeviceSensor, 0, len(result.Sensors))
+		for _, sensor := range result.Sensors {
+			sensors = append(sensors, models.SnmpDeviceSensor{
+				DeviceId:  deviceId,
+				Kind:      sensor.Kind,
+				Index:     sensor.Index,
+				Name:      sensor.Name,
+				Value:     sensor.Value,
+				Status:    sensor.Status,
+				UpdatedAt: now,
+			})
+		}
+		if err := tx.CreateInBatches(sensors, 500).Error; err != nil {
+			return err
+		}
+	}
+	if len(result.Opticals) > 0 {
+		opticals := make([]models.SnmpDeviceOptical, 0, len(result.Opticals))
+		for _, optical := range result.Opticals {
+			opticals = append(opticals, models.SnmpDeviceOptical{
+				DeviceId:  deviceId,
+				Index:     optical.Index,
+				Name:      optical.Name,
+				RxPower:   optical.RxPower,
+				TxPower:   optical.TxPower,
+				UpdatedAt: now,
+			})
+		}
+		if err := tx.CreateInBatches(opticals, 500).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// snmpIfCounters The interface counters stored by the last successful poll
+func snmpIfCounters(uptime int64, ifaces []models.SnmpDeviceIface) snmp.IfCounters {
+	counters := snmp.IfCounters{Uptime: uptime, Ifaces: make(map[int64]*snmp.DeviceIfOctets, len(ifaces))}
+	for _, iface := range ifaces {
+		counters.Time = iface.UpdatedAt
+		counters.Ifaces[iface.IfIndex] = &snmp.DeviceIfOctets{
+			Name:        iface.Name,
+			Index:       iface.IfIndex,
+			Type:        iface.IfType,
+			InOctets:    uint64(iface.InOctets),
+			OutOctets:   uint64(iface.OutOctets),
+			CounterBits: iface.CounterBits,
+		}
+	}
+	return counters
+}
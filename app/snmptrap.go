@@ -0,0 +1,225 @@
+package app
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdcommon "github.com/influxdata/go-syslog/v3/common"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/snmp"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+const (
+	// SnmpTrapLogtype The logtype of the syslog records of SNMP traps
+	SnmpTrapLogtype = "snmptrap"
+
+	// snmpTrapFacility Traps are stored as system daemon messages
+	snmpTrapFacility = 3
+)
+
+// snmpTrapSeverities The severity of the well known traps, others are notices
+var snmpTrapSeverities = map[string]int64{
+	".1.3.6.1.6.3.1.1.5.1": 5, // coldStart
+	".1.3.6.1.6.3.1.1.5.2": 5, // warmStart
+	".1.3.6.1.6.3.1.1.5.3": 4, // linkDown
+	".1.3.6.1.6.3.1.1.5.4": 6, // linkUp
+	".1.3.6.1.6.3.1.1.5.5": 4, // authenticationFailure
+}
+
+// snmpTrapDevices The names of the SNMP devices by address, and the running
+// trap server whose v3 users follow the device credentials
+var snmpTrapDevices = struct {
+	sync.RWMutex
+	names  map[string]string
+	auths  []snmp.SnmpAuth
+	server *snmp.TrapServer
+}{names: make(map[string]string)}
+
+// loadSnmpTrapDevices Refresh the device names and v3 credentials used by the trap server
+func (a *Application) loadSnmpTrapDevices(items []models.SnmpDevice) {
+	names := make(map[string]string, len(items))
+	var auths []snmp.SnmpAuth
+	for _, item := range items {
+		names[item.Ipaddr] = item.Name
+		if item.SnmpVersion != snmp.VersionV3 {
+			continue
+		}
+		auth := SnmpDeviceAuth(&item)
+		auth.Ipaddr, auth.Port = "", 0
+		if !containsSnmpAuth(auths, auth) {
+			auths = append(auths, auth)
+		}
+	}
+	snmpTrapDevices.Lock()
+	snmpTrapDevices.names = names
+	snmpTrapDevices.auths = auths
+	server := snmpTrapDevices.server
+	snmpTrapDevices.Unlock()
+	if server != nil {
+		a.setSnmpTrapUsers(server)
+	}
+}
+
+func containsSnmpAuth(auths []snmp.SnmpAuth, auth snmp.SnmpAuth) bool {
+	for _, item := range auths {
+		if item == auth {
+			return true
+		}
+	}
+	return false
+}
+
+// setSnmpTrapUsers The configured trap user and the v3 credentials of the
+// devices, a device with bad credentials is skipped
+func (a *Application) setSnmpTrapUsers(server *snmp.TrapServer) {
+	snmpTrapDevices.RLock()
+	auths := append([]snmp.SnmpAuth(nil), snmpTrapDevices.auths...)
+	snmpTrapDevices.RUnlock()
+	cfg := a.appConfig.Snmp
+	if cfg.TrapUsername != "" {
+		auths = append([]snmp.SnmpAuth{{
+			Version:       snmp.VersionV3,
+			SecurityLevel: cfg.TrapSecurityLevel,
+			Username:      cfg.TrapUsername,
+			AuthProtocol:  cfg.TrapAuthProtocol,
+			AuthPassword:  cfg.TrapAuthPassword,
+			PrivProtocol:  cfg.TrapPrivProtocol,
+			PrivPassword:  cfg.TrapPrivPassword,
+		}}, auths...)
+	}
+	users := make([]snmp.SnmpAuth, 0, len(auths))
+	for _, auth := range auths {
+		if _, _, err := auth.UsmParameters(); err != nil {
+			log.Errorf("snmp trap user %s ignored: %s", auth.Username, err.Error())
+			continue
+		}
+		users = append(users, auth)
+	}
+	if err := server.SetUsers(users); err != nil {
+		log.Errorf("snmp trap users error %s", err.Error())
+	}
+}
+
+// StartSnmpTrapServer Receive SNMP traps and informs on the configured UDP port
+func (a *Application) StartSnmpTrapServer() error {
+	cfg := a.appConfig.Snmp
+	server := &snmp.TrapServer{
+		Handler:   a.HandleSnmpTrap,
+		Community: cfg.TrapCommunity,
+		OnError: func(addr net.Addr, err error) {
+			if cfg.Debug {
+				log.Errorf("snmp trap from %s dropped: %s", addr, err.Error())
+			}
+		},
+	}
+	if cfg.TrapEngineID != "" {
+		engineID, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(cfg.TrapEngineID), "0x"))
+		if err != nil || len(engineID) < 5 || len(engineID) > 32 {
+			return fmt.Errorf("invalid snmp trap engine id %s", cfg.TrapEngineID)
+		}
+		server.EngineID = string(engineID)
+	}
+	a.setSnmpTrapUsers(server)
+	snmpTrapDevices.Lock()
+	snmpTrapDevices.server = server
+	snmpTrapDevices.Unlock()
+
+	addr := net.JoinHostPort(cfg.TrapHost, strconv.Itoa(cfg.TrapPort))
+	log.Infof("SNMP trap server started on udp %s", addr)
+	return server.ListenAndServe(addr)
+}
+
+// HandleSnmpTrap Store a trap as a syslog record, searchable with the syslog.
+// The trap is in the snmptrap structured data element and its varbinds in
+// the varbinds element, keyed by their symbolic names
+func (a *Application) HandleSnmpTrap(trap *snmp.Trap) error {
+	logdata := NewSnmpTrapSyslog(trap)
+	snmpTrapDevices.RLock()
+	if name, ok := snmpTrapDevices.names[logdata.SourceIp]; ok {
+		logdata.Hostname = name
+	} else if name, ok = snmpTrapDevices.names[trap.AgentAddress]; ok {
+		logdata.Hostname = name
+	}
+	snmpTrapDevices.RUnlock()
+	if a.appConfig.Snmp.Debug {
+		log.Infof("snmp trap from %s %s", logdata.Hostname, logdata.Message)
+	}
+
+	if !applySyslogRules(logdata) {
+		return nil
+	}
+	a.ResolveSyslogTimestamp(logdata)
+	event := a.SyslogAlertEvent(logdata)
+	tail := a.SyslogTailCopy(logdata)
+	if !a.PushSyslog(logdata) {
+		// an inform is retransmitted by the sender
+		return errors.New("syslog queue is full")
+	}
+	a.evaluateAlerts(event)
+	a.PublishSyslog(tail)
+	return nil
+}
+
+// NewSnmpTrapSyslog The syslog record of a trap, its message is the trap name
+// followed by the varbinds so that a keyword search finds them
+func NewSnmpTrapSyslog(trap *snmp.Trap) *models.TsSyslog {
+	severity, ok := snmpTrapSeverities[trap.TrapOID]
+	if !ok {
+		severity = 5
+	}
+	sourceIp := trap.Addr.IP.String()
+	logdata := &models.TsSyslog{
+		ID:              common.UUID(),
+		Timestamp:       trap.Received,
+		ReceivedAt:      trap.Received,
+		SourceIp:        sourceIp,
+		SourcePort:      trap.Addr.Port,
+		Logtype:         SnmpTrapLogtype,
+		MsgID:           trap.TrapName,
+		ProcID:          "N/A",
+		Appname:         SnmpTrapLogtype,
+		Hostname:        sourceIp,
+		Priority:        snmpTrapFacility*8 + severity,
+		Facility:        snmpTrapFacility,
+		FacilityMessage: sdcommon.Facility[snmpTrapFacility],
+		Severity:        severity,
+		SeverityMessage: sdcommon.SeverityMessages[uint8(severity)],
+		Tags:            "snmptrap,varbinds",
+	}
+	if logdata.MsgID == "" {
+		logdata.MsgID = "N/A"
+	}
+
+	fields := map[string]string{
+		"source":    sourceIp,
+		"version":   trap.Version,
+		"trap_oid":  trap.TrapOID,
+		"trap_name": trap.TrapName,
+		"uptime":    strconv.FormatUint(uint64(trap.Uptime), 10),
+		"inform":    strconv.FormatBool(trap.Inform),
+	}
+	if trap.Username != "" {
+		fields["username"] = trap.Username
+	}
+	if trap.Enterprise != "" {
+		fields["enterprise"] = trap.Enterprise
+		fields["agent_address"] = trap.AgentAddress
+	}
+	varbinds := make(map[string]string, len(trap.Variables))
+	message := []string{trap.TrapName}
+	for _, v := range trap.Variables {
+		// a structured data param has no dots, IF-MIB::ifOperStatus.3 is IF-MIB::ifOperStatus_3
+		varbinds[strings.ReplaceAll(v.Name, ".", "_")] = v.Value
+		message = append(message, v.Name+"="+v.Value)
+	}
+	logdata.StructuredData = models.StructuredData{"snmptrap": fields, "varbinds": varbinds}
+	logdata.Message = strings.Join(message, " ")
+	return logdata
+}
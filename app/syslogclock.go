@@ -0,0 +1,96 @@
+package app
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+const (
+	ClockPolicyDevice  = "device"  // always use the time reported by the sender
+	ClockPolicyReceive = "receive" // always use the time the message was received
+	ClockPolicyAuto    = "auto"    // use the sender time unless it is off by more than the max skew
+)
+
+type clockPolicyRule struct {
+	ipnet   *net.IPNet
+	source  string
+	policy  string
+	maxSkew time.Duration
+}
+
+func (r clockPolicyRule) match(ip net.IP, hostname string) bool {
+	if r.ipnet != nil {
+		return ip != nil && r.ipnet.Contains(ip)
+	}
+	return r.source == hostname
+}
+
+var clockPolicies = struct {
+	sync.RWMutex
+	rules []clockPolicyRule
+}{}
+
+// LoadSyslogClockPolicies Reload the per source clock policies from the database
+func (a *Application) LoadSyslogClockPolicies() {
+	var items []models.SyslogClockPolicy
+	if err := a.gormDB.Find(&items).Error; err != nil {
+		log.Errorf("load syslog clock policy error %s", err.Error())
+		return
+	}
+	rules := make([]clockPolicyRule, 0, len(items))
+	for _, item := range items {
+		rule := clockPolicyRule{
+			source:  item.Source,
+			policy:  item.Policy,
+			maxSkew: time.Duration(item.MaxSkew) * time.Second,
+		}
+		if _, ipnet, err := net.ParseCIDR(item.Source); err == nil {
+			rule.ipnet = ipnet
+		} else if ip := net.ParseIP(item.Source); ip != nil {
+			rule.ipnet = &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)}
+		}
+		rules = append(rules, rule)
+	}
+	clockPolicies.Lock()
+	clockPolicies.rules = rules
+	clockPolicies.Unlock()
+}
+
+// syslogClockPolicy Find the clock policy of a source, falls back to the syslogd config
+func (a *Application) syslogClockPolicy(sourceIp, hostname string) (string, time.Duration) {
+	ip := net.ParseIP(sourceIp)
+	clockPolicies.RLock()
+	defer clockPolicies.RUnlock()
+	for _, rule := range clockPolicies.rules {
+		if rule.match(ip, hostname) {
+			return rule.policy, rule.maxSkew
+		}
+	}
+	return a.appConfig.Syslogd.ClockPolicy, time.Duration(a.appConfig.Syslogd.MaxClockSkew) * time.Second
+}
+
+// ResolveSyslogTimestamp Set the authoritative timestamp of a record from its event and receive time
+func (a *Application) ResolveSyslogTimestamp(logdata *models.TsSyslog) {
+	logdata.Timestamp = logdata.ReceivedAt
+	if logdata.EventTime.IsZero() {
+		return
+	}
+	policy, maxSkew := a.syslogClockPolicy(logdata.SourceIp, logdata.Hostname)
+	switch policy {
+	case ClockPolicyDevice:
+		logdata.Timestamp = logdata.EventTime
+	case ClockPolicyReceive:
+	default:
+		skew := logdata.ReceivedAt.Sub(logdata.EventTime)
+		if skew < 0 {
+			skew = -skew
+		}
+		if skew <= maxSkew {
+			logdata.Timestamp = logdata.EventTime
+		}
+	}
+}
@@ -1,35 +1,66 @@
 package app
 
 import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"hash/fnv"
 	"net"
+	"path"
+	"runtime"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/influxdata/go-syslog/v3"
+	"github.com/spf13/cast"
 	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/multiline"
+	"github.com/talkincode/logsight/common/rfc6587"
 	"github.com/talkincode/logsight/common/zaplog"
 	"github.com/talkincode/logsight/common/zaplog/log"
 	"github.com/talkincode/logsight/models"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
 
 	_ "github.com/influxdata/go-syslog/v3"
 	"github.com/influxdata/go-syslog/v3/rfc3164"
 	"github.com/influxdata/go-syslog/v3/rfc5424"
 )
 
+// syslogMaxDatagramSize Maximum size of a UDP syslog message
+const syslogMaxDatagramSize = 64 * 1024
+
 type SyslogServer struct {
 	Rfc3164Parser  syslog.Machine
 	Rfc5424Parser  syslog.Machine
 	Rfc3164Enabled bool
 	Debug          bool
 	logger         *zap.SugaredLogger
+	// queues One per worker, the datagrams of a host always go to the same
+	// worker so that its lines are parsed and reassembled in arrival order
+	queues    []chan syslogPacket
+	assembler *multiline.Assembler[*models.TsSyslog]
+}
+
+// syslogPacket A datagram waiting for a parse worker
+type syslogPacket struct {
+	remoteaddr net.Addr
+	data       []byte
 }
 
 func NewSyslogServer() *SyslogServer {
 	s := &SyslogServer{}
-	s.Rfc3164Parser = rfc3164.NewParser(rfc3164.WithBestEffort())
-	s.Rfc5424Parser = rfc5424.NewParser(rfc3164.WithBestEffort())
+	workers := app.Config().Syslogd.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	s.queues = make([]chan syslogPacket, workers)
+	for i := range s.queues {
+		s.queues[i] = make(chan syslogPacket, 1024)
+	}
+	s.Rfc3164Parser, s.Rfc5424Parser = newSyslogParsers()
 	s.Debug = app.Config().Syslogd.Debug
 	s.logger = zaplog.GetLogger(zaplog.LogConfig{
 		Mode:           app.Config().Logger.Mode,
@@ -45,9 +76,33 @@ func NewSyslogServer() *SyslogServer {
 		MetricsStorage: app.Config().Logger.MetricsStorage,
 		MetricsHistory: app.Config().Logger.MetricsHistory,
 	}).Sugar()
+	if app.Config().Syslogd.MultilineEnable {
+		assembler, err := multiline.New[*models.TsSyslog](multiline.Config{
+			StartPattern: app.Config().Syslogd.MultilineStartPattern,
+			Timeout:      time.Millisecond * time.Duration(app.Config().Syslogd.MultilineTimeout),
+			MaxLines:     app.Config().Syslogd.MultilineMaxLines,
+		}, s.emitMultiline)
+		if err != nil {
+			log.Errorf("syslog multiline disabled, bad start pattern %s", err.Error())
+		} else {
+			s.assembler = assembler
+			app.syslogAssembler = assembler
+		}
+	}
 	return s
 }
 
+// newSyslogParsers The parsers keep state while parsing and must not be shared between goroutines
+func newSyslogParsers() (syslog.Machine, syslog.Machine) {
+	return rfc3164.NewParser(
+			rfc3164.WithBestEffort(),
+			rfc3164.WithYear(rfc3164.CurrentYear{}),
+			rfc3164.WithTimezone(time.Local),
+			rfc3164.WithRFC3339(),
+		),
+		rfc5424.NewParser(rfc5424.WithBestEffort())
+}
+
 // HandleRfc3164
 // Handling Rfc3164 messages
 func (s SyslogServer) HandleRfc3164(remoteaddr net.Addr, data []byte) (*models.TsSyslog, error) {
@@ -58,6 +113,7 @@ func (s SyslogServer) HandleRfc3164(remoteaddr net.Addr, data []byte) (*models.T
 
 	slog := *message.(*rfc3164.SyslogMessage)
 	logdata := &models.TsSyslog{
+		ID:              common.UUID(),
 		Timestamp:       time.Now(),
 		Logtype:         "rfc3164",
 		MsgID:           "N/A",
@@ -76,6 +132,13 @@ func (s SyslogServer) HandleRfc3164(remoteaddr net.Addr, data []byte) (*models.T
 	if slog.Appname != nil {
 		logdata.Appname = *slog.Appname
 	}
+	if slog.Timestamp != nil {
+		logdata.EventTime = *slog.Timestamp
+		// RFC 3164 timestamps have no year, a message from December received in January
+		if logdata.EventTime.After(time.Now().Add(time.Hour * 24)) {
+			logdata.EventTime = logdata.EventTime.AddDate(-1, 0, 0)
+		}
+	}
 
 	return logdata, nil
 }
@@ -89,6 +152,7 @@ func (s SyslogServer) HandleRfc5424(remoteaddr net.Addr, data []byte) (*models.T
 	}
 	slog := *message.(*rfc5424.SyslogMessage)
 	logdata := &models.TsSyslog{
+		ID:              common.UUID(),
 		Timestamp:       time.Now(),
 		Logtype:         "rfc5424",
 		MsgID:           *slog.MsgID,
@@ -104,6 +168,18 @@ func (s SyslogServer) HandleRfc5424(remoteaddr net.Addr, data []byte) (*models.T
 		Message:         *slog.Message,
 		Tags:            "",
 	}
+	if slog.Timestamp != nil {
+		logdata.EventTime = *slog.Timestamp
+	}
+	if slog.StructuredData != nil && len(*slog.StructuredData) > 0 {
+		logdata.StructuredData = models.StructuredData(*slog.StructuredData)
+		sdids := make([]string, 0, len(logdata.StructuredData))
+		for sdid := range logdata.StructuredData {
+			sdids = append(sdids, sdid)
+		}
+		sort.Strings(sdids)
+		logdata.Tags = strings.Join(sdids, ",")
+	}
 	return logdata, nil
 }
 
@@ -122,6 +198,9 @@ func (s SyslogServer) HandleSyslog(remoteaddr net.Addr, data []byte) {
 		}
 	}()
 
+	receivedAt := time.Now()
+	sourceIp, sourcePort := splitRemoteAddr(remoteaddr)
+
 	logdata, err := s.HandleRfc3164(remoteaddr, data)
 	if err != nil {
 		logdata, err = s.HandleRfc5424(remoteaddr, data)
@@ -131,12 +210,12 @@ func (s SyslogServer) HandleSyslog(remoteaddr net.Addr, data []byte) {
 		var message = string(data)
 		logdata = &models.TsSyslog{
 			ID:              common.UUID(),
-			Timestamp:       time.Now(),
+			Timestamp:       receivedAt,
 			Logtype:         "text",
 			MsgID:           "N/A",
 			ProcID:          "N/A",
 			Appname:         "N/A",
-			Hostname:        remoteaddr.String(),
+			Hostname:        sourceIp,
 			Facility:        3,
 			FacilityMessage: "system daemons messages",
 			Message:         message,
@@ -158,7 +237,45 @@ func (s SyslogServer) HandleSyslog(remoteaddr net.Addr, data []byte) {
 		}
 	}
 
-	app.gormDB.Create(logdata)
+	logdata.ReceivedAt = receivedAt
+	logdata.SourceIp = sourceIp
+	logdata.SourcePort = sourcePort
+	if logdata.Hostname == "" || logdata.Hostname == "-" {
+		logdata.Hostname = sourceIp
+	}
+	if s.assembler != nil {
+		s.assembler.Add(logdata.Hostname+"/"+logdata.Appname, logdata, logdata.Message)
+		return
+	}
+	s.processSyslog(logdata)
+}
+
+// emitMultiline Receives a reassembled event, the header fields come from its first line.
+// It runs on the assembler's flush goroutine, a panic here would stop the process
+func (s SyslogServer) emitMultiline(first *models.TsSyslog, lines []string) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error(err)
+		}
+	}()
+	if len(lines) > 1 {
+		first.Message = strings.Join(lines, "\n")
+	}
+	s.processSyslog(first)
+}
+
+// processSyslog Apply rules and clock policy to a parsed message and queue it for storage
+func (s SyslogServer) processSyslog(logdata *models.TsSyslog) {
+	if !applySyslogRules(logdata) {
+		return
+	}
+	app.ResolveSyslogTimestamp(logdata)
+
+	event := app.SyslogAlertEvent(logdata)
+	tail := app.SyslogTailCopy(logdata)
+	app.PushSyslog(logdata)
+	app.evaluateAlerts(event)
+	app.PublishSyslog(tail)
 	if app.Config().Syslogd.Debug {
 		switch logdata.Severity {
 		case 7:
@@ -174,21 +291,149 @@ func (s SyslogServer) HandleSyslog(remoteaddr net.Addr, data []byte) {
 
 }
 
+// StartSyslogServer starts the UDP listener and, when enabled,
+// the TCP and TLS listeners, returns when any of them fails
 func (s SyslogServer) StartSyslogServer() error {
+	for _, queue := range s.queues {
+		go s.packetWorker(queue)
+	}
+	if s.assembler != nil {
+		s.assembler.Start()
+	}
+
+	var g errgroup.Group
+	if app.Config().Syslogd.Port > 0 {
+		g.Go(s.startUdpServer)
+	}
+	if app.Config().Syslogd.TcpEnable {
+		g.Go(s.startTcpServer)
+	}
+	if app.Config().Syslogd.TlsEnable {
+		g.Go(s.startTlsServer)
+	}
+	return g.Wait()
+}
+
+func (s SyslogServer) startUdpServer() error {
 	ip := net.ParseIP(app.Config().Syslogd.Host)
 	port := app.Config().Syslogd.Port
 	listener, err := net.ListenUDP("udp", &net.UDPAddr{IP: ip, Port: port})
 	if err != nil {
 		return err
 	}
-	log.Infof("Syslog server started on %s:%d", ip, port)
+	log.Infof("Syslog server started on udp %s:%d", ip, port)
+	buf := make([]byte, syslogMaxDatagramSize)
 	for {
-		data := make([]byte, 1024)
-		n, remoteAddr, err := listener.ReadFrom(data)
+		n, remoteAddr, err := listener.ReadFrom(buf)
 		if err != nil {
 			log.Error(err)
+			continue
 		}
-		var logdata = data[:n]
-		go s.HandleSyslog(remoteAddr, logdata)
+		data := make([]byte, n)
+		copy(data, buf[:n])
+		// blocks when the worker is busy, the socket buffer absorbs short bursts
+		s.queues[s.queueIndex(remoteAddr)] <- syslogPacket{remoteaddr: remoteAddr, data: data}
+	}
+}
+
+// queueIndex The worker of a source host
+func (s SyslogServer) queueIndex(remoteAddr net.Addr) uint32 {
+	h := fnv.New32a()
+	if udpAddr, ok := remoteAddr.(*net.UDPAddr); ok {
+		h.Write(udpAddr.IP)
+	}
+	return h.Sum32() % uint32(len(s.queues))
+}
+
+func (s SyslogServer) packetWorker(queue chan syslogPacket) {
+	s.Rfc3164Parser, s.Rfc5424Parser = newSyslogParsers()
+	for pkt := range queue {
+		s.HandleSyslog(pkt.remoteaddr, pkt.data)
+	}
+}
+
+func (s SyslogServer) startTcpServer() error {
+	addr := fmt.Sprintf("%s:%d", app.Config().Syslogd.Host, app.Config().Syslogd.TcpPort)
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	log.Infof("Syslog server started on tcp %s", addr)
+	return s.serveStream(listener)
+}
+
+// startTlsServer Syslog over TLS (RFC 5425), using the same certificate as the web server
+func (s SyslogServer) startTlsServer() error {
+	cert, err := tls.LoadX509KeyPair(
+		path.Join(app.Config().GetPrivateDir(), "logsight.tls.crt"),
+		path.Join(app.Config().GetPrivateDir(), "logsight.tls.key"))
+	if err != nil {
+		return err
+	}
+	addr := fmt.Sprintf("%s:%d", app.Config().Syslogd.Host, app.Config().Syslogd.TlsPort)
+	listener, err := tls.Listen("tcp", addr, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("Syslog server started on tls %s", addr)
+	return s.serveStream(listener)
+}
+
+func (s SyslogServer) serveStream(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				log.Error(err)
+				continue
+			}
+			return err
+		}
+		go s.handleStreamConn(conn)
+	}
+}
+
+// handleStreamConn Read RFC 6587 framed messages from a TCP or TLS connection
+func (s SyslogServer) handleStreamConn(conn net.Conn) {
+	defer conn.Close()
+	s.Rfc3164Parser, s.Rfc5424Parser = newSyslogParsers()
+	remoteAddr := conn.RemoteAddr()
+	maxSize := app.Config().Syslogd.MaxMessageSize
+	if maxSize <= 0 {
+		maxSize = syslogMaxDatagramSize
+	}
+	scanner := rfc6587.NewScanner(conn, maxSize)
+	for scanner.Scan() {
+		frame := scanner.Bytes()
+		if len(frame) == 0 {
+			continue
+		}
+		var logdata = make([]byte, len(frame))
+		copy(logdata, frame)
+		s.HandleSyslog(remoteAddr, logdata)
+	}
+	if err := scanner.Err(); err != nil && !errors.Is(err, net.ErrClosed) {
+		log.Errorf("syslog connection %s error %s", remoteAddr, err.Error())
+	}
+}
+
+// splitRemoteAddr Source ip and port of a message
+func splitRemoteAddr(addr net.Addr) (string, int) {
+	switch v := addr.(type) {
+	case *net.UDPAddr:
+		return v.IP.String(), v.Port
+	case *net.TCPAddr:
+		return v.IP.String(), v.Port
+	case nil:
+		return "", 0
+	}
+	host, port, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String(), 0
 	}
+	return host, cast.ToInt(port)
 }
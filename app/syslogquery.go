@@ -0,0 +1,125 @@
+package app
+
+import (
+	"strings"
+	"time"
+
+	"github.com/talkincode/logsight/common/lql"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+const (
+	// syslogSearchDefaultRange Time range of a query that does not filter on time
+	syslogSearchDefaultRange = time.Hour * 24
+	// syslogSearchMaxRows Upper bound of the rows a single query returns
+	syslogSearchMaxRows = 10000
+)
+
+// SyslogSearchResult The result of an LQL query, Rows for plain queries and
+// Stats with its Columns for queries with a stats pipe
+type SyslogSearchResult struct {
+	Columns []string                 `json:"columns,omitempty"`
+	Stats   []map[string]interface{} `json:"stats,omitempty"`
+	Rows    []models.TsSyslog        `json:"rows,omitempty"`
+	Total   int64                    `json:"total"`
+}
+
+// syslogSeverities Severity names accepted by LQL, e.g. severity<=err
+var syslogSeverities = map[string]int64{
+	"emerg": 0, "emergency": 0, "alert": 1, "crit": 2, "critical": 2, "err": 3, "error": 3,
+	"warn": 4, "warning": 4, "notice": 5, "info": 6, "informational": 6, "debug": 7,
+}
+
+// syslogQueryFields The ts_syslog fields available to LQL with their aliases
+var syslogQueryFields = map[string]lql.Field{
+	"host":        {Column: "hostname", Type: lql.TypeString},
+	"hostname":    {Column: "hostname", Type: lql.TypeString},
+	"app":         {Column: "appname", Type: lql.TypeString},
+	"appname":     {Column: "appname", Type: lql.TypeString},
+	"ip":          {Column: "source_ip", Type: lql.TypeString},
+	"source_ip":   {Column: "source_ip", Type: lql.TypeString},
+	"port":        {Column: "source_port", Type: lql.TypeInt},
+	"source_port": {Column: "source_port", Type: lql.TypeInt},
+	"procid":      {Column: "proc_id", Type: lql.TypeString},
+	"msgid":       {Column: "msg_id", Type: lql.TypeString},
+	"logtype":     {Column: "logtype", Type: lql.TypeString},
+	"tags":        {Column: "tags", Type: lql.TypeString, Contains: true},
+	"message":     {Column: "message", Type: lql.TypeString, Contains: true},
+	"msg":         {Column: "message", Type: lql.TypeString, Contains: true},
+	"severity":    {Column: "severity", Type: lql.TypeInt, Values: syslogSeverities},
+	"facility":    {Column: "facility", Type: lql.TypeInt},
+	"time":        {Column: "timestamp", Type: lql.TypeTime},
+	"timestamp":   {Column: "timestamp", Type: lql.TypeTime},
+	"received":    {Column: "received_at", Type: lql.TypeTime},
+}
+
+// SyslogQuerySchema The LQL schema of ts_syslog, words without a field search the message
+func (a *Application) SyslogQuerySchema() *lql.Schema {
+	return &lql.Schema{
+		Fields:      syslogQueryFields,
+		Text:        "message",
+		TextIndexed: a.SyslogFullTextEnabled(),
+	}
+}
+
+// SearchSyslog Run an LQL query, start and count page plain queries and are
+// overridden by a head pipe. Queries without a time filter cover the last 24 hours
+func (a *Application) SearchSyslog(query string, start, count int) (*SyslogSearchResult, error) {
+	q, err := lql.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	compiled, err := a.SyslogQuerySchema().Compile(q, now)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := a.gormDB.Model(&models.TsSyslog{})
+	if !q.HasField("time", "timestamp") {
+		tx = tx.Where("timestamp >= ?", now.Add(-syslogSearchDefaultRange))
+	}
+	if compiled.Where != "" {
+		tx = tx.Where(compiled.Where, compiled.Args...)
+	}
+	if compiled.Limit > 0 {
+		start, count = 0, compiled.Limit
+	}
+	if count <= 0 || count > syslogSearchMaxRows {
+		count = syslogSearchMaxRows
+	}
+
+	result := &SyslogSearchResult{}
+	if q.Stats != nil {
+		tx = tx.Select(strings.Join(compiled.Select, ", "))
+		if len(compiled.GroupBy) > 0 {
+			tx = tx.Group(strings.Join(compiled.GroupBy, ", "))
+		}
+		for _, order := range compiled.OrderBy {
+			tx = tx.Order(order)
+		}
+		result.Columns = compiled.Columns
+		result.Stats = make([]map[string]interface{}, 0)
+		err = tx.Limit(count).Find(&result.Stats).Error
+		result.Total = int64(len(result.Stats))
+		return result, err
+	}
+
+	if compiled.Limit == 0 {
+		if err = tx.Session(&gorm.Session{}).Count(&result.Total).Error; err != nil {
+			return nil, err
+		}
+	}
+	if len(compiled.OrderBy) == 0 {
+		tx = tx.Order("timestamp desc")
+	}
+	for _, order := range compiled.OrderBy {
+		tx = tx.Order(order)
+	}
+	err = tx.Offset(start).Limit(count).Find(&result.Rows).Error
+	if compiled.Limit > 0 {
+		result.Total = int64(len(result.Rows))
+	}
+	return result, err
+}
@@ -0,0 +1,98 @@
+package app
+
+import (
+	"strings"
+	"sync"
+
+	sdcommon "github.com/influxdata/go-syslog/v3/common"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/logparse"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+var syslogRules = struct {
+	sync.RWMutex
+	engine *logparse.Engine
+}{engine: &logparse.Engine{}}
+
+// LoadSyslogRules Reload the enabled parsing rules from the database
+func (a *Application) LoadSyslogRules() {
+	var items []models.SyslogRule
+	if err := a.gormDB.Where("status = ?", common.ENABLED).Order("sort asc").Find(&items).Error; err != nil {
+		log.Errorf("load syslog rules error %s", err.Error())
+		return
+	}
+	rules := make([]logparse.Rule, 0, len(items))
+	for _, item := range items {
+		rules = append(rules, SyslogRuleToLogparse(item))
+	}
+	engine, errs := logparse.NewEngine(rules)
+	for _, err := range errs {
+		log.Errorf("syslog rule error %s", err.Error())
+	}
+	syslogRules.Lock()
+	syslogRules.engine = engine
+	syslogRules.Unlock()
+}
+
+// SyslogRuleToLogparse Convert a stored rule to a logparse rule
+func SyslogRuleToLogparse(item models.SyslogRule) logparse.Rule {
+	var tags []string
+	for _, tag := range strings.Split(item.Tags, ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return logparse.Rule{
+		Name:        item.Name,
+		Hostname:    item.Hostname,
+		Appname:     item.Appname,
+		PatternType: item.PatternType,
+		Pattern:     item.Pattern,
+		Action:      item.Action,
+		Severity:    item.Severity,
+		Tags:        tags,
+		Namespace:   item.Namespace,
+	}
+}
+
+// applySyslogRules Apply the parsing rules to a record, returns false if the record is dropped
+func applySyslogRules(logdata *models.TsSyslog) bool {
+	syslogRules.RLock()
+	engine := syslogRules.engine
+	syslogRules.RUnlock()
+	if engine.Len() == 0 {
+		return true
+	}
+
+	result := engine.Apply(logdata.Hostname, logdata.Appname, logdata.Message)
+	if result.Drop {
+		return false
+	}
+	if result.Severity != logparse.SeverityKeep {
+		logdata.Severity = int64(result.Severity)
+		logdata.SeverityMessage = sdcommon.SeverityMessages[uint8(result.Severity)]
+	}
+	for namespace, fields := range result.Fields {
+		if logdata.StructuredData == nil {
+			logdata.StructuredData = make(models.StructuredData)
+		}
+		if logdata.StructuredData[namespace] == nil {
+			logdata.StructuredData[namespace] = make(map[string]string)
+		}
+		for k, v := range fields {
+			logdata.StructuredData[namespace][k] = v
+		}
+	}
+	if len(result.Tags) > 0 {
+		tags := strings.Split(logdata.Tags, ",")
+		for _, tag := range result.Tags {
+			if !common.InSlice(tag, tags) {
+				tags = append(tags, tag)
+			}
+		}
+		logdata.Tags = strings.Trim(strings.Join(tags, ","), ",")
+	}
+	return true
+}
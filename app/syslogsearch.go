@@ -0,0 +1,156 @@
+package app
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talkincode/logsight/common/alerting"
+	"github.com/talkincode/logsight/common/fts"
+	"github.com/talkincode/logsight/common/glob"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"gorm.io/gorm"
+)
+
+const (
+	SearchPrivate = "private"
+	SearchShared  = "shared"
+
+	// SearchRangeCustom The saved search uses its absolute start and end time
+	SearchRangeCustom = "custom"
+
+	// syslogSearchDefaultSpan Range of saved searches without one, matches the syslog view
+	syslogSearchDefaultSpan = time.Hour * 8
+)
+
+const searchTokenChars = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// NewSyslogSearchToken A random 8 character permalink code
+func NewSyslogSearchToken() string {
+	var sb strings.Builder
+	max := big.NewInt(int64(len(searchTokenChars)))
+	for i := 0; i < 8; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			panic(err)
+		}
+		sb.WriteByte(searchTokenChars[n.Int64()])
+	}
+	return sb.String()
+}
+
+// ParseSearchRange Parse a relative range such as 15m, 8h or 7d
+func ParseSearchRange(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil || days <= 0 {
+			return 0, fmt.Errorf("bad time range %s", value)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("bad time range %s", value)
+	}
+	return d, nil
+}
+
+// SyslogSearchRange The time range a saved search covers at now
+func SyslogSearchRange(search models.SyslogSearch, now time.Time) (time.Time, time.Time) {
+	if search.TimeRange == SearchRangeCustom {
+		return search.StartTime, search.EndTime
+	}
+	span, err := ParseSearchRange(search.TimeRange)
+	if err != nil {
+		span = syslogSearchDefaultSpan
+	}
+	return now.Add(-span), now
+}
+
+// ApplySyslogSearch Add the conditions of a saved search to a ts_syslog query
+func (a *Application) ApplySyslogSearch(tx *gorm.DB, search models.SyslogSearch, now time.Time) (*gorm.DB, error) {
+	start, end := SyslogSearchRange(search, now)
+	tx = tx.Where("timestamp >= ? AND timestamp <= ?", start, end)
+	if search.Hostname != "" {
+		tx = tx.Where("hostname = ?", search.Hostname)
+	}
+	if search.Severity >= 0 {
+		tx = tx.Where("severity <= ?", search.Severity)
+	}
+	// structured data terms work like in the syslog view
+	terms, keyword := web.ParseJsonPathTerms(search.Keyword, "sd")
+	for _, term := range terms {
+		tx = term.Apply(tx, "structured_data")
+	}
+	node, err := fts.Parse(keyword)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case node == nil:
+	case a.SyslogFullTextEnabled():
+		tx = tx.Where(fts.Match("message"), node.TsQuery())
+	default:
+		sql, args := node.Like("message")
+		tx = tx.Where(sql, args...)
+	}
+	return tx, nil
+}
+
+// SyslogSearchVisible Whether an operator may use a saved search
+func SyslogSearchVisible(search models.SyslogSearch, oprID int64) bool {
+	return search.Visibility == SearchShared || search.OprID == oprID
+}
+
+// GetSyslogSearch Load a saved search
+func (a *Application) GetSyslogSearch(id int64) (*models.SyslogSearch, error) {
+	var search models.SyslogSearch
+	if err := a.gormDB.Where("id = ?", id).First(&search).Error; err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// AlertRuleWithSearch Convert a stored rule to an alerting rule, a linked
+// saved search supplies the hostname, the severity and the search query
+func (a *Application) AlertRuleWithSearch(item models.AlertRule) (alerting.Rule, error) {
+	rule := AlertRuleToAlerting(item)
+	if item.SearchID == 0 {
+		return rule, nil
+	}
+	search, err := a.GetSyslogSearch(item.SearchID)
+	if err != nil {
+		return rule, fmt.Errorf("rule %s: saved search %d not found", item.Name, item.SearchID)
+	}
+	// the syslog view matches the hostname of a saved search exactly
+	if search.Hostname != "" {
+		rule.Hostname = glob.Quote(search.Hostname)
+	}
+	if search.Severity >= 0 {
+		rule.Severity = search.Severity
+	}
+	terms, keyword := SplitAlertSearch(search.Keyword)
+	rule.StructuredData = append(rule.StructuredData, terms...)
+	switch {
+	case rule.Search == "":
+		rule.Search = keyword
+	case keyword != "":
+		rule.Search = "(" + rule.Search + ") (" + keyword + ")"
+	}
+	return rule, nil
+}
+
+// SplitAlertSearch Take the structured data terms of a syslog view keyword out, the
+// alert engine matches them against the structured data and the rest against the message
+func SplitAlertSearch(keyword string) ([]alerting.SdTerm, string) {
+	terms, rest := web.ParseJsonPathTerms(keyword, "sd")
+	sdterms := make([]alerting.SdTerm, 0, len(terms))
+	for _, term := range terms {
+		sdterms = append(sdterms, alerting.SdTerm{ID: term.Key, Param: term.Param, Value: term.Value, HasValue: term.HasValue})
+	}
+	return sdterms, rest
+}
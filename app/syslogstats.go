@@ -0,0 +1,130 @@
+package app
+
+import (
+	"fmt"
+	"time"
+
+	sdcommon "github.com/influxdata/go-syslog/v3/common"
+)
+
+// syslogBuckets Histogram bucket sizes, the smallest one giving at most
+// syslogMaxBuckets points over the requested range is used
+var syslogBuckets = []time.Duration{
+	time.Minute, 5 * time.Minute, 10 * time.Minute, 15 * time.Minute, 30 * time.Minute,
+	time.Hour, 3 * time.Hour, 6 * time.Hour, 12 * time.Hour, 24 * time.Hour,
+}
+
+const syslogMaxBuckets = 120
+
+// syslogStatsFields Columns that can be ranked by SyslogTopN
+var syslogStatsFields = map[string]string{
+	"host":     "hostname",
+	"app":      "appname",
+	"ip":       "source_ip",
+	"facility": "facility",
+	"severity": "severity",
+}
+
+// SyslogBucketCount Messages of one severity in a time bucket
+type SyslogBucketCount struct {
+	Bucket   time.Time `json:"bucket"`
+	Severity int       `json:"severity"`
+	Count    int64     `json:"count"`
+}
+
+// SyslogNameCount A ranked value and its message count
+type SyslogNameCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// SyslogBucketSize Pick a histogram bucket for a time range
+func SyslogBucketSize(start, end time.Time) time.Duration {
+	for _, bucket := range syslogBuckets {
+		if end.Sub(start)/bucket <= syslogMaxBuckets {
+			return bucket
+		}
+	}
+	return syslogBuckets[len(syslogBuckets)-1]
+}
+
+// syslogBucketExpr The bucket expression, time_bucket on TimescaleDB, otherwise
+// date_trunc aligned to multiples of the bucket like time_bucket does
+func (a *Application) syslogBucketExpr(bucket time.Duration) (string, []interface{}) {
+	seconds := int64(bucket / time.Second)
+	if a.isHypertable("ts_syslog") {
+		return "time_bucket(?::interval, timestamp)", []interface{}{fmt.Sprintf("%d seconds", seconds)}
+	}
+	switch bucket {
+	case time.Minute:
+		return "date_trunc('minute', timestamp)", nil
+	case time.Hour:
+		return "date_trunc('hour', timestamp)", nil
+	}
+	return "date_trunc('minute', timestamp) - " +
+		"(extract(epoch FROM date_trunc('minute', timestamp))::bigint % ?)::float8 * interval '1 second'", []interface{}{seconds}
+}
+
+// SyslogHistogram Message counts per time bucket and severity
+func (a *Application) SyslogHistogram(start, end time.Time, bucket time.Duration) ([]SyslogBucketCount, error) {
+	expr, args := a.syslogBucketExpr(bucket)
+	args = append(args, start, end)
+	var items []SyslogBucketCount
+	err := a.gormDB.Raw("SELECT "+expr+" AS bucket, severity, count(*) AS count FROM ts_syslog "+
+		"WHERE timestamp >= ? AND timestamp < ? GROUP BY 1, 2 ORDER BY 1", args...).Scan(&items).Error
+	return items, err
+}
+
+// SyslogTopN The values of a field with the most messages, field is one of
+// host, app, ip, facility or severity
+func (a *Application) SyslogTopN(field string, start, end time.Time, limit int) ([]SyslogNameCount, error) {
+	column, ok := syslogStatsFields[field]
+	if !ok {
+		return nil, fmt.Errorf("unsupported field %s", field)
+	}
+	var items []SyslogNameCount
+	err := a.gormDB.Raw(fmt.Sprintf("SELECT %s::text AS name, count(*) AS count FROM ts_syslog "+
+		"WHERE timestamp >= ? AND timestamp < ? GROUP BY 1 ORDER BY 2 DESC LIMIT ?", column),
+		start, end, limit).Scan(&items).Error
+	if err != nil {
+		return nil, err
+	}
+	// numeric codes read better by their keyword
+	for i := range items {
+		var code uint8
+		if _, err := fmt.Sscan(items[i].Name, &code); err != nil {
+			continue
+		}
+		switch field {
+		case "facility":
+			if name, ok := sdcommon.FacilityKeywords[code]; ok {
+				items[i].Name = name
+			}
+		case "severity":
+			if name, ok := sdcommon.SeverityLevelsShort[code]; ok {
+				items[i].Name = name
+			}
+		}
+	}
+	return items, nil
+}
+
+// SyslogCount Messages in a time range, maxSeverity -1 counts all severities,
+// otherwise the messages at least as severe
+func (a *Application) SyslogCount(start, end time.Time, maxSeverity int) int64 {
+	var count int64
+	query := a.gormDB.Table("ts_syslog").Where("timestamp >= ? AND timestamp < ?", start, end)
+	if maxSeverity >= 0 {
+		query = query.Where("severity <= ?", maxSeverity)
+	}
+	query.Count(&count)
+	return count
+}
+
+// SyslogSourceCount Distinct hosts sending messages in a time range
+func (a *Application) SyslogSourceCount(start, end time.Time) int64 {
+	var count int64
+	a.gormDB.Raw("SELECT count(DISTINCT hostname) FROM ts_syslog WHERE timestamp >= ? AND timestamp < ?",
+		start, end).Scan(&count)
+	return count
+}
@@ -0,0 +1,41 @@
+package app
+
+import (
+	"time"
+
+	"github.com/talkincode/logsight/common/batchq"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+)
+
+// initSyslogQueue Syslog records are written to the database in batches
+func (a *Application) initSyslogQueue() {
+	cfg := a.appConfig.Syslogd
+	a.syslogQueue = batchq.New[*models.TsSyslog](batchq.Config{
+		QueueSize:     cfg.QueueSize,
+		BatchSize:     cfg.BatchSize,
+		FlushInterval: time.Duration(cfg.FlushInterval) * time.Millisecond,
+		Flushers:      2,
+		Policy:        batchq.ParsePolicy(cfg.QueuePolicy),
+	}, a.flushSyslog)
+	a.syslogQueue.Start()
+}
+
+// flushSyslog Write a batch of syslog records with a single multi-row INSERT
+func (a *Application) flushSyslog(items []*models.TsSyslog) error {
+	err := a.gormDB.CreateInBatches(items, len(items)).Error
+	if err != nil {
+		log.Errorf("syslog batch insert error, %d records lost: %s", len(items), err.Error())
+	}
+	return err
+}
+
+// PushSyslog Queue a syslog record for persistence
+func (a *Application) PushSyslog(logdata *models.TsSyslog) bool {
+	return a.syslogQueue.Push(logdata)
+}
+
+// SyslogQueueStats Syslog persistence queue counters
+func (a *Application) SyslogQueueStats() batchq.Stats {
+	return a.syslogQueue.Stats()
+}
@@ -0,0 +1,75 @@
+package app
+
+import (
+	"strings"
+
+	"github.com/talkincode/logsight/common/glob"
+	"github.com/talkincode/logsight/common/pubsub"
+	"github.com/talkincode/logsight/models"
+)
+
+// SyslogTailFilter Server side filter of a live tail subscriber
+type SyslogTailFilter struct {
+	Hostname string // glob, matched against the hostname and the source ip
+	Appname  string // glob
+	Severity int    // deliver messages at this severity or more severe, -1 for all
+	Keyword  string // case-insensitive substring of the message
+}
+
+// Match Whether the record passes the filter
+func (f SyslogTailFilter) Match(logdata *models.TsSyslog) bool {
+	if f.Severity >= 0 && logdata.Severity > int64(f.Severity) {
+		return false
+	}
+	if f.Hostname != "" && !glob.Match(f.Hostname, logdata.Hostname) && !glob.Match(f.Hostname, logdata.SourceIp) {
+		return false
+	}
+	if f.Appname != "" && !glob.Match(f.Appname, logdata.Appname) {
+		return false
+	}
+	if f.Keyword != "" && !strings.Contains(strings.ToLower(logdata.Message), strings.ToLower(f.Keyword)) {
+		return false
+	}
+	return true
+}
+
+// SubscribeSyslog Subscribe to live syslog records, rate is the maximum messages per second
+func (a *Application) SubscribeSyslog(filter SyslogTailFilter, rate float64) *pubsub.Subscriber[*models.TsSyslog] {
+	return a.syslogHub.Subscribe(pubsub.Options[*models.TsSyslog]{
+		Filter: filter.Match,
+		Buffer: 1024,
+		Rate:   rate,
+	})
+}
+
+// SyslogTailCopy A copy of a record for the live tail, nil without subscribers. It must be
+// taken before the record is pushed, from then on the record is owned by the persistence queue
+func (a *Application) SyslogTailCopy(logdata *models.TsSyslog) *models.TsSyslog {
+	if !a.syslogHub.HasSubscribers() {
+		return nil
+	}
+	item := *logdata
+	if logdata.StructuredData != nil {
+		item.StructuredData = make(models.StructuredData, len(logdata.StructuredData))
+		for id, params := range logdata.StructuredData {
+			item.StructuredData[id] = make(map[string]string, len(params))
+			for name, value := range params {
+				item.StructuredData[id][name] = value
+			}
+		}
+	}
+	return &item
+}
+
+// PublishSyslog Fan out a copy taken by SyslogTailCopy to live tail subscribers, never blocks
+func (a *Application) PublishSyslog(item *models.TsSyslog) {
+	if item == nil {
+		return
+	}
+	a.syslogHub.Publish(item)
+}
+
+// SyslogTailStats Live tail hub counters
+func (a *Application) SyslogTailStats() pubsub.Stats {
+	return a.syslogHub.Stats()
+}
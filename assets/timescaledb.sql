@@ -29,6 +29,8 @@ ALTER TABLE ts_syslog
 SELECT set_chunk_time_interval('ts_syslog', INTERVAL '24 hours');
 -- SELECT remove_compression_policy('ts_syslog');
 SELECT add_compression_policy('ts_syslog', INTERVAL '15d');
-SELECT add_retention_policy('ts_syslog', INTERVAL '6 months');
+-- syslog retention is applied per source by the retention manager (syslogd.retention_days and
+-- the retention rules in the admin ui), a fixed policy would drop records kept by longer rules
+-- SELECT add_retention_policy('ts_syslog', INTERVAL '6 months');
 
 
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common/radius"
+)
+
+// radsim simulates a NAS sending accounting for a number of user sessions,
+// the source address must be registered as a NAS device with the same secret
+var (
+	server   = flag.String("server", "127.0.0.1:1813", "accounting server address")
+	secret   = flag.String("secret", "testing123", "shared secret")
+	nasip    = flag.String("nasip", "127.0.0.1", "NAS-IP-Address")
+	user     = flag.String("user", "test", "username prefix")
+	sessions = flag.Int("sessions", 10, "number of sessions")
+	interim  = flag.Duration("interim", 10*time.Second, "interim update interval")
+	duration = flag.Duration("duration", time.Minute, "session duration")
+)
+
+func main() {
+	flag.Parse()
+	nas := &radius.NAS{Server: *server, Secret: *secret, Retries: 2}
+	var wg sync.WaitGroup
+	for i := 0; i < *sessions; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := simulate(nas, i); err != nil {
+				log.Printf("session %d: %s", i, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func simulate(nas *radius.NAS, i int) error {
+	ctx := context.Background()
+	session, err := nas.StartSession(ctx, radius.Accounting{
+		Username:        fmt.Sprintf("%s%03d", *user, i),
+		SessionID:       fmt.Sprintf("%08x", rand.Uint32()),
+		NasIP:           net.ParseIP(*nasip),
+		NasID:           "radsim",
+		NasPort:         uint32(i),
+		NasPortType:     15, // Ethernet
+		ServiceType:     2,  // Framed
+		FramedIP:        net.IPv4(10, 99, byte(i>>8), byte(i)),
+		MacAddr:         fmt.Sprintf("02:00:00:00:%02x:%02x", byte(i>>8), byte(i)),
+		InterimInterval: uint32(interim.Seconds()),
+	})
+	if err != nil {
+		return err
+	}
+	started := time.Now()
+	var input, output uint64
+	ticker := time.NewTicker(*interim)
+	defer ticker.Stop()
+	for range ticker.C {
+		input += uint64(rand.Intn(10 << 20))
+		output += uint64(rand.Intn(50 << 20))
+		elapsed := uint32(time.Since(started).Seconds())
+		if time.Since(started) >= *duration {
+			return session.Stop(ctx, elapsed, input, output, 1) // User-Request
+		}
+		if err = session.Interim(ctx, elapsed, input, output); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,422 @@
+// Package alerting evaluates alert rules against a stream of log events.
+//
+// Keyword, regex, severity and search rules fire on a single matching event,
+// threshold rules fire when more than Threshold matching events of the
+// same group arrive within Window. A fired alert is not repeated for the
+// same rule and group until the dedup window has passed, and silences
+// suppress alerts entirely for a period of time.
+package alerting
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/talkincode/logsight/common/fts"
+	"github.com/talkincode/logsight/common/glob"
+)
+
+const (
+	TypeKeyword   = "keyword"
+	TypeRegex     = "regex"
+	TypeSeverity  = "severity"
+	TypeThreshold = "threshold"
+	TypeSearch    = "search"
+
+	GroupNone    = ""
+	GroupHost    = "host"
+	GroupApp     = "app"
+	GroupHostApp = "host_app"
+
+	// SeverityAny The rule matches events of any severity
+	SeverityAny = -1
+)
+
+// Rule An alert rule, Hostname and Appname are glob patterns, empty matches everything.
+// Keyword rules match a case-insensitive substring, regex rules a regular expression.
+// Severity rules match events at Severity or more severe. Threshold rules count events
+// matching the optional Pattern (a regular expression) and Severity. Search is an
+// optional search query (see package fts) and StructuredData optional conditions on
+// the RFC 5424 structured data every matching event must satisfy, search rules fire
+// on each event matching them.
+type Rule struct {
+	ID        int64
+	Name      string
+	Type      string
+	Level     string
+	Hostname  string
+	Appname   string
+	Pattern   string
+	Severity  int
+	GroupBy   string
+	Threshold int
+	Window    time.Duration
+	Dedup     time.Duration
+	Search    string
+	// StructuredData written as sd.id.param=value in the syslog view
+	StructuredData []SdTerm
+}
+
+// SdTerm A structured data condition on an SD-ID, one of its params or the value of the param
+type SdTerm struct {
+	ID       string
+	Param    string
+	Value    string
+	HasValue bool
+}
+
+// Match Whether the structured data of an event satisfies the condition
+func (t SdTerm) Match(sd map[string]map[string]string) bool {
+	params, ok := sd[t.ID]
+	if !ok || t.Param == "" {
+		return ok
+	}
+	value, ok := params[t.Param]
+	return ok && (!t.HasValue || value == t.Value)
+}
+
+// Silence Suppresses alerts between Start and End, RuleID 0 silences all rules
+type Silence struct {
+	RuleID   int64
+	Hostname string
+	Start    time.Time
+	End      time.Time
+}
+
+// Event A log event to evaluate
+type Event struct {
+	Time     time.Time
+	Hostname string
+	Appname  string
+	Severity int
+	Message  string
+	// StructuredData SD-ID => PARAM-NAME => PARAM-VALUE
+	StructuredData map[string]map[string]string
+}
+
+// Alert A fired alert
+type Alert struct {
+	RuleID   int64     `json:"rule_id,string"`
+	RuleName string    `json:"rule_name"`
+	RuleType string    `json:"rule_type"`
+	Level    string    `json:"level"`
+	GroupKey string    `json:"group_key"`
+	Hostname string    `json:"hostname"`
+	Appname  string    `json:"appname"`
+	Count    int       `json:"count"`
+	Message  string    `json:"message"`
+	FiredAt  time.Time `json:"fired_at"`
+}
+
+type compiledRule struct {
+	Rule
+	keyword string
+	re      *regexp.Regexp
+	search  *fts.Node
+}
+
+type stateKey struct {
+	rule  int64
+	group string
+}
+
+// Stats Engine counters
+type Stats struct {
+	Rules      int   `json:"rules"`
+	Fired      int64 `json:"fired"`
+	Duplicated int64 `json:"duplicated"`
+	Silenced   int64 `json:"silenced"`
+	Windows    int   `json:"windows"`
+}
+
+type Engine struct {
+	rulesMu  sync.RWMutex
+	rules    []*compiledRule
+	silences []Silence
+
+	stateMu    sync.Mutex
+	windows    map[stateKey][]time.Time
+	fired      map[stateKey]time.Time
+	firedCount int64
+	duplicated int64
+	silenced   int64
+}
+
+func NewEngine() *Engine {
+	return &Engine{
+		windows: make(map[stateKey][]time.Time),
+		fired:   make(map[stateKey]time.Time),
+	}
+}
+
+// ValidateRule Check that a rule compiles
+func ValidateRule(rule Rule) error {
+	_, err := compileRule(rule)
+	return err
+}
+
+func compileRule(rule Rule) (*compiledRule, error) {
+	cr := &compiledRule{Rule: rule}
+	switch rule.Type {
+	case TypeKeyword:
+		if strings.TrimSpace(rule.Pattern) == "" {
+			return nil, fmt.Errorf("rule %s: keyword is empty", rule.Name)
+		}
+		cr.keyword = strings.ToLower(rule.Pattern)
+	case TypeRegex, TypeThreshold:
+		if rule.Type == TypeRegex && rule.Pattern == "" {
+			return nil, fmt.Errorf("rule %s: pattern is empty", rule.Name)
+		}
+		if rule.Pattern != "" {
+			re, err := regexp.Compile(rule.Pattern)
+			if err != nil {
+				return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+			}
+			cr.re = re
+		}
+		if rule.Type == TypeThreshold && (rule.Threshold <= 0 || rule.Window <= 0) {
+			return nil, fmt.Errorf("rule %s: threshold and window must be positive", rule.Name)
+		}
+	case TypeSeverity:
+		if rule.Severity < 0 || rule.Severity > 7 {
+			return nil, fmt.Errorf("rule %s: severity must be between 0 and 7", rule.Name)
+		}
+	case TypeSearch:
+	default:
+		return nil, fmt.Errorf("rule %s: unsupported type %s", rule.Name, rule.Type)
+	}
+	if rule.Search != "" {
+		node, err := fts.Parse(rule.Search)
+		if err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+		}
+		if node != nil {
+			if err = node.Validate(); err != nil {
+				return nil, fmt.Errorf("rule %s: %w", rule.Name, err)
+			}
+		}
+		cr.search = node
+	}
+	if rule.Type == TypeSearch && cr.search == nil && len(rule.StructuredData) == 0 {
+		return nil, fmt.Errorf("rule %s: search query is empty", rule.Name)
+	}
+	switch rule.GroupBy {
+	case GroupNone, GroupHost, GroupApp, GroupHostApp:
+	default:
+		return nil, fmt.Errorf("rule %s: unsupported group by %s", rule.Name, rule.GroupBy)
+	}
+	if _, err := path.Match(rule.Hostname, ""); err != nil {
+		return nil, fmt.Errorf("rule %s: bad hostname pattern %w", rule.Name, err)
+	}
+	if _, err := path.Match(rule.Appname, ""); err != nil {
+		return nil, fmt.Errorf("rule %s: bad appname pattern %w", rule.Name, err)
+	}
+	return cr, nil
+}
+
+// SetRules Replace the rules, counters of rules that still exist are kept.
+// Rules that fail to compile are skipped and reported
+func (e *Engine) SetRules(rules []Rule) []error {
+	var errs []error
+	compiled := make([]*compiledRule, 0, len(rules))
+	ids := make(map[int64]bool)
+	for _, rule := range rules {
+		cr, err := compileRule(rule)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		compiled = append(compiled, cr)
+		ids[rule.ID] = true
+	}
+	e.rulesMu.Lock()
+	e.rules = compiled
+	e.rulesMu.Unlock()
+
+	e.stateMu.Lock()
+	for key := range e.windows {
+		if !ids[key.rule] {
+			delete(e.windows, key)
+		}
+	}
+	for key := range e.fired {
+		if !ids[key.rule] {
+			delete(e.fired, key)
+		}
+	}
+	e.stateMu.Unlock()
+	return errs
+}
+
+// SetSilences Replace the silences
+func (e *Engine) SetSilences(silences []Silence) {
+	e.rulesMu.Lock()
+	e.silences = silences
+	e.rulesMu.Unlock()
+}
+
+// Len Number of active rules
+func (e *Engine) Len() int {
+	e.rulesMu.RLock()
+	defer e.rulesMu.RUnlock()
+	return len(e.rules)
+}
+
+// Evaluate Run all rules against an event and return the alerts that fired
+func (e *Engine) Evaluate(ev Event) []Alert {
+	e.rulesMu.RLock()
+	rules := e.rules
+	silences := e.silences
+	e.rulesMu.RUnlock()
+
+	var alerts []Alert
+	for _, rule := range rules {
+		if !rule.match(ev) {
+			continue
+		}
+		if alert, ok := e.record(rule, ev, silences); ok {
+			alerts = append(alerts, alert)
+		}
+	}
+	return alerts
+}
+
+func (r *compiledRule) match(ev Event) bool {
+	if !glob.Match(r.Hostname, ev.Hostname) || !glob.Match(r.Appname, ev.Appname) {
+		return false
+	}
+	if r.Type != TypeSeverity && r.Severity >= 0 && ev.Severity > r.Severity {
+		return false
+	}
+	if r.search != nil && !r.search.Match(ev.Message) {
+		return false
+	}
+	for _, term := range r.StructuredData {
+		if !term.Match(ev.StructuredData) {
+			return false
+		}
+	}
+	switch r.Type {
+	case TypeKeyword:
+		return strings.Contains(strings.ToLower(ev.Message), r.keyword)
+	case TypeRegex:
+		return r.re.MatchString(ev.Message)
+	case TypeSeverity:
+		return ev.Severity <= r.Severity
+	case TypeThreshold:
+		return r.re == nil || r.re.MatchString(ev.Message)
+	case TypeSearch:
+		return true
+	}
+	return false
+}
+
+func (r *compiledRule) groupKey(ev Event) string {
+	switch r.GroupBy {
+	case GroupHost:
+		return ev.Hostname
+	case GroupApp:
+		return ev.Appname
+	case GroupHostApp:
+		return ev.Hostname + "/" + ev.Appname
+	}
+	return ""
+}
+
+// record Update the counters for a matching event and decide whether to fire
+func (e *Engine) record(rule *compiledRule, ev Event, silences []Silence) (Alert, bool) {
+	key := stateKey{rule: rule.ID, group: rule.groupKey(ev)}
+	count := 1
+
+	e.stateMu.Lock()
+	defer e.stateMu.Unlock()
+	if rule.Type == TypeThreshold {
+		times := e.windows[key]
+		cutoff := ev.Time.Add(-rule.Window)
+		n := 0
+		for n < len(times) && !times[n].After(cutoff) {
+			n++
+		}
+		times = append(times[n:], ev.Time)
+		// only the most recent Threshold+1 timestamps are needed
+		if len(times) > rule.Threshold+1 {
+			times = times[len(times)-rule.Threshold-1:]
+		}
+		e.windows[key] = times
+		if len(times) <= rule.Threshold {
+			return Alert{}, false
+		}
+		count = len(times)
+	}
+
+	for _, s := range silences {
+		if (s.RuleID == 0 || s.RuleID == rule.ID) &&
+			!ev.Time.Before(s.Start) && ev.Time.Before(s.End) &&
+			(s.Hostname == "" || glob.Match(s.Hostname, ev.Hostname)) {
+			e.silenced++
+			return Alert{}, false
+		}
+	}
+
+	if last, ok := e.fired[key]; ok && ev.Time.Sub(last) < rule.Dedup {
+		e.duplicated++
+		return Alert{}, false
+	}
+	e.fired[key] = ev.Time
+	delete(e.windows, key)
+	e.firedCount++
+
+	return Alert{
+		RuleID:   rule.ID,
+		RuleName: rule.Name,
+		RuleType: rule.Type,
+		Level:    rule.Level,
+		GroupKey: key.group,
+		Hostname: ev.Hostname,
+		Appname:  ev.Appname,
+		Count:    count,
+		Message:  ev.Message,
+		FiredAt:  ev.Time,
+	}, true
+}
+
+// Gc Drop counters that can no longer affect any rule
+func (e *Engine) Gc(now time.Time) {
+	e.rulesMu.RLock()
+	rules := make(map[int64]*compiledRule, len(e.rules))
+	for _, r := range e.rules {
+		rules[r.ID] = r
+	}
+	e.rulesMu.RUnlock()
+
+	e.stateMu.Lock()
+	defer e.stateMu.Unlock()
+	for key, times := range e.windows {
+		r, ok := rules[key.rule]
+		if !ok || len(times) == 0 || now.Sub(times[len(times)-1]) > r.Window {
+			delete(e.windows, key)
+		}
+	}
+	for key, last := range e.fired {
+		r, ok := rules[key.rule]
+		if !ok || now.Sub(last) > r.Dedup {
+			delete(e.fired, key)
+		}
+	}
+}
+
+func (e *Engine) Stats() Stats {
+	n := e.Len()
+	e.stateMu.Lock()
+	defer e.stateMu.Unlock()
+	return Stats{
+		Rules:      n,
+		Fired:      e.firedCount,
+		Duplicated: e.duplicated,
+		Silenced:   e.silenced,
+		Windows:    len(e.windows),
+	}
+}
@@ -0,0 +1,171 @@
+package alerting
+
+import (
+	"testing"
+	"time"
+)
+
+var t0 = time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+func ev(offset time.Duration, host, app string, severity int, msg string) Event {
+	return Event{Time: t0.Add(offset), Hostname: host, Appname: app, Severity: severity, Message: msg}
+}
+
+func TestKeywordAndDedup(t *testing.T) {
+	e := NewEngine()
+	errs := e.SetRules([]Rule{{ID: 1, Name: "oom", Type: TypeKeyword, Pattern: "Out of memory", Severity: SeverityAny, GroupBy: GroupHost, Dedup: time.Minute}})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if a := e.Evaluate(ev(0, "h1", "kernel", 3, "kernel: out of memory: kill process")); len(a) != 1 {
+		t.Fatalf("expected an alert, got %v", a)
+	}
+	if a := e.Evaluate(ev(time.Second*10, "h1", "kernel", 3, "out of memory again")); len(a) != 0 {
+		t.Fatalf("expected dedup, got %v", a)
+	}
+	if a := e.Evaluate(ev(time.Second*10, "h2", "kernel", 3, "out of memory")); len(a) != 1 {
+		t.Fatal("dedup must be per group")
+	}
+	if a := e.Evaluate(ev(time.Minute*2, "h1", "kernel", 3, "out of memory")); len(a) != 1 {
+		t.Fatal("expected an alert after the dedup window")
+	}
+	if e.Stats().Duplicated != 1 {
+		t.Fatalf("unexpected stats %+v", e.Stats())
+	}
+}
+
+func TestSeverityRule(t *testing.T) {
+	e := NewEngine()
+	e.SetRules([]Rule{{ID: 1, Name: "crit", Type: TypeSeverity, Severity: 2, Appname: "sshd"}})
+	if len(e.Evaluate(ev(0, "h", "sshd", 3, "x"))) != 0 {
+		t.Fatal("err must not match crit threshold")
+	}
+	if len(e.Evaluate(ev(0, "h", "nginx", 1, "x"))) != 0 {
+		t.Fatal("appname filter ignored")
+	}
+	if len(e.Evaluate(ev(0, "h", "sshd", 1, "x"))) != 1 {
+		t.Fatal("alert severity must match")
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	e := NewEngine()
+	e.SetRules([]Rule{{
+		ID: 7, Name: "auth failures", Type: TypeThreshold, Pattern: `Failed password`,
+		Severity: SeverityAny, GroupBy: GroupHost, Threshold: 5, Window: time.Minute * 5, Dedup: time.Minute * 10,
+	}})
+	var fired []Alert
+	for i := 0; i < 5; i++ {
+		fired = append(fired, e.Evaluate(ev(time.Duration(i)*time.Second, "h1", "sshd", 6, "Failed password for root"))...)
+		fired = append(fired, e.Evaluate(ev(time.Duration(i)*time.Second, "h1", "sshd", 6, "Accepted password for root"))...)
+	}
+	if len(fired) != 0 {
+		t.Fatalf("threshold not reached yet, got %v", fired)
+	}
+	// events outside the window are forgotten
+	if a := e.Evaluate(ev(time.Minute*6, "h1", "sshd", 6, "Failed password for root")); len(a) != 0 {
+		t.Fatalf("old events must expire, got %v", a)
+	}
+	for i := 0; i < 5; i++ {
+		fired = append(fired, e.Evaluate(ev(time.Minute*6+time.Duration(i)*time.Second, "h1", "sshd", 6, "Failed password"))...)
+	}
+	if len(fired) != 1 || fired[0].Count != 6 || fired[0].GroupKey != "h1" {
+		t.Fatalf("unexpected alerts %v", fired)
+	}
+	e.Gc(t0.Add(time.Hour))
+	if e.Stats().Windows != 0 {
+		t.Fatalf("gc should drop stale windows %+v", e.Stats())
+	}
+}
+
+func TestSilence(t *testing.T) {
+	e := NewEngine()
+	e.SetRules([]Rule{{ID: 1, Name: "re", Type: TypeRegex, Pattern: `link (down|flap)`, Severity: SeverityAny}})
+	e.SetSilences([]Silence{{RuleID: 0, Hostname: "sw-*", Start: t0, End: t0.Add(time.Hour)}})
+	if len(e.Evaluate(ev(time.Minute, "sw-01", "", 5, "port 1 link down"))) != 0 {
+		t.Fatal("silenced host must not alert")
+	}
+	if len(e.Evaluate(ev(time.Minute, "rt-01", "", 5, "port 1 link down"))) != 1 {
+		t.Fatal("other hosts must alert")
+	}
+	if len(e.Evaluate(ev(time.Hour*2, "sw-01", "", 5, "port 1 link flap"))) != 1 {
+		t.Fatal("silence expired")
+	}
+}
+
+func TestSearchRule(t *testing.T) {
+	e := NewEngine()
+	errs := e.SetRules([]Rule{
+		{ID: 1, Name: "link", Type: TypeSearch, Search: `"link down" -eth0`, Severity: 4},
+		{ID: 2, Name: "oom", Type: TypeKeyword, Pattern: "memory", Search: "kernel", Severity: SeverityAny},
+	})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	if a := e.Evaluate(ev(0, "sw1", "ifmgr", 3, "Interface eth1 LINK DOWN")); len(a) != 1 || a[0].RuleID != 1 {
+		t.Fatalf("expected the search rule to fire, got %v", a)
+	}
+	if a := e.Evaluate(ev(0, "sw2", "ifmgr", 3, "Interface eth0 link down")); len(a) != 0 {
+		t.Fatalf("excluded term must not fire, got %v", a)
+	}
+	if a := e.Evaluate(ev(0, "sw3", "ifmgr", 6, "Interface eth1 link down")); len(a) != 0 {
+		t.Fatalf("severity must still apply, got %v", a)
+	}
+	if a := e.Evaluate(ev(0, "h1", "app", 3, "out of memory")); len(a) != 0 {
+		t.Fatalf("search must narrow keyword rules, got %v", a)
+	}
+	if a := e.Evaluate(ev(0, "h1", "app", 3, "kernel: out of memory")); len(a) != 1 {
+		t.Fatalf("expected the keyword rule to fire, got %v", a)
+	}
+}
+
+func TestStructuredDataRule(t *testing.T) {
+	e := NewEngine()
+	errs := e.SetRules([]Rule{
+		{ID: 1, Name: "origin", Type: TypeSearch, Severity: SeverityAny, StructuredData: []SdTerm{
+			{ID: "origin", Param: "ip", Value: "10.0.0.1", HasValue: true},
+		}},
+		{ID: 2, Name: "meta", Type: TypeSearch, Search: "login", Severity: SeverityAny, StructuredData: []SdTerm{
+			{ID: "meta", Param: "sequenceId"},
+		}},
+	})
+	if len(errs) > 0 {
+		t.Fatal(errs)
+	}
+	event := ev(0, "sw1", "sshd", 6, "login failed")
+	event.StructuredData = map[string]map[string]string{"origin": {"ip": "10.0.0.1"}, "meta": {"sequenceId": "7"}}
+	if a := e.Evaluate(event); len(a) != 2 {
+		t.Fatalf("expected both rules to fire, got %v", a)
+	}
+	event = ev(0, "sw2", "sshd", 6, "login failed")
+	event.StructuredData = map[string]map[string]string{"origin": {"ip": "10.0.0.2"}, "meta": {}}
+	if a := e.Evaluate(event); len(a) != 0 {
+		t.Fatalf("other structured data must not fire, got %v", a)
+	}
+	if a := e.Evaluate(ev(0, "sw3", "sshd", 6, "login failed")); len(a) != 0 {
+		t.Fatalf("events without structured data must not fire, got %v", a)
+	}
+}
+
+func TestValidateRule(t *testing.T) {
+	bad := []Rule{
+		{Type: "unknown"},
+		{Type: TypeKeyword},
+		{Type: TypeRegex, Pattern: "("},
+		{Type: TypeThreshold, Threshold: 0, Window: time.Minute},
+		{Type: TypeSeverity, Severity: 9},
+		{Type: TypeSeverity, Severity: 3, GroupBy: "user"},
+		{Type: TypeSearch, Search: `""`},
+		{Type: TypeSearch, Search: "error -*"},
+		{Type: TypeSearch, Search: "(link"},
+	}
+	for _, r := range bad {
+		if ValidateRule(r) == nil {
+			t.Fatalf("expected error for %+v", r)
+		}
+	}
+	e := NewEngine()
+	if errs := e.SetRules(append(bad, Rule{ID: 1, Type: TypeSeverity, Severity: 3})); len(errs) != len(bad) || e.Len() != 1 {
+		t.Fatalf("unexpected result %v %d", errs, e.Len())
+	}
+}
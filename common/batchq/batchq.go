@@ -0,0 +1,214 @@
+// Package batchq provides a bounded in-memory queue whose items are
+// flushed in batches, either when a batch is full or when the flush
+// interval expires.
+package batchq
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Policy What to do when an item is pushed into a full queue
+type Policy string
+
+const (
+	DropOldest Policy = "drop_oldest"
+	DropNewest Policy = "drop_newest"
+	Block      Policy = "block"
+)
+
+// ParsePolicy Parse a policy name, unknown names fall back to DropNewest
+func ParsePolicy(name string) Policy {
+	switch Policy(name) {
+	case DropOldest, Block:
+		return Policy(name)
+	default:
+		return DropNewest
+	}
+}
+
+type Config struct {
+	QueueSize     int
+	BatchSize     int
+	FlushInterval time.Duration
+	Flushers      int
+	Policy        Policy
+}
+
+// Stats Queue counters, all values are totals since the queue was created
+type Stats struct {
+	Received int64  `json:"received"`
+	Dropped  int64  `json:"dropped"`
+	Flushed  int64  `json:"flushed"`
+	Failed   int64  `json:"failed"`
+	Batches  int64  `json:"batches"`
+	Pending  int    `json:"pending"`
+	Capacity int    `json:"capacity"`
+	Policy   Policy `json:"policy"`
+}
+
+type BatchQueue[T any] struct {
+	cfg      Config
+	ch       chan T
+	flush    func([]T) error
+	received int64
+	dropped  int64
+	flushed  int64
+	failed   int64
+	batches  int64
+	// mu is held for reading by Push and for writing when the queue stops, so
+	// that no item is enqueued after the flushers have drained the queue
+	mu      sync.RWMutex
+	stopped bool
+	closing chan struct{}
+	stop    chan struct{}
+	wg      sync.WaitGroup
+	once    sync.Once
+}
+
+// New Create a queue, flush is called with each batch and must not retain the slice
+func New[T any](cfg Config, flush func([]T) error) *BatchQueue[T] {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = 10000
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 500
+	}
+	if cfg.FlushInterval <= 0 {
+		cfg.FlushInterval = time.Second
+	}
+	if cfg.Flushers <= 0 {
+		cfg.Flushers = 1
+	}
+	if cfg.Policy == "" {
+		cfg.Policy = DropNewest
+	}
+	return &BatchQueue[T]{
+		cfg:     cfg,
+		ch:      make(chan T, cfg.QueueSize),
+		flush:   flush,
+		closing: make(chan struct{}),
+		stop:    make(chan struct{}),
+	}
+}
+
+// Start the flush goroutines
+func (q *BatchQueue[T]) Start() {
+	for i := 0; i < q.cfg.Flushers; i++ {
+		q.wg.Add(1)
+		go q.run()
+	}
+}
+
+// Stop accepting items, flush everything still queued and wait for the flushers
+func (q *BatchQueue[T]) Stop() {
+	q.once.Do(func() {
+		// release the pushes blocked on a full queue before waiting for them
+		close(q.closing)
+		q.mu.Lock()
+		q.stopped = true
+		q.mu.Unlock()
+		close(q.stop)
+		q.wg.Wait()
+	})
+}
+
+// Push an item, returns false if the item was dropped
+func (q *BatchQueue[T]) Push(item T) bool {
+	atomic.AddInt64(&q.received, 1)
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	if q.stopped {
+		atomic.AddInt64(&q.dropped, 1)
+		return false
+	}
+	switch q.cfg.Policy {
+	case Block:
+		select {
+		case q.ch <- item:
+			return true
+		case <-q.closing:
+			atomic.AddInt64(&q.dropped, 1)
+			return false
+		}
+	case DropOldest:
+		for {
+			select {
+			case q.ch <- item:
+				return true
+			default:
+			}
+			select {
+			case <-q.ch:
+				atomic.AddInt64(&q.dropped, 1)
+			default:
+			}
+		}
+	default:
+		select {
+		case q.ch <- item:
+			return true
+		default:
+			atomic.AddInt64(&q.dropped, 1)
+			return false
+		}
+	}
+}
+
+func (q *BatchQueue[T]) Stats() Stats {
+	return Stats{
+		Received: atomic.LoadInt64(&q.received),
+		Dropped:  atomic.LoadInt64(&q.dropped),
+		Flushed:  atomic.LoadInt64(&q.flushed),
+		Failed:   atomic.LoadInt64(&q.failed),
+		Batches:  atomic.LoadInt64(&q.batches),
+		Pending:  len(q.ch),
+		Capacity: cap(q.ch),
+		Policy:   q.cfg.Policy,
+	}
+}
+
+func (q *BatchQueue[T]) run() {
+	defer q.wg.Done()
+	ticker := time.NewTicker(q.cfg.FlushInterval)
+	defer ticker.Stop()
+	batch := make([]T, 0, q.cfg.BatchSize)
+	for {
+		select {
+		case item := <-q.ch:
+			batch = append(batch, item)
+			if len(batch) >= q.cfg.BatchSize {
+				batch = q.doFlush(batch)
+			}
+		case <-ticker.C:
+			batch = q.doFlush(batch)
+		case <-q.stop:
+			for {
+				select {
+				case item := <-q.ch:
+					batch = append(batch, item)
+					if len(batch) >= q.cfg.BatchSize {
+						batch = q.doFlush(batch)
+					}
+				default:
+					q.doFlush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (q *BatchQueue[T]) doFlush(batch []T) []T {
+	if len(batch) == 0 {
+		return batch
+	}
+	atomic.AddInt64(&q.batches, 1)
+	if err := q.flush(batch); err != nil {
+		atomic.AddInt64(&q.failed, int64(len(batch)))
+	} else {
+		atomic.AddInt64(&q.flushed, int64(len(batch)))
+	}
+	return make([]T, 0, q.cfg.BatchSize)
+}
@@ -0,0 +1,155 @@
+package batchq
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+type collector struct {
+	sync.Mutex
+	batches [][]int
+}
+
+func (c *collector) flush(items []int) error {
+	c.Lock()
+	defer c.Unlock()
+	c.batches = append(c.batches, append([]int(nil), items...))
+	return nil
+}
+
+func (c *collector) count() (batches int, items int) {
+	c.Lock()
+	defer c.Unlock()
+	for _, b := range c.batches {
+		items += len(b)
+	}
+	return len(c.batches), items
+}
+
+func TestBatchSizeFlush(t *testing.T) {
+	c := &collector{}
+	q := New[int](Config{QueueSize: 100, BatchSize: 10, FlushInterval: time.Hour}, c.flush)
+	q.Start()
+	for i := 0; i < 25; i++ {
+		q.Push(i)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if batches, items := c.count(); batches != 2 || items != 20 {
+		t.Fatalf("expected 2 batches with 20 items, got %d batches with %d items", batches, items)
+	}
+	q.Stop()
+	if _, items := c.count(); items != 25 {
+		t.Fatalf("expected all 25 items flushed on stop, got %d", items)
+	}
+	stats := q.Stats()
+	if stats.Flushed != 25 || stats.Received != 25 || stats.Dropped != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func TestIntervalFlush(t *testing.T) {
+	c := &collector{}
+	q := New[int](Config{QueueSize: 100, BatchSize: 100, FlushInterval: 20 * time.Millisecond}, c.flush)
+	q.Start()
+	defer q.Stop()
+	q.Push(1)
+	q.Push(2)
+	time.Sleep(100 * time.Millisecond)
+	if batches, items := c.count(); batches != 1 || items != 2 {
+		t.Fatalf("expected 1 batch with 2 items, got %d batches with %d items", batches, items)
+	}
+}
+
+func TestDropNewest(t *testing.T) {
+	c := &collector{}
+	q := New[int](Config{QueueSize: 3, Policy: DropNewest}, c.flush)
+	for i := 0; i < 5; i++ {
+		q.Push(i)
+	}
+	q.Start()
+	q.Stop()
+	if c.batches[0][0] != 0 || c.batches[0][2] != 2 {
+		t.Fatalf("expected the first items to be kept, got %v", c.batches)
+	}
+	if q.Stats().Dropped != 2 {
+		t.Fatalf("expected 2 dropped, got %d", q.Stats().Dropped)
+	}
+}
+
+func TestDropOldest(t *testing.T) {
+	c := &collector{}
+	q := New[int](Config{QueueSize: 3, Policy: DropOldest}, c.flush)
+	for i := 0; i < 5; i++ {
+		q.Push(i)
+	}
+	q.Start()
+	q.Stop()
+	if c.batches[0][0] != 2 || c.batches[0][2] != 4 {
+		t.Fatalf("expected the last items to be kept, got %v", c.batches)
+	}
+	if q.Stats().Dropped != 2 {
+		t.Fatalf("expected 2 dropped, got %d", q.Stats().Dropped)
+	}
+}
+
+func TestBlock(t *testing.T) {
+	c := &collector{}
+	q := New[int](Config{QueueSize: 1, BatchSize: 1, Policy: Block}, c.flush)
+	q.Push(0)
+	done := make(chan struct{})
+	go func() {
+		q.Push(1)
+		close(done)
+	}()
+	select {
+	case <-done:
+		t.Fatal("push should block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.Start()
+	<-done
+	q.Stop()
+	if _, items := c.count(); items != 2 {
+		t.Fatalf("expected 2 items, got %d", items)
+	}
+}
+
+func TestFlushError(t *testing.T) {
+	q := New[int](Config{BatchSize: 2}, func(items []int) error {
+		return errors.New("database unavailable")
+	})
+	q.Start()
+	q.Push(1)
+	q.Push(2)
+	q.Stop()
+	if q.Stats().Failed != 2 {
+		t.Fatalf("expected 2 failed, got %d", q.Stats().Failed)
+	}
+}
+
+func TestPushRacingStop(t *testing.T) {
+	for _, policy := range []Policy{DropNewest, DropOldest, Block} {
+		c := &collector{}
+		q := New[int](Config{QueueSize: 16, BatchSize: 4, Policy: policy}, c.flush)
+		q.Start()
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < 1000; j++ {
+					q.Push(j)
+				}
+			}()
+		}
+		time.Sleep(time.Millisecond)
+		q.Stop()
+		wg.Wait()
+		stats := q.Stats()
+		if _, items := c.count(); int64(items) != stats.Flushed || stats.Flushed+stats.Dropped != stats.Received {
+			t.Fatalf("%s: items lost, flushed %d stats %+v", policy, items, stats)
+		}
+	}
+}
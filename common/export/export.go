@@ -0,0 +1,174 @@
+// Package export writes table rows as CSV, NDJSON or XLSX to a stream one
+// row at a time, so an export of any size runs in constant memory.
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	FormatCsv    = "csv"
+	FormatNdjson = "ndjson"
+	FormatXlsx   = "xlsx"
+)
+
+// TimeLayout The layout of time values in CSV and XLSX files
+const TimeLayout = "2006-01-02 15:04:05"
+
+var contentTypes = map[string]string{
+	FormatCsv:    "text/csv; charset=utf-8",
+	FormatNdjson: "application/x-ndjson",
+	FormatXlsx:   "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+// Writer Writes the rows of an export
+type Writer interface {
+	// WriteRow Write the values of a row in column order
+	WriteRow(values []interface{}) error
+	// Close Flush buffered data and finish the file, the underlying writer is not closed
+	Close() error
+}
+
+// Supported Whether format is a known export format
+func Supported(format string) bool {
+	_, ok := contentTypes[format]
+	return ok
+}
+
+// ContentType The response content type of a format
+func ContentType(format string) string {
+	return contentTypes[format]
+}
+
+// Filename The download name of an export, stamped with the export time
+func Filename(name, format string, now time.Time) string {
+	return fmt.Sprintf("%s-%s.%s", name, now.Format("20060102150405"), format)
+}
+
+// New Create a writer of format on w, the column names are written first
+// where the format has a header
+func New(format string, w io.Writer, columns []string) (Writer, error) {
+	switch format {
+	case FormatCsv:
+		return newCsvWriter(w, columns)
+	case FormatNdjson:
+		return newNdjsonWriter(w, columns)
+	case FormatXlsx:
+		return newXlsxWriter(w, columns)
+	}
+	return nil, fmt.Errorf("unsupported export format %s", format)
+}
+
+// FormatValue The text of a value in CSV and XLSX cells
+func FormatValue(v interface{}) string {
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case []byte:
+		return string(val)
+	case time.Time:
+		if val.IsZero() {
+			return ""
+		}
+		return val.Format(TimeLayout)
+	case bool:
+		return strconv.FormatBool(val)
+	case int:
+		return strconv.Itoa(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case uint64:
+		return strconv.FormatUint(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(val), 'f', -1, 32)
+	case fmt.Stringer:
+		return val.String()
+	}
+	// maps, slices and structs such as structured data are written as JSON
+	bs, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	if string(bs) == "null" {
+		return ""
+	}
+	return string(bs)
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func newCsvWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w)}
+	return cw, cw.w.Write(columns)
+}
+
+func (cw *csvWriter) WriteRow(values []interface{}) error {
+	record := make([]string, len(values))
+	for i, v := range values {
+		record[i] = FormatValue(v)
+	}
+	return cw.w.Write(record)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// ndjsonWriter Writes a JSON object per line with the keys in column order
+type ndjsonWriter struct {
+	w    *bufio.Writer
+	keys [][]byte
+}
+
+func newNdjsonWriter(w io.Writer, columns []string) (*ndjsonWriter, error) {
+	nw := &ndjsonWriter{w: bufio.NewWriter(w), keys: make([][]byte, len(columns))}
+	for i, column := range columns {
+		key, err := json.Marshal(column)
+		if err != nil {
+			return nil, err
+		}
+		nw.keys[i] = key
+	}
+	return nw, nil
+}
+
+func (nw *ndjsonWriter) WriteRow(values []interface{}) error {
+	if len(values) != len(nw.keys) {
+		return errors.New("row does not match the export columns")
+	}
+	nw.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			nw.w.WriteByte(',')
+		}
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		nw.w.Write(nw.keys[i])
+		nw.w.WriteByte(':')
+		nw.w.Write(bs)
+	}
+	nw.w.WriteByte('}')
+	return nw.w.WriteByte('\n')
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nw.w.Flush()
+}
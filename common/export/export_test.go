@@ -0,0 +1,110 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/360EntSecGroup-Skylar/excelize"
+)
+
+var testColumns = []string{"time", "host", "severity", "message", "sd"}
+
+func testRows() [][]interface{} {
+	ts := time.Date(2026, 10, 18, 9, 30, 0, 0, time.UTC)
+	return [][]interface{}{
+		{ts, "sw-core-1", int64(3), "link down, \"eth0\"", map[string]interface{}{"ip": "10.0.0.1"}},
+		{time.Time{}, "fw-1", int64(6), "bad\x01char <tag>", nil},
+	}
+}
+
+func writeAll(t *testing.T, format string) []byte {
+	var buf bytes.Buffer
+	w, err := New(format, &buf, testColumns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range testRows() {
+		if err = w.WriteRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCsv(t *testing.T) {
+	want := "time,host,severity,message,sd\n" +
+		"2026-10-18 09:30:00,sw-core-1,3,\"link down, \"\"eth0\"\"\",\"{\"\"ip\"\":\"\"10.0.0.1\"\"}\"\n" +
+		",fw-1,6,bad\x01char <tag>,\n"
+	if got := string(writeAll(t, FormatCsv)); got != want {
+		t.Errorf("unexpected csv\n%s", got)
+	}
+}
+
+func TestNdjson(t *testing.T) {
+	lines := strings.Split(strings.TrimSpace(string(writeAll(t, FormatNdjson))), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %d", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], `{"time":"2026-10-18T09:30:00Z","host":"sw-core-1","severity":3,`) {
+		t.Errorf("keys out of column order: %s", lines[0])
+	}
+	var row map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &row); err != nil {
+		t.Fatal(err)
+	}
+	if row["host"] != "fw-1" || row["sd"] != nil {
+		t.Errorf("unexpected row %v", row)
+	}
+}
+
+func TestXlsx(t *testing.T) {
+	xlsx, err := excelize.OpenReader(bytes.NewReader(writeAll(t, FormatXlsx)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows := xlsx.GetRows("Sheet1")
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+	if rows[0][0] != "time" || rows[1][0] != "2026-10-18 09:30:00" || rows[1][2] != "3" {
+		t.Errorf("unexpected rows %v", rows)
+	}
+	if rows[2][0] != "" || rows[2][3] != "bad�char <tag>" {
+		t.Errorf("unexpected rows %v", rows)
+	}
+}
+
+func TestXlsxRowLimit(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := newXlsxWriter(&buf, []string{"n"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.rows = MaxXlsxRows - 1
+	if err = w.WriteRow([]interface{}{1}); err != nil {
+		t.Fatal(err)
+	}
+	if err = w.WriteRow([]interface{}{2}); err != ErrTooManyRows {
+		t.Fatalf("expected ErrTooManyRows, got %v", err)
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(index); got != want {
+			t.Errorf("%d: got %s, want %s", index, got, want)
+		}
+	}
+}
+
+func TestUnsupported(t *testing.T) {
+	if _, err := New("pdf", &bytes.Buffer{}, testColumns); err == nil || Supported("pdf") {
+		t.Fatal("pdf must not be supported")
+	}
+}
@@ -0,0 +1,139 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"unicode/utf8"
+)
+
+// MaxXlsxRows The row limit of a sheet, the header takes the first row
+const MaxXlsxRows = 1048576
+
+// maxCellText The longest text a cell holds
+const maxCellText = 32767
+
+// ErrTooManyRows The export does not fit in a single sheet
+var ErrTooManyRows = errors.New("xlsx export is limited to 1048575 rows, use csv or ndjson")
+
+var xlsxParts = []struct {
+	name string
+	body string
+}{
+	{"[Content_Types].xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`},
+	{"xl/_rels/workbook.xml.rels", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// xlsxWriter Writes a single sheet workbook, the sheet is the last zip entry
+// so its rows are compressed straight to the output with inline strings
+// instead of a shared string table that would have to be kept in memory
+type xlsxWriter struct {
+	zw   *zip.Writer
+	w    *bufio.Writer
+	rows int
+}
+
+func newXlsxWriter(w io.Writer, columns []string) (*xlsxWriter, error) {
+	zw := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		fw, err := zw.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err = io.WriteString(fw, part.body); err != nil {
+			return nil, err
+		}
+	}
+	fw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	xw := &xlsxWriter{zw: zw, w: bufio.NewWriter(fw)}
+	xw.w.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n" +
+		`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	header := make([]interface{}, len(columns))
+	for i, column := range columns {
+		header[i] = column
+	}
+	return xw, xw.WriteRow(header)
+}
+
+func (xw *xlsxWriter) WriteRow(values []interface{}) error {
+	if xw.rows >= MaxXlsxRows {
+		return ErrTooManyRows
+	}
+	xw.rows++
+	row := strconv.Itoa(xw.rows)
+	xw.w.WriteString(`<row r="` + row + `">`)
+	for i, v := range values {
+		ref := columnName(i) + row
+		switch v.(type) {
+		case int, int32, int64, uint64, float32, float64:
+			xw.w.WriteString(`<c r="` + ref + `"><v>`)
+			xw.w.WriteString(FormatValue(v))
+			xw.w.WriteString("</v></c>")
+		default:
+			text := FormatValue(v)
+			if text == "" {
+				continue
+			}
+			xw.w.WriteString(`<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">`)
+			// EscapeText also replaces the control characters XML does not allow
+			if err := xml.EscapeText(xw.w, []byte(truncateText(text))); err != nil {
+				return err
+			}
+			xw.w.WriteString("</t></is></c>")
+		}
+	}
+	_, err := xw.w.WriteString("</row>")
+	return err
+}
+
+func (xw *xlsxWriter) Close() error {
+	xw.w.WriteString("</sheetData></worksheet>")
+	if err := xw.w.Flush(); err != nil {
+		return err
+	}
+	return xw.zw.Close()
+}
+
+// columnName The letters of a zero based column index, A to Z, AA and so on
+func columnName(index int) string {
+	var name []byte
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = append([]byte{byte('A' + (index-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+// truncateText Cut a text to the cell limit on a rune boundary
+func truncateText(text string) string {
+	if len(text) <= maxCellText {
+		return text
+	}
+	text = text[:maxCellText]
+	for len(text) > 0 && !utf8.ValidString(text) {
+		text = text[:len(text)-1]
+	}
+	return text
+}
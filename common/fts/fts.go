@@ -0,0 +1,339 @@
+// Package fts parses a search box query into a Postgres full-text query
+// and into an equivalent LIKE condition for databases without the index.
+//
+// Syntax: words are ANDed, OR and NOT (or a leading -) combine them,
+// "quoted phrases" match adjacent words, word* matches a prefix and
+// parentheses group expressions. AND, OR and NOT are case-sensitive so
+// that lower case words are still searchable.
+package fts
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Config The text search configuration, simple does not stem or drop
+// stop words, which suits host names, addresses and identifiers
+const Config = "simple"
+
+type nodeKind int
+
+const (
+	nodeTerm nodeKind = iota
+	nodeAnd
+	nodeOr
+	nodeNot
+)
+
+// Node A parsed query expression
+type Node struct {
+	kind     nodeKind
+	words    []string // a phrase has more than one word
+	prefix   bool
+	children []*Node
+}
+
+type token struct {
+	text   string
+	quoted bool
+}
+
+func tokenize(query string) []token {
+	var tokens []token
+	var buf strings.Builder
+	flush := func() {
+		if buf.Len() > 0 {
+			tokens = append(tokens, token{text: buf.String()})
+			buf.Reset()
+		}
+	}
+	runes := []rune(query)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"':
+			flush()
+			j := i + 1
+			for j < len(runes) && runes[j] != '"' {
+				j++
+			}
+			tokens = append(tokens, token{text: string(runes[i+1 : j]), quoted: true})
+			i = j
+		case r == '(' || r == ')':
+			flush()
+			tokens = append(tokens, token{text: string(r)})
+		case unicode.IsSpace(r):
+			flush()
+		case r == '-' && buf.Len() == 0:
+			// a leading minus negates the next word or phrase
+			tokens = append(tokens, token{text: "NOT"})
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	flush()
+	return tokens
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) isOp(op string) bool {
+	t, ok := p.peek()
+	return ok && !t.quoted && t.text == op
+}
+
+// Parse Parse a query, returns nil for an empty query
+func Parse(query string) (*Node, error) {
+	p := &parser{tokens: tokenize(query)}
+	if len(p.tokens) == 0 {
+		return nil, nil
+	}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %q in search query", p.tokens[p.pos].text)
+	}
+	return node, nil
+}
+
+func (p *parser) parseOr() (*Node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	children := []*Node{left}
+	for p.isOp("OR") || p.isOp("|") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, right)
+	}
+	if len(children) == 1 {
+		return left, nil
+	}
+	return &Node{kind: nodeOr, children: children}, nil
+}
+
+func (p *parser) parseAnd() (*Node, error) {
+	var children []*Node
+	for {
+		t, ok := p.peek()
+		if !ok || (!t.quoted && (t.text == ")" || t.text == "OR" || t.text == "|")) {
+			break
+		}
+		if !t.quoted && (t.text == "AND" || t.text == "&") {
+			p.pos++
+			continue
+		}
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if node != nil {
+			children = append(children, node)
+		}
+	}
+	switch len(children) {
+	case 0:
+		return nil, fmt.Errorf("search query has an empty expression")
+	case 1:
+		return children[0], nil
+	}
+	return &Node{kind: nodeAnd, children: children}, nil
+}
+
+func (p *parser) parseNot() (*Node, error) {
+	if p.isOp("NOT") || p.isOp("!") {
+		p.pos++
+		child, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if child == nil {
+			// NOT of an empty phrase or a lone * would exclude everything
+			return nil, fmt.Errorf("search query negates an empty term")
+		}
+		return &Node{kind: nodeNot, children: []*Node{child}}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (*Node, error) {
+	t, ok := p.peek()
+	if !ok {
+		return nil, fmt.Errorf("search query ends unexpectedly")
+	}
+	p.pos++
+	if !t.quoted && t.text == "(" {
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOp(")") {
+			return nil, fmt.Errorf("missing ) in search query")
+		}
+		p.pos++
+		return node, nil
+	}
+	if !t.quoted && t.text == ")" {
+		return nil, fmt.Errorf("unexpected ) in search query")
+	}
+	node := &Node{kind: nodeTerm}
+	text := t.text
+	if !t.quoted && strings.HasSuffix(text, "*") {
+		node.prefix = true
+		text = strings.TrimRight(text, "*")
+	}
+	node.words = strings.Fields(text)
+	if len(node.words) == 0 {
+		// an empty phrase or a lone * matches everything, drop it
+		return nil, nil
+	}
+	return node, nil
+}
+
+// Validate Check that every operator of an expression has its operands, TsQuery, Like
+// and Match assume it. Parse only returns valid expressions
+func (n *Node) Validate() error {
+	if n.kind == nodeTerm {
+		if len(n.words) == 0 {
+			return fmt.Errorf("search query has an empty term")
+		}
+		return nil
+	}
+	if len(n.children) == 0 {
+		return fmt.Errorf("search query has an operator without operands")
+	}
+	for _, child := range n.children {
+		if child == nil {
+			return fmt.Errorf("search query has an operator without operands")
+		}
+		if err := child.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// quoteLexeme Quote a word as a tsquery lexeme
+func quoteLexeme(word string) string {
+	word = strings.ReplaceAll(word, `\`, `\\`)
+	return "'" + strings.ReplaceAll(word, "'", "''") + "'"
+}
+
+// TsQuery Render the expression in to_tsquery syntax
+func (n *Node) TsQuery() string {
+	switch n.kind {
+	case nodeTerm:
+		lexemes := make([]string, len(n.words))
+		for i, w := range n.words {
+			lexemes[i] = quoteLexeme(w)
+		}
+		if n.prefix {
+			lexemes[len(lexemes)-1] += ":*"
+		}
+		if len(lexemes) == 1 {
+			return lexemes[0]
+		}
+		return "(" + strings.Join(lexemes, " <-> ") + ")"
+	case nodeNot:
+		return "!" + n.children[0].TsQuery()
+	default:
+		op := " & "
+		if n.kind == nodeOr {
+			op = " | "
+		}
+		parts := make([]string, len(n.children))
+		for i, c := range n.children {
+			parts[i] = c.TsQuery()
+		}
+		return "(" + strings.Join(parts, op) + ")"
+	}
+}
+
+// escapeLike Escape the LIKE wildcards of a literal value
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// Like Render the expression as ILIKE conditions on column
+func (n *Node) Like(column string) (string, []interface{}) {
+	switch n.kind {
+	case nodeTerm:
+		return column + " ILIKE ?", []interface{}{"%" + escapeLike(strings.Join(n.words, " ")) + "%"}
+	case nodeNot:
+		sql, args := n.children[0].Like(column)
+		return "NOT (" + sql + ")", args
+	default:
+		op := " AND "
+		if n.kind == nodeOr {
+			op = " OR "
+		}
+		parts := make([]string, len(n.children))
+		var args []interface{}
+		for i, c := range n.children {
+			sql, cargs := c.Like(column)
+			parts[i] = sql
+			args = append(args, cargs...)
+		}
+		return "(" + strings.Join(parts, op) + ")", args
+	}
+}
+
+// Match Evaluate the expression on a text in memory with the semantics of Like
+func (n *Node) Match(text string) bool {
+	return n.match(strings.ToLower(text))
+}
+
+func (n *Node) match(lower string) bool {
+	switch n.kind {
+	case nodeTerm:
+		return strings.Contains(lower, strings.ToLower(strings.Join(n.words, " ")))
+	case nodeNot:
+		return !n.children[0].match(lower)
+	case nodeAnd:
+		for _, c := range n.children {
+			if !c.match(lower) {
+				return false
+			}
+		}
+		return true
+	default:
+		for _, c := range n.children {
+			if c.match(lower) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// TsVector The indexed document expression for column
+func TsVector(column string) string {
+	return fmt.Sprintf("to_tsvector('%s', %s)", Config, column)
+}
+
+// Match The full-text condition on column, the query is passed as the single argument
+func Match(column string) string {
+	return fmt.Sprintf("%s @@ to_tsquery('%s', ?)", TsVector(column), Config)
+}
+
+// Rank The rank expression on column, the query is passed as the single argument
+func Rank(column string) string {
+	return fmt.Sprintf("ts_rank(%s, to_tsquery('%s', ?))", TsVector(column), Config)
+}
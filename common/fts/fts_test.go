@@ -0,0 +1,106 @@
+package fts
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTsQuery(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"error", "'error'"},
+		{"link down", "('link' & 'down')"},
+		{"link AND down", "('link' & 'down')"},
+		{"link OR down", "('link' | 'down')"},
+		{"link -down", "('link' & !'down')"},
+		{"link NOT down", "('link' & !'down')"},
+		{`"link down" eth*`, "(('link' <-> 'down') & 'eth':*)"},
+		{"(error OR fail) sshd", "(('error' | 'fail') & 'sshd')"},
+		{"a b OR c", "(('a' & 'b') | 'c')"},
+		{"o'brien", "'o''brien'"},
+		{`"" error`, "'error'"},
+	}
+	for _, tt := range tests {
+		node, err := Parse(tt.query)
+		if err != nil {
+			t.Fatalf("%s: %s", tt.query, err)
+		}
+		if got := node.TsQuery(); got != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestParseEmpty(t *testing.T) {
+	node, err := Parse("   ")
+	if err != nil || node != nil {
+		t.Fatalf("empty query: %v %v", node, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, query := range []string{"(error", "error)", "error OR", "NOT", "error -*", `NOT ""`, "a OR -*", "NOT NOT *"} {
+		if _, err := Parse(query); err == nil {
+			t.Errorf("%s: expected an error", query)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	node, err := Parse(`"link down" OR (error -timeout) eth*`)
+	if err != nil || node.Validate() != nil {
+		t.Fatalf("valid query: %v", err)
+	}
+	bad := []*Node{
+		{kind: nodeNot, children: []*Node{nil}},
+		{kind: nodeAnd, children: []*Node{{kind: nodeTerm, words: []string{"a"}}, {kind: nodeNot}}},
+		{kind: nodeTerm},
+	}
+	for _, node := range bad {
+		if node.Validate() == nil {
+			t.Errorf("%+v: expected an error", node)
+		}
+	}
+}
+
+func TestLike(t *testing.T) {
+	node, err := Parse(`"link down" OR -100%`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql, args := node.Like("message")
+	if sql != "(message ILIKE ? OR NOT (message ILIKE ?))" {
+		t.Errorf("unexpected sql %s", sql)
+	}
+	want := []interface{}{"%link down%", `%100\%%`}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("unexpected args %v", args)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	if got := Match("message"); got != "to_tsvector('simple', message) @@ to_tsquery('simple', ?)" {
+		t.Errorf("unexpected match %s", got)
+	}
+}
+
+func TestNodeMatch(t *testing.T) {
+	node, err := Parse(`"Link Down" OR (error -timeout) eth*`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]bool{
+		"Interface eth0 link down":          true,
+		"error on eth1":                     true,
+		"error on eth1 after timeout":       false,
+		"error without interface":           false,
+		"link is down on eth2, error later": true,
+	}
+	for text, want := range tests {
+		if got := node.Match(text); got != want {
+			t.Errorf("%s: got %v, want %v", text, got, want)
+		}
+	}
+}
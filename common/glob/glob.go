@@ -0,0 +1,50 @@
+// Package glob matches hostnames and appnames against shell patterns
+// in memory and translates the patterns to SQL LIKE.
+package glob
+
+import (
+	"path"
+	"strings"
+)
+
+// Match Whether value matches the pattern, an empty pattern or * matches everything
+// and a bad pattern matches nothing
+func Match(pattern, value string) bool {
+	if pattern == "" || pattern == "*" {
+		return true
+	}
+	ok, err := path.Match(pattern, value)
+	return err == nil && ok
+}
+
+// Quote A pattern that matches only the literal value
+func Quote(value string) string {
+	var sb strings.Builder
+	for _, c := range value {
+		switch c {
+		case '*', '?', '[', '\\':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// ToLike Convert a glob pattern to a SQL LIKE pattern, escaping the LIKE wildcards
+func ToLike(glob string) string {
+	var sb strings.Builder
+	for _, c := range glob {
+		switch c {
+		case '*':
+			sb.WriteRune('%')
+		case '?':
+			sb.WriteRune('_')
+		case '%', '_', '\\':
+			sb.WriteRune('\\')
+			sb.WriteRune(c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,39 @@
+package glob
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	cases := []struct {
+		pattern, value string
+		want           bool
+	}{
+		{"", "core-sw1", true},
+		{"*", "core-sw1", true},
+		{"core-*", "core-sw1", true},
+		{"core-sw?", "core-sw12", false},
+		{"edge-*", "core-sw1", false},
+		{"[", "[", false},
+	}
+	for _, c := range cases {
+		if got := Match(c.pattern, c.value); got != c.want {
+			t.Errorf("Match(%q, %q) = %v", c.pattern, c.value, got)
+		}
+	}
+}
+
+func TestToLike(t *testing.T) {
+	if got := ToLike(`core_sw?-*%\`); got != `core\_sw_-%\%\\` {
+		t.Fatalf("unexpected %s", got)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	for _, value := range []string{"core-sw1", "core-*", "sw?[1]", `a\b`} {
+		if !Match(Quote(value), value) {
+			t.Errorf("%s does not match itself", value)
+		}
+	}
+	if Match(Quote("core-*"), "core-sw1") {
+		t.Error("quoted * matches other values")
+	}
+}
@@ -0,0 +1,125 @@
+package logparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/talkincode/logsight/common/glob"
+)
+
+const (
+	PatternRegex = "regex"
+	PatternGrok  = "grok"
+
+	ActionExtract = "extract"
+	ActionDrop    = "drop"
+
+	// SeverityKeep The rule does not change the severity
+	SeverityKeep = -1
+)
+
+// Rule A parsing rule, Hostname and Appname are glob patterns, empty matches everything
+type Rule struct {
+	Name        string
+	Hostname    string
+	Appname     string
+	PatternType string
+	Pattern     string
+	Action      string
+	Severity    int
+	Tags        []string
+	Namespace   string
+}
+
+type compiledRule struct {
+	Rule
+	re *regexp.Regexp
+}
+
+// Result The combined outcome of all rules matching a message
+type Result struct {
+	Matched  []string                     `json:"matched"`
+	Drop     bool                         `json:"drop"`
+	Severity int                          `json:"severity"`
+	Tags     []string                     `json:"tags"`
+	Fields   map[string]map[string]string `json:"fields"`
+}
+
+// Engine Applies rules in order, a matching drop rule stops the evaluation
+type Engine struct {
+	rules []compiledRule
+}
+
+// NewEngine Compile the rules, rules that fail to compile are skipped and reported
+func NewEngine(rules []Rule) (*Engine, []error) {
+	grok := NewGrok()
+	e := &Engine{}
+	var errs []error
+	for _, rule := range rules {
+		re, err := CompileRule(grok, rule)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rule %s: %w", rule.Name, err))
+			continue
+		}
+		if rule.Namespace == "" {
+			rule.Namespace = "fields"
+		}
+		e.rules = append(e.rules, compiledRule{Rule: rule, re: re})
+	}
+	return e, errs
+}
+
+// CompileRule Compile the pattern of a rule, an empty pattern matches every message
+func CompileRule(grok *Grok, rule Rule) (*regexp.Regexp, error) {
+	switch {
+	case strings.TrimSpace(rule.Pattern) == "":
+		return nil, nil
+	case rule.PatternType == PatternGrok:
+		return grok.Compile(rule.Pattern)
+	default:
+		return regexp.Compile(rule.Pattern)
+	}
+}
+
+// Len Number of active rules
+func (e *Engine) Len() int {
+	return len(e.rules)
+}
+
+func (e *Engine) Apply(hostname, appname, message string) Result {
+	result := Result{Severity: SeverityKeep}
+	for _, rule := range e.rules {
+		if !glob.Match(rule.Hostname, hostname) || !glob.Match(rule.Appname, appname) {
+			continue
+		}
+		var fields map[string]string
+		if rule.re != nil {
+			var ok bool
+			if fields, ok = ExtractFields(rule.re, message); !ok {
+				continue
+			}
+		}
+		result.Matched = append(result.Matched, rule.Name)
+		if rule.Action == ActionDrop {
+			result.Drop = true
+			return result
+		}
+		if len(fields) > 0 {
+			if result.Fields == nil {
+				result.Fields = make(map[string]map[string]string)
+			}
+			if result.Fields[rule.Namespace] == nil {
+				result.Fields[rule.Namespace] = make(map[string]string)
+			}
+			for k, v := range fields {
+				result.Fields[rule.Namespace][k] = v
+			}
+		}
+		if rule.Severity >= 0 && rule.Severity <= 7 {
+			result.Severity = rule.Severity
+		}
+		result.Tags = append(result.Tags, rule.Tags...)
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+// Package logparse extracts fields from log messages with regular
+// expressions or grok patterns and applies rewrite rules to them.
+package logparse
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var grokRef = regexp.MustCompile(`%\{(\w+)(?::([\w.@-]+))?(?::\w+)?\}`)
+
+var invalidGroupChars = regexp.MustCompile(`\W`)
+
+// Grok Expand %{PATTERN} and %{PATTERN:field} references into regular expressions
+type Grok struct {
+	patterns map[string]string
+}
+
+// NewGrok Create a grok compiler with the built-in pattern library
+func NewGrok() *Grok {
+	g := &Grok{patterns: make(map[string]string, len(BasePatterns)+len(VendorPatterns))}
+	for name, pattern := range BasePatterns {
+		g.patterns[name] = pattern
+	}
+	for name, pattern := range VendorPatterns {
+		g.patterns[name] = pattern
+	}
+	return g
+}
+
+// AddPattern Add or replace a named pattern
+func (g *Grok) AddPattern(name, pattern string) {
+	g.patterns[name] = pattern
+}
+
+// Expand Replace all pattern references, returns a regular expression
+func (g *Grok) Expand(pattern string) (string, error) {
+	return g.expand(pattern, 0)
+}
+
+// Compile Expand and compile a grok pattern
+func (g *Grok) Compile(pattern string) (*regexp.Regexp, error) {
+	expr, err := g.Expand(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return regexp.Compile(expr)
+}
+
+func (g *Grok) expand(pattern string, depth int) (string, error) {
+	if depth > 16 {
+		return "", fmt.Errorf("grok pattern nested too deep: %s", pattern)
+	}
+	var err error
+	result := grokRef.ReplaceAllStringFunc(pattern, func(ref string) string {
+		if err != nil {
+			return ""
+		}
+		m := grokRef.FindStringSubmatch(ref)
+		sub, ok := g.patterns[m[1]]
+		if !ok {
+			err = fmt.Errorf("unknown grok pattern %s", m[1])
+			return ""
+		}
+		var expanded string
+		expanded, err = g.expand(sub, depth+1)
+		if m[2] == "" {
+			return "(?:" + expanded + ")"
+		}
+		return "(?P<" + groupName(m[2]) + ">" + expanded + ")"
+	})
+	return result, err
+}
+
+// groupName Field names may contain characters that are not valid in a group name
+func groupName(field string) string {
+	return invalidGroupChars.ReplaceAllString(field, "_")
+}
+
+// ExtractFields Match message against re, returns the named groups that matched
+func ExtractFields(re *regexp.Regexp, message string) (map[string]string, bool) {
+	m := re.FindStringSubmatch(message)
+	if m == nil {
+		return nil, false
+	}
+	fields := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name == "" || m[i] == "" {
+			continue
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = strings.TrimSpace(m[i])
+		}
+	}
+	return fields, true
+}
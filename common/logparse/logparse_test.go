@@ -0,0 +1,131 @@
+package logparse
+
+import (
+	"testing"
+)
+
+func TestGrokLibrary(t *testing.T) {
+	grok := NewGrok()
+	for _, item := range Library() {
+		if _, err := grok.Compile(item.Pattern); err != nil {
+			t.Errorf("pattern %s: %s", item.Name, err)
+		}
+	}
+}
+
+func TestGrokUnknownPattern(t *testing.T) {
+	if _, err := NewGrok().Compile("%{NOT_A_PATTERN:x}"); err == nil {
+		t.Fatal("expected unknown pattern error")
+	}
+}
+
+func TestVendorPatterns(t *testing.T) {
+	cases := []struct {
+		pattern string
+		message string
+		expect  map[string]string
+	}{
+		{
+			"%{SSHD}",
+			"Failed password for invalid user admin from 192.168.1.10 port 53122 ssh2",
+			map[string]string{"ssh_result": "Failed", "ssh_method": "password", "user": "admin", "src_ip": "192.168.1.10", "src_port": "53122"},
+		},
+		{
+			"%{SSHD}",
+			"Invalid user oracle from 10.1.1.1 port 4022",
+			map[string]string{"user": "oracle", "src_ip": "10.1.1.1", "src_port": "4022"},
+		},
+		{
+			"%{NGINX_ACCESS}",
+			`10.0.0.8 - - [12/Mar/2024:10:01:02 +0800] "GET /api/v1/users?id=1 HTTP/1.1" 404 153 "-" "curl/8.1.2"`,
+			map[string]string{"client_ip": "10.0.0.8", "method": "GET", "request": "/api/v1/users?id=1", "status": "404", "body_bytes": "153", "user_agent": "curl/8.1.2"},
+		},
+		{
+			"%{IPTABLES}",
+			"DROP IN=eth0 OUT= MAC=00:16:3e:00:00:01:00:16:3e:00:00:02:08:00 SRC=1.2.3.4 DST=10.0.0.1 LEN=60 TOS=0x00 PREC=0x00 TTL=52 ID=1 DF PROTO=TCP SPT=51234 DPT=22 WINDOW=29200 RES=0x00 SYN URGP=0",
+			map[string]string{"in_iface": "eth0", "src_ip": "1.2.3.4", "dst_ip": "10.0.0.1", "proto": "TCP", "src_port": "51234", "dst_port": "22"},
+		},
+		{
+			"%{CISCO_MSG}",
+			"%LINK-3-UPDOWN: Interface GigabitEthernet0/1, changed state to down",
+			map[string]string{"facility": "LINK", "vendor_severity": "3", "mnemonic": "UPDOWN", "detail": "Interface GigabitEthernet0/1, changed state to down"},
+		},
+		{
+			"%{RUIJIE_MSG}",
+			"%LINEPROTO-5-UPDOWN: Line protocol on Interface GigabitEthernet 0/1, changed state to up",
+			map[string]string{"facility": "LINEPROTO", "vendor_severity": "5", "mnemonic": "UPDOWN"},
+		},
+		{
+			"%{HUAWEI_MSG}",
+			"%%01IFNET/4/LINK_STATE(l)[0]:The line protocol IP on the interface GigabitEthernet0/0/1 has entered the DOWN state.",
+			map[string]string{"version": "01", "module": "IFNET", "vendor_severity": "4", "mnemonic": "LINK_STATE", "log_type": "l", "seq": "0"},
+		},
+		{
+			"%{H3C_MSG}",
+			"H3C %%10IFNET/3/PHY_UPDOWN(l): Physical state on the interface GigabitEthernet1/0/1 changed to down.",
+			map[string]string{"module": "IFNET", "vendor_severity": "3", "mnemonic": "PHY_UPDOWN", "log_type": "l"},
+		},
+		{
+			"%{MIKROTIK}",
+			"login failure for user admin from 203.0.113.9 via winbox",
+			map[string]string{"user": "admin", "src_ip": "203.0.113.9", "service": "winbox"},
+		},
+		{
+			"%{MIKROTIK}",
+			"ether1 link down",
+			map[string]string{"interface": "ether1", "link_state": "down"},
+		},
+	}
+	grok := NewGrok()
+	for _, c := range cases {
+		re, err := grok.Compile(c.pattern)
+		if err != nil {
+			t.Fatalf("%s: %s", c.pattern, err)
+		}
+		fields, ok := ExtractFields(re, c.message)
+		if !ok {
+			t.Errorf("%s did not match %q", c.pattern, c.message)
+			continue
+		}
+		for k, v := range c.expect {
+			if fields[k] != v {
+				t.Errorf("%s: field %s expected %q, got %q", c.pattern, k, v, fields[k])
+			}
+		}
+	}
+}
+
+func TestEngine(t *testing.T) {
+	engine, errs := NewEngine([]Rule{
+		{Name: "drop-cron", Appname: "CRON", Action: ActionDrop},
+		{Name: "ssh", Appname: "sshd", PatternType: PatternGrok, Pattern: "%{SSHD_AUTH}", Action: ActionExtract, Severity: SeverityKeep, Tags: []string{"auth"}, Namespace: "ssh"},
+		{Name: "ssh-fail", Appname: "ssh*", PatternType: PatternRegex, Pattern: `^Failed `, Action: ActionExtract, Severity: 4, Tags: []string{"auth-failure"}},
+		{Name: "broken", PatternType: PatternRegex, Pattern: `(`},
+	})
+	if len(errs) != 1 || engine.Len() != 3 {
+		t.Fatalf("expected 1 compile error and 3 rules, got %v and %d", errs, engine.Len())
+	}
+
+	if r := engine.Apply("host1", "CRON", "(root) CMD (run-parts /etc/cron.hourly)"); !r.Drop {
+		t.Errorf("expected cron message to be dropped")
+	}
+
+	r := engine.Apply("host1", "sshd", "Failed password for root from 10.0.0.1 port 22 ssh2")
+	if r.Drop || len(r.Matched) != 2 {
+		t.Fatalf("unexpected result %+v", r)
+	}
+	if r.Severity != 4 {
+		t.Errorf("expected severity 4, got %d", r.Severity)
+	}
+	if r.Fields["ssh"]["user"] != "root" || r.Fields["ssh"]["src_ip"] != "10.0.0.1" {
+		t.Errorf("unexpected fields %v", r.Fields)
+	}
+	if len(r.Tags) != 2 || r.Tags[0] != "auth" || r.Tags[1] != "auth-failure" {
+		t.Errorf("unexpected tags %v", r.Tags)
+	}
+
+	r = engine.Apply("host1", "nginx", "GET / 200")
+	if len(r.Matched) != 0 || r.Severity != SeverityKeep {
+		t.Errorf("expected no match, got %+v", r)
+	}
+}
@@ -0,0 +1,103 @@
+package logparse
+
+import (
+	"sort"
+)
+
+// BasePatterns General purpose grok patterns, compatible with the logstash names
+var BasePatterns = map[string]string{
+	"USERNAME":          `[a-zA-Z0-9._-]+`,
+	"USER":              `%{USERNAME}`,
+	"INT":               `(?:[+-]?(?:[0-9]+))`,
+	"POSINT":            `\b(?:[1-9][0-9]*)\b`,
+	"BASE10NUM":         `(?:[+-]?(?:(?:[0-9]+(?:\.[0-9]+)?)|(?:\.[0-9]+)))`,
+	"NUMBER":            `(?:%{BASE10NUM})`,
+	"WORD":              `\b\w+\b`,
+	"NOTSPACE":          `\S+`,
+	"SPACE":             `\s*`,
+	"DATA":              `.*?`,
+	"GREEDYDATA":        `.*`,
+	"QUOTEDSTRING":      `"(?:[^"\\]|\\.)*"`,
+	"IPV4":              `(?:(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)\.){3}(?:25[0-5]|2[0-4][0-9]|[01]?[0-9][0-9]?)`,
+	"IPV6":              `(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f]{0,4}(?:%[0-9A-Za-z]+)?`,
+	"IP":                `(?:%{IPV6}|%{IPV4})`,
+	"HOSTNAME":          `\b(?:[0-9A-Za-z][0-9A-Za-z-]{0,62})(?:\.(?:[0-9A-Za-z][0-9A-Za-z-]{0,62}))*\b`,
+	"IPORHOST":          `(?:%{IP}|%{HOSTNAME})`,
+	"MAC":               `(?:(?:[0-9A-Fa-f]{2}[:-]){5}[0-9A-Fa-f]{2}|(?:[0-9A-Fa-f]{4}[.-]){2}[0-9A-Fa-f]{4})`,
+	"MONTH":             `\b(?:[Jj]an|[Ff]eb|[Mm]ar|[Aa]pr|[Mm]ay|[Jj]un|[Jj]ul|[Aa]ug|[Ss]ep|[Oo]ct|[Nn]ov|[Dd]ec)\w*\b`,
+	"MONTHDAY":          `(?:(?:0[1-9])|(?:[12][0-9])|(?:3[01])|[1-9])`,
+	"YEAR":              `(?:\d\d){1,2}`,
+	"HOUR":              `(?:2[0123]|[01]?[0-9])`,
+	"MINUTE":            `(?:[0-5][0-9])`,
+	"SECOND":            `(?:(?:[0-5]?[0-9]|60)(?:[:.,][0-9]+)?)`,
+	"TIME":              `%{HOUR}:%{MINUTE}(?::%{SECOND})?`,
+	"ISO8601_TIMEZONE":  `(?:Z|[+-]%{HOUR}(?::?%{MINUTE}))`,
+	"TIMESTAMP_ISO8601": `%{YEAR}-\d\d-\d\d[T ]%{HOUR}:?%{MINUTE}(?::?%{SECOND})?%{ISO8601_TIMEZONE}?`,
+	"HTTPDATE":          `%{MONTHDAY}/%{MONTH}/%{YEAR}:%{TIME} %{INT}`,
+	"INTERFACE":         `[A-Za-z][\w-]*(?: ?\d+(?:[/.:]\d+)*)?`,
+}
+
+// VendorPatterns Patterns for common log sources
+var VendorPatterns = map[string]string{
+	// sshd
+	"SSHD_AUTH":         `%{WORD:ssh_result} %{WORD:ssh_method} for (?:invalid user )?%{USERNAME:user} from %{IP:src_ip} port %{INT:src_port}`,
+	"SSHD_INVALID_USER": `[Ii]nvalid user (?:%{USERNAME:user} )?from %{IP:src_ip}(?: port %{INT:src_port})?`,
+	"SSHD_DISCONNECT":   `(?:Disconnected from|Connection closed by|Received disconnect from) (?:(?:invalid |authenticating )?user %{USERNAME:user} )?%{IP:src_ip} port %{INT:src_port}`,
+	"SSHD":              `(?:%{SSHD_AUTH}|%{SSHD_INVALID_USER}|%{SSHD_DISCONNECT})`,
+
+	// nginx default "combined" access log format
+	"NGINX_ACCESS": `%{IPORHOST:client_ip} - %{NOTSPACE:remote_user} \[%{HTTPDATE:time_local}\] "%{WORD:method} %{NOTSPACE:request}(?: HTTP/%{NUMBER:http_version})?" %{INT:status} %{INT:body_bytes} "%{DATA:referrer}" "%{DATA:user_agent}"`,
+
+	// netfilter LOG target
+	"IPTABLES": `IN=%{NOTSPACE:in_iface}? OUT=%{NOTSPACE:out_iface}? (?:MAC=%{NOTSPACE:mac} )?SRC=%{IP:src_ip} DST=%{IP:dst_ip} .*?PROTO=%{WORD:proto}(?: SPT=%{INT:src_port} DPT=%{INT:dst_port})?`,
+
+	// %LINK-3-UPDOWN: Interface GigabitEthernet0/1, changed state to down
+	"CISCO_MSG":  `%%{CISCO_NAME:facility}-%{INT:vendor_severity}-%{CISCO_NAME:mnemonic}: %{GREEDYDATA:detail}`,
+	"CISCO_NAME": `[\w-]+?`,
+
+	// Ruijie uses the Cisco message format
+	"RUIJIE_MSG": `%{CISCO_MSG}`,
+
+	// %%01IFNET/4/LINK_STATE(l)[0]:The line protocol IP on the interface ...
+	"HUAWEI_MSG":    `%%%{INT:version}%{HUAWEI_MODULE:module}/%{INT:vendor_severity}/%{CISCO_NAME:mnemonic}(?:\(%{WORD:log_type}\))?(?:\[%{INT:seq}\])?:\s*%{GREEDYDATA:detail}`,
+	"HUAWEI_MODULE": `[A-Za-z][\w-]*`,
+
+	// %%10IFNET/3/PHY_UPDOWN(l): ... or Comware 7 IFNET/3/PHY_UPDOWN: ...
+	"H3C_MSG": `(?:%%\d{2})?%{HUAWEI_MODULE:module}/%{INT:vendor_severity}/%{CISCO_NAME:mnemonic}(?:\(%{WORD:log_type}\))?:\s*%{GREEDYDATA:detail}`,
+
+	// RouterOS
+	"MIKROTIK_LOGIN": `(?:login failure for user %{USERNAME:user} from %{IP:src_ip} via %{WORD:service}|user %{USERNAME:user} logged (?:in|out) from %{IP:src_ip} via %{WORD:service})`,
+	"MIKROTIK_LINK":  `%{NOTSPACE:interface} link (?P<link_state>up|down)`,
+	"MIKROTIK_DHCP":  `%{NOTSPACE:dhcp_server} (?:assigned|deassigned) %{IP:client_ip} (?:to|from) %{MAC:client_mac}`,
+	"MIKROTIK":       `(?:%{MIKROTIK_LOGIN}|%{MIKROTIK_LINK}|%{MIKROTIK_DHCP})`,
+}
+
+var vendorPatternRemarks = map[string]string{
+	"SSHD":           "sshd authentication, invalid user and disconnect messages",
+	"NGINX_ACCESS":   "nginx access log, combined format",
+	"IPTABLES":       "iptables / netfilter LOG target",
+	"CISCO_MSG":      "Cisco IOS %FACILITY-SEVERITY-MNEMONIC messages",
+	"RUIJIE_MSG":     "Ruijie %FACILITY-SEVERITY-MNEMONIC messages",
+	"HUAWEI_MSG":     "Huawei VRP %%01MODULE/SEVERITY/BRIEF messages",
+	"H3C_MSG":        "H3C Comware MODULE/SEVERITY/MNEMONIC messages",
+	"MIKROTIK":       "MikroTik RouterOS login, link and DHCP messages",
+	"MIKROTIK_LOGIN": "MikroTik RouterOS login messages",
+}
+
+type PatternInfo struct {
+	Name    string `json:"name"`
+	Pattern string `json:"pattern"`
+	Remark  string `json:"remark"`
+}
+
+// Library The vendor patterns meant to be used directly in a rule
+func Library() []PatternInfo {
+	items := make([]PatternInfo, 0, len(vendorPatternRemarks))
+	for name, remark := range vendorPatternRemarks {
+		items = append(items, PatternInfo{Name: name, Pattern: "%{" + name + "}", Remark: remark})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return items
+}
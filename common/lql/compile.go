@@ -0,0 +1,248 @@
+package lql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/talkincode/logsight/common/fts"
+	"github.com/talkincode/logsight/common/glob"
+)
+
+// Field types
+const (
+	TypeString = "string"
+	TypeInt    = "int"
+	TypeTime   = "time"
+)
+
+// timeLayouts Absolute time values, parsed in the local time zone
+var timeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// Field A queryable column. Contains makes field:value a substring match,
+// Values maps names such as severity levels to the stored numbers
+type Field struct {
+	Column   string
+	Type     string
+	Contains bool
+	Values   map[string]int64
+}
+
+// Schema The fields a query may use, keyed by name and alias. Text is the
+// column searched by words without a field, TextIndexed selects the full-text
+// index over ILIKE (see package fts)
+type Schema struct {
+	Fields      map[string]Field
+	Text        string
+	TextIndexed bool
+}
+
+// Compiled The SQL parts of a query, expressions only contain schema columns
+// and every value is in Args
+type Compiled struct {
+	Where   string
+	Args    []interface{}
+	Select  []string // stats queries only
+	GroupBy []string
+	OrderBy []string
+	Columns []string // result column names of a stats query
+	Limit   int
+}
+
+// Compile Compile a query against the schema
+func (s *Schema) Compile(q *Query, now time.Time) (*Compiled, error) {
+	c := &Compiled{Limit: q.Limit}
+	if q.Where != nil {
+		where, args, err := s.compileExpr(q.Where, now)
+		if err != nil {
+			return nil, err
+		}
+		c.Where, c.Args = where, args
+	}
+
+	names := map[string]bool{}
+	if q.Stats != nil {
+		for _, name := range q.Stats.By {
+			field, ok := s.Fields[name]
+			if !ok {
+				return nil, fmt.Errorf("unknown field %s", name)
+			}
+			c.Select = append(c.Select, fmt.Sprintf(`%s AS "%s"`, field.Column, name))
+			c.GroupBy = append(c.GroupBy, field.Column)
+			c.Columns = append(c.Columns, name)
+			names[name] = true
+		}
+		for _, agg := range q.Stats.Aggs {
+			expr, err := s.compileAgg(agg)
+			if err != nil {
+				return nil, err
+			}
+			c.Select = append(c.Select, fmt.Sprintf(`%s AS "%s"`, expr, agg.Name()))
+			c.Columns = append(c.Columns, agg.Name())
+			names[agg.Name()] = true
+		}
+	}
+
+	for _, key := range q.Sort {
+		dir := "ASC"
+		if key.Desc {
+			dir = "DESC"
+		}
+		switch {
+		case q.Stats != nil && names[key.Name]:
+			c.OrderBy = append(c.OrderBy, fmt.Sprintf(`"%s" %s`, key.Name, dir))
+		case q.Stats == nil:
+			field, ok := s.Fields[key.Name]
+			if !ok {
+				return nil, fmt.Errorf("unknown sort field %s", key.Name)
+			}
+			c.OrderBy = append(c.OrderBy, field.Column+" "+dir)
+		default:
+			return nil, fmt.Errorf("sort field %s is not a stats column", key.Name)
+		}
+	}
+	return c, nil
+}
+
+func (s *Schema) compileAgg(agg Agg) (string, error) {
+	if agg.Field == "" {
+		return "count(*)", nil
+	}
+	field, ok := s.Fields[agg.Field]
+	if !ok {
+		return "", fmt.Errorf("unknown field %s", agg.Field)
+	}
+	switch agg.Func {
+	case "count":
+		return "count(" + field.Column + ")", nil
+	case "dc":
+		return "count(DISTINCT " + field.Column + ")", nil
+	case "avg", "sum":
+		if field.Type != TypeInt {
+			return "", fmt.Errorf("%s needs a numeric field", agg.Func)
+		}
+	}
+	return agg.Func + "(" + field.Column + ")", nil
+}
+
+func (s *Schema) compileExpr(e Expr, now time.Time) (string, []interface{}, error) {
+	switch e := e.(type) {
+	case *And:
+		return s.compileBinary(e.Left, e.Right, " AND ", now)
+	case *Or:
+		return s.compileBinary(e.Left, e.Right, " OR ", now)
+	case *Not:
+		sql, args, err := s.compileExpr(e.X, now)
+		if err != nil {
+			return "", nil, err
+		}
+		return "NOT (" + sql + ")", args, nil
+	case *Text:
+		return s.compileText(e)
+	case *Compare:
+		return s.compileCompare(e, now)
+	}
+	return "", nil, fmt.Errorf("unsupported expression %s", e)
+}
+
+func (s *Schema) compileBinary(left, right Expr, op string, now time.Time) (string, []interface{}, error) {
+	lsql, largs, err := s.compileExpr(left, now)
+	if err != nil {
+		return "", nil, err
+	}
+	rsql, rargs, err := s.compileExpr(right, now)
+	if err != nil {
+		return "", nil, err
+	}
+	return "(" + lsql + op + rsql + ")", append(largs, rargs...), nil
+}
+
+func (s *Schema) compileText(e *Text) (string, []interface{}, error) {
+	if s.Text == "" {
+		return "", nil, fmt.Errorf("%s needs a field", e)
+	}
+	value := strings.ReplaceAll(e.Value, `"`, "")
+	if e.Phrase {
+		value = `"` + value + `"`
+	}
+	node, err := fts.Parse(value)
+	if err != nil || node == nil {
+		return "", nil, fmt.Errorf("bad search term %s", e)
+	}
+	if s.TextIndexed {
+		return fts.Match(s.Text), []interface{}{node.TsQuery()}, nil
+	}
+	sql, args := node.Like(s.Text)
+	return sql, args, nil
+}
+
+func (s *Schema) compileCompare(e *Compare, now time.Time) (string, []interface{}, error) {
+	field, ok := s.Fields[e.Field]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown field %s", e.Field)
+	}
+	op := e.Op
+	switch op {
+	case ":":
+		op = "="
+	case "!=":
+		op = "<>"
+	}
+
+	switch field.Type {
+	case TypeInt:
+		value, err := strconv.ParseInt(e.Value, 10, 64)
+		if err != nil {
+			var ok bool
+			if value, ok = field.Values[strings.ToLower(e.Value)]; !ok {
+				return "", nil, fmt.Errorf("%s expects a number, got %s", e.Field, e.Value)
+			}
+		}
+		return field.Column + " " + op + " ?", []interface{}{value}, nil
+	case TypeTime:
+		value, err := parseTime(e.Value, now)
+		if err != nil {
+			return "", nil, fmt.Errorf("%s: %s", e.Field, err.Error())
+		}
+		return field.Column + " " + op + " ?", []interface{}{value}, nil
+	}
+
+	switch {
+	case op != "=" && op != "<>":
+		return "", nil, fmt.Errorf("%s does not support %s", e.Field, e.Op)
+	case strings.ContainsAny(e.Value, "*?"):
+		op = "LIKE"
+		if e.Op == "!=" {
+			op = "NOT LIKE"
+		}
+		return field.Column + " " + op + " ?", []interface{}{glob.ToLike(e.Value)}, nil
+	case field.Contains && e.Op == ":":
+		return field.Column + " ILIKE ?", []interface{}{"%" + glob.ToLike(e.Value) + "%"}, nil
+	}
+	return field.Column + " " + op + " ?", []interface{}{e.Value}, nil
+}
+
+// parseTime An absolute time or a duration relative to now such as -1h, -30m or -7d
+func parseTime(value string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		if strings.HasSuffix(value, "d") {
+			days, err := strconv.Atoi(value[:len(value)-1])
+			if err == nil {
+				return now.AddDate(0, 0, days), nil
+			}
+		} else if d, err := time.ParseDuration(value); err == nil {
+			return now.Add(d), nil
+		}
+		return time.Time{}, fmt.Errorf("bad relative time %s", value)
+	}
+	if value == "now" {
+		return now, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("bad time %s", value)
+}
@@ -0,0 +1,105 @@
+package lql
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokWord
+	tokString
+	tokOp
+	tokLParen
+	tokRParen
+	tokPipe
+	tokComma
+	tokNot
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return fmt.Sprintf("%q", t.text)
+	}
+	return t.text
+}
+
+// isWordRune Runes that may appear in an unquoted word, quote values containing others
+func isWordRune(r rune) bool {
+	if unicode.IsSpace(r) {
+		return false
+	}
+	return !strings.ContainsRune(`"()|,:=!<>`, r)
+}
+
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"':
+			var sb strings.Builder
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at %d", i)
+			}
+			tokens = append(tokens, token{kind: tokString, text: sb.String(), pos: i})
+			i = j + 1
+		case r == '(':
+			tokens = append(tokens, token{kind: tokLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokRParen, text: ")", pos: i})
+			i++
+		case r == '|':
+			tokens = append(tokens, token{kind: tokPipe, text: "|", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokComma, text: ",", pos: i})
+			i++
+		case r == ':' || r == '=':
+			tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			if i+1 < len(runes) && runes[i+1] == '=' {
+				tokens = append(tokens, token{kind: tokOp, text: string(r) + "=", pos: i})
+				i += 2
+			} else if r == '!' {
+				tokens = append(tokens, token{kind: tokNot, text: "!", pos: i})
+				i++
+			} else {
+				tokens = append(tokens, token{kind: tokOp, text: string(r), pos: i})
+				i++
+			}
+		default:
+			j := i
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+			tokens = append(tokens, token{kind: tokWord, text: string(runes[i:j]), pos: i})
+			i = j
+		}
+	}
+	return append(tokens, token{kind: tokEOF, pos: len(runes)}), nil
+}
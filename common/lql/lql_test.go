@@ -0,0 +1,136 @@
+package lql
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSchema = &Schema{
+	Fields: map[string]Field{
+		"host":     {Column: "hostname", Type: TypeString},
+		"app":      {Column: "appname", Type: TypeString},
+		"message":  {Column: "message", Type: TypeString, Contains: true},
+		"severity": {Column: "severity", Type: TypeInt, Values: map[string]int64{"err": 3, "warning": 4}},
+		"time":     {Column: "timestamp", Type: TypeTime},
+	},
+	Text: "message",
+}
+
+func compile(t *testing.T, query string) *Compiled {
+	t.Helper()
+	q, err := Parse(query)
+	if err != nil {
+		t.Fatalf("%s: %s", query, err)
+	}
+	c, err := testSchema.Compile(q, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("%s: %s", query, err)
+	}
+	return c
+}
+
+func TestCompileWhere(t *testing.T) {
+	c := compile(t, "host:core-sw* AND severity<=3 AND NOT app:cron")
+	if c.Where != "((hostname LIKE ? AND severity <= ?) AND NOT (appname = ?))" {
+		t.Errorf("unexpected where %s", c.Where)
+	}
+	want := []interface{}{"core-sw%", int64(3), "cron"}
+	if !reflect.DeepEqual(c.Args, want) {
+		t.Errorf("unexpected args %v", c.Args)
+	}
+}
+
+func TestCompileOperators(t *testing.T) {
+	tests := []struct {
+		query string
+		where string
+		args  []interface{}
+	}{
+		{"severity:err", "severity = ?", []interface{}{int64(3)}},
+		{"host!=a*", "hostname NOT LIKE ?", []interface{}{"a%"}},
+		{"-host:a OR app:b", "(NOT (hostname = ?) OR appname = ?)", []interface{}{"a", "b"}},
+		{`message:"50%"`, "message ILIKE ?", []interface{}{`%50\%%`}},
+		{"time>-1h", "timestamp > ?", []interface{}{time.Date(2024, 5, 1, 11, 0, 0, 0, time.UTC)}},
+		{`link down`, "(message ILIKE ? AND message ILIKE ?)", []interface{}{"%link%", "%down%"}},
+		{`"link down"`, "message ILIKE ?", []interface{}{"%link down%"}},
+		{`(host:a OR host:b) !app:c`, "((hostname = ? OR hostname = ?) AND NOT (appname = ?))", []interface{}{"a", "b", "c"}},
+	}
+	for _, tt := range tests {
+		c := compile(t, tt.query)
+		if c.Where != tt.where {
+			t.Errorf("%s: got %s, want %s", tt.query, c.Where, tt.where)
+		}
+		if !reflect.DeepEqual(c.Args, tt.args) {
+			t.Errorf("%s: got args %v, want %v", tt.query, c.Args, tt.args)
+		}
+	}
+}
+
+func TestCompileStats(t *testing.T) {
+	c := compile(t, "severity<=3 | stats count, max(severity) by host, app | sort -count | head 10")
+	wantSelect := []string{`hostname AS "host"`, `appname AS "app"`, `count(*) AS "count"`, `max(severity) AS "max_severity"`}
+	if !reflect.DeepEqual(c.Select, wantSelect) {
+		t.Errorf("unexpected select %v", c.Select)
+	}
+	if !reflect.DeepEqual(c.GroupBy, []string{"hostname", "appname"}) {
+		t.Errorf("unexpected group by %v", c.GroupBy)
+	}
+	if !reflect.DeepEqual(c.OrderBy, []string{`"count" DESC`}) {
+		t.Errorf("unexpected order by %v", c.OrderBy)
+	}
+	if !reflect.DeepEqual(c.Columns, []string{"host", "app", "count", "max_severity"}) {
+		t.Errorf("unexpected columns %v", c.Columns)
+	}
+	if c.Limit != 10 {
+		t.Errorf("unexpected limit %d", c.Limit)
+	}
+}
+
+func TestCompileSortRows(t *testing.T) {
+	c := compile(t, "| sort time desc, host")
+	if c.Where != "" || !reflect.DeepEqual(c.OrderBy, []string{"timestamp DESC", "hostname ASC"}) {
+		t.Errorf("unexpected order by %v", c.OrderBy)
+	}
+}
+
+func TestRejected(t *testing.T) {
+	for _, query := range []string{
+		"hostname;drop:x",
+		"host:a | sort host;drop",
+		"host:a | stats count by hostname",
+		"host:a | stats count | sort host",
+		"host<a",
+		"severity:high",
+		"time>yesterday",
+		"host:a | stats avg(host)",
+		`host:"a`,
+		"(host:a",
+		"host: | head 1",
+		"host:a | head -1",
+		"host:a | grep b",
+		// negates an empty search term
+		"--*",
+	} {
+		q, err := Parse(query)
+		if err == nil {
+			_, err = testSchema.Compile(q, time.Now())
+		}
+		if err == nil {
+			t.Errorf("%s: expected an error", query)
+		} else if strings.Contains(err.Error(), "panic") {
+			t.Errorf("%s: %s", query, err)
+		}
+	}
+}
+
+func TestHasField(t *testing.T) {
+	q, err := Parse("host:a (error OR NOT time>-1h)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.HasField("time", "timestamp") || q.HasField("app") {
+		t.Errorf("unexpected fields in %s", q.Where)
+	}
+}
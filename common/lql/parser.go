@@ -0,0 +1,340 @@
+// Package lql implements the LogSight query language.
+//
+// A query is a filter expression followed by optional pipes:
+//
+//	host:core-sw* AND severity<=3 AND NOT app:cron | stats count by host | sort -count | head 10
+//
+// Filters compare a field with a value (: = != < <= > >=), a value with * or ?
+// is a glob pattern. Words without a field search the text column, "quoted"
+// words are phrases. Terms are ANDed unless joined by OR, NOT, ! or a leading
+// - negates a term and parentheses group them. Field names are resolved through
+// a Schema and values are always query arguments, so the compiled SQL never
+// contains user input.
+package lql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Expr A node of the filter expression
+type Expr interface {
+	String() string
+}
+
+// And Both sides match
+type And struct{ Left, Right Expr }
+
+// Or Either side matches
+type Or struct{ Left, Right Expr }
+
+// Not The expression does not match
+type Not struct{ X Expr }
+
+// Compare A field comparison such as host:core-sw* or severity<=3
+type Compare struct {
+	Field string
+	Op    string
+	Value string
+}
+
+// Text A word or quoted phrase searched in the text column
+type Text struct {
+	Value  string
+	Phrase bool
+}
+
+func (e *And) String() string { return "(" + e.Left.String() + " AND " + e.Right.String() + ")" }
+func (e *Or) String() string  { return "(" + e.Left.String() + " OR " + e.Right.String() + ")" }
+func (e *Not) String() string { return "NOT " + e.X.String() }
+func (e *Compare) String() string {
+	return e.Field + e.Op + strconv.Quote(e.Value)
+}
+func (e *Text) String() string {
+	if e.Phrase {
+		return strconv.Quote(e.Value)
+	}
+	return e.Value
+}
+
+// Agg An aggregation of the stats pipe, Field is empty for count
+type Agg struct {
+	Func  string
+	Field string
+}
+
+// Name The result column name, e.g. count or max_severity
+func (a Agg) Name() string {
+	if a.Field == "" {
+		return a.Func
+	}
+	return a.Func + "_" + a.Field
+}
+
+// SortKey A sort pipe key
+type SortKey struct {
+	Name string
+	Desc bool
+}
+
+// Stats The stats pipe
+type Stats struct {
+	Aggs []Agg
+	By   []string
+}
+
+// Query A parsed query, Where is nil when the query has no filter
+type Query struct {
+	Where Expr
+	Stats *Stats
+	Sort  []SortKey
+	Limit int
+}
+
+// aggFuncs The supported aggregation functions, count takes no field
+var aggFuncs = map[string]bool{"count": true, "dc": true, "min": true, "max": true, "avg": true, "sum": true}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parse Parse a query
+func Parse(query string) (*Query, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	q := &Query{}
+	if k := p.peek().kind; k != tokPipe && k != tokEOF {
+		if q.Where, err = p.parseOr(); err != nil {
+			return nil, err
+		}
+	}
+	for p.peek().kind == tokPipe {
+		p.next()
+		if err = p.parsePipe(q); err != nil {
+			return nil, err
+		}
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %s at %d", t, t.pos)
+	}
+	return q, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isKeyword(word string) bool {
+	t := p.peek()
+	return t.kind == tokWord && t.text == word
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind == tokEOF || t.kind == tokPipe || t.kind == tokRParen || p.isKeyword("OR") {
+			return left, nil
+		}
+		if p.isKeyword("AND") {
+			p.next()
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) parseNot() (Expr, error) {
+	if p.peek().kind == tokNot || p.isKeyword("NOT") {
+		p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{X: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (Expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokLParen:
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if r := p.next(); r.kind != tokRParen {
+			return nil, fmt.Errorf("expected ) at %d, got %s", r.pos, r)
+		}
+		return x, nil
+	case tokString:
+		return &Text{Value: t.text, Phrase: true}, nil
+	case tokWord:
+		negate := false
+		name := t.text
+		if strings.HasPrefix(name, "-") && len(name) > 1 {
+			negate, name = true, name[1:]
+		}
+		var x Expr = &Text{Value: name}
+		if p.peek().kind == tokOp {
+			op := p.next()
+			value := p.next()
+			if value.kind != tokWord && value.kind != tokString {
+				return nil, fmt.Errorf("expected a value for %s at %d, got %s", name, value.pos, value)
+			}
+			x = &Compare{Field: name, Op: op.text, Value: value.text}
+		}
+		if negate {
+			x = &Not{X: x}
+		}
+		return x, nil
+	}
+	return nil, fmt.Errorf("unexpected %s at %d", t, t.pos)
+}
+
+func (p *parser) parsePipe(q *Query) error {
+	cmd := p.next()
+	if cmd.kind != tokWord {
+		return fmt.Errorf("expected a command after | at %d", cmd.pos)
+	}
+	switch cmd.text {
+	case "stats":
+		if q.Stats != nil {
+			return fmt.Errorf("only one stats pipe is allowed")
+		}
+		if len(q.Sort) > 0 || q.Limit > 0 {
+			return fmt.Errorf("stats must come before sort and head")
+		}
+		return p.parseStats(q)
+	case "sort":
+		for {
+			t := p.next()
+			if t.kind != tokWord {
+				return fmt.Errorf("expected a sort field at %d, got %s", t.pos, t)
+			}
+			key := SortKey{Name: t.text}
+			if strings.HasPrefix(key.Name, "-") {
+				key.Name, key.Desc = key.Name[1:], true
+			}
+			if p.isKeyword("desc") || p.isKeyword("asc") {
+				key.Desc = p.next().text == "desc"
+			}
+			q.Sort = append(q.Sort, key)
+			if p.peek().kind != tokComma {
+				return nil
+			}
+			p.next()
+		}
+	case "head", "limit":
+		t := p.next()
+		n, err := strconv.Atoi(t.text)
+		if t.kind != tokWord || err != nil || n <= 0 {
+			return fmt.Errorf("%s expects a positive number, got %s", cmd.text, t)
+		}
+		q.Limit = n
+		return nil
+	}
+	return fmt.Errorf("unknown command %s", cmd.text)
+}
+
+func (p *parser) parseStats(q *Query) error {
+	stats := &Stats{}
+	for {
+		t := p.next()
+		if t.kind != tokWord || !aggFuncs[t.text] {
+			return fmt.Errorf("unknown aggregation %s at %d", t, t.pos)
+		}
+		agg := Agg{Func: t.text}
+		if p.peek().kind == tokLParen {
+			p.next()
+			field := p.next()
+			if field.kind != tokWord || p.next().kind != tokRParen {
+				return fmt.Errorf("expected %s(field) at %d", agg.Func, t.pos)
+			}
+			agg.Field = field.text
+		}
+		if agg.Func != "count" && agg.Field == "" {
+			return fmt.Errorf("%s needs a field", agg.Func)
+		}
+		stats.Aggs = append(stats.Aggs, agg)
+		if p.peek().kind != tokComma {
+			break
+		}
+		p.next()
+	}
+	if p.isKeyword("by") {
+		p.next()
+		for {
+			t := p.next()
+			if t.kind != tokWord {
+				return fmt.Errorf("expected a field after by at %d, got %s", t.pos, t)
+			}
+			stats.By = append(stats.By, t.text)
+			if p.peek().kind != tokComma {
+				break
+			}
+			p.next()
+		}
+	}
+	q.Stats = stats
+	return nil
+}
+
+// HasField Whether the filter compares one of the fields
+func (q *Query) HasField(names ...string) bool {
+	var walk func(e Expr) bool
+	walk = func(e Expr) bool {
+		switch e := e.(type) {
+		case *And:
+			return walk(e.Left) || walk(e.Right)
+		case *Or:
+			return walk(e.Left) || walk(e.Right)
+		case *Not:
+			return walk(e.X)
+		case *Compare:
+			for _, name := range names {
+				if e.Field == name {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return q.Where != nil && walk(q.Where)
+}
@@ -0,0 +1,146 @@
+// Package multiline joins continuation lines into a single event.
+// Lines are grouped by a caller supplied key (host and app for syslog),
+// a line matching the start pattern begins a new event, any other line
+// is appended to the pending event of the same key. Pending events are
+// emitted when the next event starts, when they grow too large, or when
+// no line arrived for the configured timeout.
+package multiline
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	StartPattern string
+	Timeout      time.Duration
+	MaxLines     int
+}
+
+// EmitFunc Receives the first item of an event and all of its lines
+type EmitFunc[T any] func(first T, lines []string)
+
+type pending[T any] struct {
+	first   T
+	lines   []string
+	updated time.Time
+}
+
+type Assembler[T any] struct {
+	cfg     Config
+	start   *regexp.Regexp
+	emit    EmitFunc[T]
+	mu      sync.Mutex
+	pending map[string]*pending[T]
+	done    chan struct{}
+	wg      sync.WaitGroup
+}
+
+// New Create an assembler, an empty start pattern treats every non-indented line as a new event
+func New[T any](cfg Config, emit EmitFunc[T]) (*Assembler[T], error) {
+	if cfg.StartPattern == "" {
+		cfg.StartPattern = `^\S`
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = time.Second
+	}
+	if cfg.MaxLines <= 0 {
+		cfg.MaxLines = 500
+	}
+	start, err := regexp.Compile(cfg.StartPattern)
+	if err != nil {
+		return nil, err
+	}
+	return &Assembler[T]{
+		cfg:     cfg,
+		start:   start,
+		emit:    emit,
+		pending: make(map[string]*pending[T]),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// Start Run the timeout flusher
+func (a *Assembler[T]) Start() {
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		interval := a.cfg.Timeout / 2
+		if interval < time.Millisecond*10 {
+			interval = time.Millisecond * 10
+		}
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-a.done:
+				return
+			case now := <-ticker.C:
+				a.flushExpired(now)
+			}
+		}
+	}()
+}
+
+// Stop Stop the flusher and emit all pending events
+func (a *Assembler[T]) Stop() {
+	close(a.done)
+	a.wg.Wait()
+	a.mu.Lock()
+	events := make([]*pending[T], 0, len(a.pending))
+	for key, p := range a.pending {
+		events = append(events, p)
+		delete(a.pending, key)
+	}
+	a.mu.Unlock()
+	for _, p := range events {
+		a.emit(p.first, p.lines)
+	}
+}
+
+// IsStart Whether the line begins a new event
+func (a *Assembler[T]) IsStart(line string) bool {
+	return a.start.MatchString(line)
+}
+
+// Add Feed one message, a message is never split even if it contains line breaks
+func (a *Assembler[T]) Add(key string, item T, line string) {
+	line = strings.TrimRight(line, "\r\n")
+	a.mu.Lock()
+	p, ok := a.pending[key]
+	if ok && !a.start.MatchString(line) && len(p.lines) < a.cfg.MaxLines {
+		p.lines = append(p.lines, line)
+		p.updated = time.Now()
+		a.mu.Unlock()
+		return
+	}
+	a.pending[key] = &pending[T]{first: item, lines: []string{line}, updated: time.Now()}
+	a.mu.Unlock()
+	if ok {
+		a.emit(p.first, p.lines)
+	}
+}
+
+// Pending Number of events waiting for more lines
+func (a *Assembler[T]) Pending() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.pending)
+}
+
+func (a *Assembler[T]) flushExpired(now time.Time) {
+	var ready []*pending[T]
+	a.mu.Lock()
+	for key, p := range a.pending {
+		if now.Sub(p.updated) >= a.cfg.Timeout {
+			ready = append(ready, p)
+			delete(a.pending, key)
+		}
+	}
+	a.mu.Unlock()
+	for _, p := range ready {
+		a.emit(p.first, p.lines)
+	}
+}
@@ -0,0 +1,87 @@
+package multiline
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type collector struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (c *collector) emit(first int, lines []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, strings.Join(lines, "\n"))
+}
+
+func (c *collector) all() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]string{}, c.events...)
+}
+
+func TestJoinStackTrace(t *testing.T) {
+	c := &collector{}
+	a, err := New[int](Config{Timeout: time.Hour}, c.emit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Add("h1/app", 1, "java.lang.NullPointerException: boom")
+	a.Add("h1/app", 2, "\tat com.example.Foo.bar(Foo.java:10)")
+	a.Add("h1/app", 3, "\tat com.example.Foo.main(Foo.java:3)")
+	a.Add("h2/app", 4, "other host line")
+	a.Add("h1/app", 5, "next event")
+	events := c.all()
+	if len(events) != 1 {
+		t.Fatalf("expected 1 emitted event, got %d", len(events))
+	}
+	if strings.Count(events[0], "\n") != 2 || !strings.HasPrefix(events[0], "java.lang") {
+		t.Fatalf("unexpected event %q", events[0])
+	}
+	if a.Pending() != 2 {
+		t.Fatalf("expected 2 pending events, got %d", a.Pending())
+	}
+	a.Start()
+	a.Stop()
+	if len(c.all()) != 3 {
+		t.Fatalf("stop should flush pending events, got %v", c.all())
+	}
+}
+
+func TestTimeoutFlush(t *testing.T) {
+	c := &collector{}
+	a, err := New[int](Config{StartPattern: `^\d{4}-\d{2}-\d{2}`, Timeout: time.Millisecond * 50}, c.emit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Start()
+	defer a.Stop()
+	a.Add("k", 1, "2023-01-02 10:00:00 ERROR request failed")
+	a.Add("k", 2, "Traceback (most recent call last):")
+	time.Sleep(time.Millisecond * 200)
+	events := c.all()
+	if len(events) != 1 || !strings.Contains(events[0], "Traceback") {
+		t.Fatalf("unexpected events %v", events)
+	}
+}
+
+func TestMaxLines(t *testing.T) {
+	c := &collector{}
+	a, _ := New[int](Config{Timeout: time.Hour, MaxLines: 2}, c.emit)
+	a.Add("k", 1, "start")
+	a.Add("k", 2, " one")
+	a.Add("k", 3, " two")
+	if len(c.all()) != 1 {
+		t.Fatalf("expected the event to be emitted at max lines, got %v", c.all())
+	}
+}
+
+func TestBadPattern(t *testing.T) {
+	if _, err := New[int](Config{StartPattern: "("}, func(int, []string) {}); err == nil {
+		t.Fatal("expected compile error")
+	}
+}
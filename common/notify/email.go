@@ -0,0 +1,134 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// EmailNotifier SMTP email, SmtpTls uses implicit TLS (usually port 465),
+// otherwise STARTTLS is used when the server offers it
+type EmailNotifier struct {
+	host      string
+	port      int
+	username  string
+	password  string
+	from      string
+	receivers []string
+	implicit  bool
+}
+
+func NewEmailNotifier(cfg Config) (*EmailNotifier, error) {
+	if cfg.SmtpHost == "" {
+		return nil, fmt.Errorf("smtp host is empty")
+	}
+	if len(cfg.Receivers) == 0 {
+		return nil, fmt.Errorf("email receivers are empty")
+	}
+	n := &EmailNotifier{
+		host:      cfg.SmtpHost,
+		port:      cfg.SmtpPort,
+		username:  cfg.SmtpUser,
+		password:  cfg.SmtpPwd,
+		from:      cfg.SmtpFrom,
+		receivers: cfg.Receivers,
+		implicit:  cfg.SmtpTls,
+	}
+	if n.port == 0 {
+		n.port = 25
+		if n.implicit {
+			n.port = 465
+		}
+	}
+	if n.from == "" {
+		n.from = n.username
+	}
+	return n, nil
+}
+
+func (n *EmailNotifier) Type() string {
+	return TypeEmail
+}
+
+func (n *EmailNotifier) Send(ctx context.Context, msg Message) error {
+	addr := net.JoinHostPort(n.host, fmt.Sprint(n.port))
+	dialer := &net.Dialer{Timeout: time.Second * 15}
+	var conn net.Conn
+	var err error
+	if n.implicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{ServerName: n.host})
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if !n.implicit {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+				return err
+			}
+		}
+	}
+	if n.username != "" {
+		if ok, _ := client.Extension("AUTH"); ok {
+			if err = client.Auth(smtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+				return err
+			}
+		}
+	}
+	if err = client.Mail(n.from); err != nil {
+		return err
+	}
+	for _, rcpt := range n.receivers {
+		if err = client.Rcpt(rcpt); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(n.buildMessage(msg)); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (n *EmailNotifier) buildMessage(msg Message) []byte {
+	subject := fmt.Sprintf("[%s] %s", strings.ToUpper(msg.Level), msg.Title)
+	var sb strings.Builder
+	sb.WriteString("From: " + n.from + "\r\n")
+	sb.WriteString("To: " + strings.Join(n.receivers, ", ") + "\r\n")
+	sb.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	sb.WriteString("Date: " + msg.Time.Format(time.RFC1123Z) + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	sb.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.PlainText()))
+	for len(encoded) > 76 {
+		sb.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	sb.WriteString(encoded + "\r\n")
+	return []byte(sb.String())
+}
@@ -0,0 +1,210 @@
+// Package notify delivers alert and system event messages to external
+// channels: SMTP email, generic webhooks, DingTalk, WeCom, Feishu/Lark and
+// Slack compatible webhooks.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TypeEmail    = "email"
+	TypeWebhook  = "webhook"
+	TypeDingTalk = "dingtalk"
+	TypeWeCom    = "wecom"
+	TypeFeishu   = "feishu"
+	TypeSlack    = "slack"
+
+	LevelInfo     = "info"
+	LevelWarning  = "warning"
+	LevelCritical = "critical"
+)
+
+var ErrRateLimited = errors.New("notify rate limit exceeded")
+
+// Message A notification, Fields are rendered as key/value lines
+type Message struct {
+	Title  string            `json:"title"`
+	Text   string            `json:"text"`
+	Level  string            `json:"level"`
+	Source string            `json:"source"`
+	Time   time.Time         `json:"time"`
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// Markdown Render the message as markdown, used by the chat webhooks
+func (m Message) Markdown() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("### [%s] %s\n\n", strings.ToUpper(m.Level), m.Title))
+	if m.Text != "" {
+		sb.WriteString(m.Text)
+		sb.WriteString("\n\n")
+	}
+	for _, k := range m.sortedKeys() {
+		sb.WriteString(fmt.Sprintf("- **%s**: %s\n", k, m.Fields[k]))
+	}
+	sb.WriteString(fmt.Sprintf("\n%s", m.Time.Format("2006-01-02 15:04:05")))
+	return sb.String()
+}
+
+// PlainText Render the message as plain text, used by email
+func (m Message) PlainText() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("[%s] %s\n\n", strings.ToUpper(m.Level), m.Title))
+	if m.Text != "" {
+		sb.WriteString(m.Text)
+		sb.WriteString("\n\n")
+	}
+	for _, k := range m.sortedKeys() {
+		sb.WriteString(fmt.Sprintf("%s: %s\n", k, m.Fields[k]))
+	}
+	sb.WriteString(fmt.Sprintf("\nTime: %s\n", m.Time.Format("2006-01-02 15:04:05")))
+	return sb.String()
+}
+
+func (m Message) sortedKeys() []string {
+	keys := make([]string, 0, len(m.Fields))
+	for k := range m.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Notifier A notification channel implementation
+type Notifier interface {
+	Type() string
+	Send(ctx context.Context, msg Message) error
+}
+
+// Config Settings of a channel, only the fields of the channel type are used
+type Config struct {
+	Type         string
+	Webhook      string
+	Secret       string
+	Method       string
+	Headers      map[string]string
+	BodyTemplate string
+	SmtpHost     string
+	SmtpPort     int
+	SmtpUser     string
+	SmtpPwd      string
+	SmtpFrom     string
+	SmtpTls      bool
+	Receivers    []string
+}
+
+// New Create a notifier from its configuration
+func New(cfg Config) (Notifier, error) {
+	switch cfg.Type {
+	case TypeEmail:
+		return NewEmailNotifier(cfg)
+	case TypeWebhook:
+		return NewWebhookNotifier(cfg)
+	case TypeDingTalk:
+		return NewDingTalkNotifier(cfg)
+	case TypeWeCom:
+		return NewWeComNotifier(cfg)
+	case TypeFeishu:
+		return NewFeishuNotifier(cfg)
+	case TypeSlack:
+		return NewSlackNotifier(cfg)
+	}
+	return nil, fmt.Errorf("unsupported notify channel type %s", cfg.Type)
+}
+
+// ChannelOptions Delivery policy of a channel
+type ChannelOptions struct {
+	// RatePerMinute Maximum messages per minute, 0 means unlimited
+	RatePerMinute int
+	// Retries Additional attempts after a failed send
+	Retries int
+	// RetryDelay Delay before the first retry, doubled on each attempt
+	RetryDelay time.Duration
+	// Levels Message levels delivered by the channel, empty delivers all
+	Levels []string
+}
+
+// Channel A notifier with rate limiting, retries and level filtering
+type Channel struct {
+	Notifier
+	opts   ChannelOptions
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func NewChannel(n Notifier, opts ChannelOptions) *Channel {
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = time.Second
+	}
+	return &Channel{Notifier: n, opts: opts, tokens: float64(opts.RatePerMinute), last: time.Now()}
+}
+
+// Accept Whether the channel delivers messages of this level
+func (c *Channel) Accept(level string) bool {
+	if len(c.opts.Levels) == 0 {
+		return true
+	}
+	for _, l := range c.opts.Levels {
+		if l == level {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Channel) allow() bool {
+	if c.opts.RatePerMinute <= 0 {
+		return true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	rate := float64(c.opts.RatePerMinute)
+	c.tokens += now.Sub(c.last).Minutes() * rate
+	c.last = now
+	if c.tokens > rate {
+		c.tokens = rate
+	}
+	if c.tokens < 1 {
+		return false
+	}
+	c.tokens--
+	return true
+}
+
+// Send Deliver a message, retrying failed attempts
+func (c *Channel) Send(ctx context.Context, msg Message) error {
+	if !c.allow() {
+		return ErrRateLimited
+	}
+	if msg.Time.IsZero() {
+		msg.Time = time.Now()
+	}
+	if msg.Level == "" {
+		msg.Level = LevelInfo
+	}
+	delay := c.opts.RetryDelay
+	var err error
+	for attempt := 0; attempt <= c.opts.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay):
+			}
+			delay *= 2
+		}
+		if err = c.Notifier.Send(ctx, msg); err == nil {
+			return nil
+		}
+	}
+	return err
+}
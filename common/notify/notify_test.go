@@ -0,0 +1,221 @@
+package notify
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var testMsg = Message{
+	Title:  "Database backup failed",
+	Text:   "pg_dump exited with status 1",
+	Level:  LevelCritical,
+	Source: "system",
+	Time:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+	Fields: map[string]string{"host": "logsight-01"},
+}
+
+type captured struct {
+	query url.Values
+	body  map[string]interface{}
+}
+
+func stubServer(t *testing.T, reply string) (*httptest.Server, chan captured) {
+	ch := make(chan captured, 10)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := io.ReadAll(r.Body)
+		var body map[string]interface{}
+		if err := json.Unmarshal(bs, &body); err != nil {
+			t.Errorf("body is not json: %s", bs)
+		}
+		ch <- captured{query: r.URL.Query(), body: body}
+		_, _ = w.Write([]byte(reply))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, ch
+}
+
+func TestChatWebhooks(t *testing.T) {
+	for _, typ := range []string{TypeDingTalk, TypeWeCom, TypeFeishu, TypeSlack} {
+		srv, ch := stubServer(t, `{"errcode":0,"errmsg":"ok"}`)
+		n, err := New(Config{Type: typ, Webhook: srv.URL, Secret: "s3cret"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = n.Send(context.Background(), testMsg); err != nil {
+			t.Fatalf("%s: %v", typ, err)
+		}
+		got := <-ch
+		bs, _ := json.Marshal(got.body)
+		if !strings.Contains(string(bs), "Database backup failed") {
+			t.Fatalf("%s: title missing in %s", typ, bs)
+		}
+		switch typ {
+		case TypeDingTalk:
+			if got.query.Get("sign") == "" || got.query.Get("timestamp") == "" {
+				t.Fatalf("dingtalk request not signed %v", got.query)
+			}
+		case TypeFeishu:
+			if got.body["sign"] == nil || got.body["timestamp"] == nil {
+				t.Fatalf("feishu request not signed %v", got.body)
+			}
+		}
+	}
+}
+
+func TestWebhookErrcode(t *testing.T) {
+	srv, _ := stubServer(t, `{"errcode":310000,"errmsg":"sign not match"}`)
+	n, _ := NewDingTalkNotifier(Config{Webhook: srv.URL})
+	if err := n.Send(context.Background(), testMsg); err == nil || !strings.Contains(err.Error(), "310000") {
+		t.Fatalf("expected errcode error, got %v", err)
+	}
+}
+
+func TestWebhookTemplate(t *testing.T) {
+	srv, ch := stubServer(t, "")
+	n, err := NewWebhookNotifier(Config{
+		Webhook:      srv.URL,
+		BodyTemplate: `{"summary": {{json .Title}}, "severity": {{json .Level}}, "host": {{json (index .Fields "host")}}}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = n.Send(context.Background(), testMsg); err != nil {
+		t.Fatal(err)
+	}
+	got := <-ch
+	if got.body["summary"] != testMsg.Title || got.body["host"] != "logsight-01" {
+		t.Fatalf("unexpected body %v", got.body)
+	}
+	if _, err = NewWebhookNotifier(Config{Webhook: srv.URL, BodyTemplate: "{{"}); err == nil {
+		t.Fatal("expected template error")
+	}
+}
+
+type flakyNotifier struct {
+	failures int32
+	calls    int32
+}
+
+func (f *flakyNotifier) Type() string { return "flaky" }
+
+func (f *flakyNotifier) Send(ctx context.Context, msg Message) error {
+	if atomic.AddInt32(&f.calls, 1) <= f.failures {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func TestChannelRetryAndRateLimit(t *testing.T) {
+	f := &flakyNotifier{failures: 2}
+	c := NewChannel(f, ChannelOptions{Retries: 2, RetryDelay: time.Millisecond, RatePerMinute: 2})
+	if err := c.Send(context.Background(), testMsg); err != nil {
+		t.Fatalf("expected success after retries, got %v", err)
+	}
+	if f.calls != 3 {
+		t.Fatalf("expected 3 calls, got %d", f.calls)
+	}
+	if err := c.Send(context.Background(), testMsg); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Send(context.Background(), testMsg); err != ErrRateLimited {
+		t.Fatalf("expected rate limit, got %v", err)
+	}
+
+	f2 := &flakyNotifier{failures: 10}
+	c2 := NewChannel(f2, ChannelOptions{Retries: 1, RetryDelay: time.Millisecond, Levels: []string{LevelCritical}})
+	if err := c2.Send(context.Background(), testMsg); err == nil || f2.calls != 2 {
+		t.Fatalf("expected failure after 2 calls, got %v %d", err, f2.calls)
+	}
+	if c2.Accept(LevelInfo) || !c2.Accept(LevelCritical) {
+		t.Fatal("level filter not applied")
+	}
+}
+
+// smtpStub A minimal SMTP server accepting one message
+func smtpStub(t *testing.T) (string, chan string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	ch := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		write := func(s string) { _, _ = conn.Write([]byte(s + "\r\n")) }
+		write("220 stub ESMTP")
+		var data strings.Builder
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+				write("250 stub")
+			case strings.HasPrefix(cmd, "MAIL"), strings.HasPrefix(cmd, "RCPT"):
+				write("250 ok")
+			case cmd == "DATA":
+				write("354 go ahead")
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil || l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				write("250 queued")
+			case cmd == "QUIT":
+				write("221 bye")
+				ch <- data.String()
+				return
+			default:
+				write("250 ok")
+			}
+		}
+	}()
+	return ln.Addr().String(), ch
+}
+
+func TestEmail(t *testing.T) {
+	addr, ch := smtpStub(t)
+	host, port, _ := net.SplitHostPort(addr)
+	var p int
+	_, _ = fmt.Sscan(port, &p)
+	n, err := New(Config{Type: TypeEmail, SmtpHost: host, SmtpPort: p, SmtpFrom: "logsight@example.com", Receivers: []string{"ops@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = n.Send(context.Background(), testMsg); err != nil {
+		t.Fatal(err)
+	}
+	data := <-ch
+	if !strings.Contains(data, "To: ops@example.com") || !strings.Contains(data, "Subject: [CRITICAL] Database backup failed") {
+		t.Fatalf("unexpected headers %s", data)
+	}
+	body := data[strings.Index(data, "\r\n\r\n")+4:]
+	decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\r\n", ""))
+	if err != nil || !strings.Contains(string(decoded), "pg_dump exited") {
+		t.Fatalf("unexpected body %q %v", decoded, err)
+	}
+	if _, err = New(Config{Type: TypeEmail, SmtpHost: host}); err == nil {
+		t.Fatal("expected error without receivers")
+	}
+}
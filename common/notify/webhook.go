@@ -0,0 +1,248 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var httpClient = &http.Client{Timeout: time.Second * 15}
+
+// postJSON Post a JSON body and treat any non 2xx status as an error
+func postJSON(ctx context.Context, method, target string, headers map[string]string, body []byte) ([]byte, error) {
+	if method == "" {
+		method = http.MethodPost
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return respBody, fmt.Errorf("webhook status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return respBody, nil
+}
+
+// checkErrcode DingTalk, WeCom and Feishu answer 200 with an error code in the body
+func checkErrcode(body []byte) error {
+	var result struct {
+		Errcode *int   `json:"errcode"`
+		Errmsg  string `json:"errmsg"`
+		Code    *int   `json:"code"`
+		Msg     string `json:"msg"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil
+	}
+	if result.Errcode != nil && *result.Errcode != 0 {
+		return fmt.Errorf("webhook error %d: %s", *result.Errcode, result.Errmsg)
+	}
+	if result.Code != nil && *result.Code != 0 {
+		return fmt.Errorf("webhook error %d: %s", *result.Code, result.Msg)
+	}
+	return nil
+}
+
+// WebhookNotifier Generic webhook, the body is rendered from a text/template,
+// the json function quotes a value, e.g. {"text": {{json .Title}}}
+type WebhookNotifier struct {
+	url     string
+	method  string
+	headers map[string]string
+	tpl     *template.Template
+}
+
+func NewWebhookNotifier(cfg Config) (*WebhookNotifier, error) {
+	if cfg.Webhook == "" {
+		return nil, fmt.Errorf("webhook url is empty")
+	}
+	n := &WebhookNotifier{url: cfg.Webhook, method: cfg.Method, headers: cfg.Headers}
+	if strings.TrimSpace(cfg.BodyTemplate) != "" {
+		tpl, err := template.New("body").Funcs(template.FuncMap{
+			"json": func(v interface{}) (string, error) {
+				bs, err := json.Marshal(v)
+				return string(bs), err
+			},
+		}).Parse(cfg.BodyTemplate)
+		if err != nil {
+			return nil, err
+		}
+		n.tpl = tpl
+	}
+	return n, nil
+}
+
+func (n *WebhookNotifier) Type() string {
+	return TypeWebhook
+}
+
+func (n *WebhookNotifier) Send(ctx context.Context, msg Message) error {
+	var body []byte
+	if n.tpl == nil {
+		body, _ = json.Marshal(msg)
+	} else {
+		var buf bytes.Buffer
+		if err := n.tpl.Execute(&buf, msg); err != nil {
+			return err
+		}
+		body = buf.Bytes()
+	}
+	_, err := postJSON(ctx, n.method, n.url, n.headers, body)
+	return err
+}
+
+// DingTalkNotifier DingTalk robot, messages are signed when a secret is set
+type DingTalkNotifier struct {
+	webhook string
+	secret  string
+}
+
+func NewDingTalkNotifier(cfg Config) (*DingTalkNotifier, error) {
+	if cfg.Webhook == "" {
+		return nil, fmt.Errorf("dingtalk webhook is empty")
+	}
+	return &DingTalkNotifier{webhook: cfg.Webhook, secret: cfg.Secret}, nil
+}
+
+func (n *DingTalkNotifier) Type() string {
+	return TypeDingTalk
+}
+
+func (n *DingTalkNotifier) Send(ctx context.Context, msg Message) error {
+	target := n.webhook
+	if n.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+		mac := hmac.New(sha256.New, []byte(n.secret))
+		mac.Write([]byte(timestamp + "\n" + n.secret))
+		sign := url.QueryEscape(base64.StdEncoding.EncodeToString(mac.Sum(nil)))
+		sep := "?"
+		if strings.Contains(target, "?") {
+			sep = "&"
+		}
+		target = fmt.Sprintf("%s%stimestamp=%s&sign=%s", target, sep, timestamp, sign)
+	}
+	body, _ := json.Marshal(map[string]interface{}{
+		"msgtype": "markdown",
+		"markdown": map[string]string{
+			"title": msg.Title,
+			"text":  msg.Markdown(),
+		},
+	})
+	resp, err := postJSON(ctx, http.MethodPost, target, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkErrcode(resp)
+}
+
+// WeComNotifier WeCom (WeChat Work) group robot
+type WeComNotifier struct {
+	webhook string
+}
+
+func NewWeComNotifier(cfg Config) (*WeComNotifier, error) {
+	if cfg.Webhook == "" {
+		return nil, fmt.Errorf("wecom webhook is empty")
+	}
+	return &WeComNotifier{webhook: cfg.Webhook}, nil
+}
+
+func (n *WeComNotifier) Type() string {
+	return TypeWeCom
+}
+
+func (n *WeComNotifier) Send(ctx context.Context, msg Message) error {
+	body, _ := json.Marshal(map[string]interface{}{
+		"msgtype":  "markdown",
+		"markdown": map[string]string{"content": msg.Markdown()},
+	})
+	resp, err := postJSON(ctx, http.MethodPost, n.webhook, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkErrcode(resp)
+}
+
+// FeishuNotifier Feishu/Lark custom bot, messages are signed when a secret is set
+type FeishuNotifier struct {
+	webhook string
+	secret  string
+}
+
+func NewFeishuNotifier(cfg Config) (*FeishuNotifier, error) {
+	if cfg.Webhook == "" {
+		return nil, fmt.Errorf("feishu webhook is empty")
+	}
+	return &FeishuNotifier{webhook: cfg.Webhook, secret: cfg.Secret}, nil
+}
+
+func (n *FeishuNotifier) Type() string {
+	return TypeFeishu
+}
+
+func (n *FeishuNotifier) Send(ctx context.Context, msg Message) error {
+	payload := map[string]interface{}{
+		"msg_type": "text",
+		"content":  map[string]string{"text": msg.PlainText()},
+	}
+	if n.secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		// Feishu uses timestamp + "\n" + secret as the key of an empty message
+		mac := hmac.New(sha256.New, []byte(timestamp+"\n"+n.secret))
+		payload["timestamp"] = timestamp
+		payload["sign"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	body, _ := json.Marshal(payload)
+	resp, err := postJSON(ctx, http.MethodPost, n.webhook, nil, body)
+	if err != nil {
+		return err
+	}
+	return checkErrcode(resp)
+}
+
+// SlackNotifier Slack incoming webhook, also accepted by Mattermost and Rocket.Chat
+type SlackNotifier struct {
+	webhook string
+}
+
+func NewSlackNotifier(cfg Config) (*SlackNotifier, error) {
+	if cfg.Webhook == "" {
+		return nil, fmt.Errorf("slack webhook is empty")
+	}
+	return &SlackNotifier{webhook: cfg.Webhook}, nil
+}
+
+func (n *SlackNotifier) Type() string {
+	return TypeSlack
+}
+
+func (n *SlackNotifier) Send(ctx context.Context, msg Message) error {
+	text := fmt.Sprintf("*[%s] %s*\n%s", strings.ToUpper(msg.Level), msg.Title, msg.Text)
+	for _, k := range msg.sortedKeys() {
+		text += fmt.Sprintf("\n• %s: %s", k, msg.Fields[k])
+	}
+	body, _ := json.Marshal(map[string]string{"text": text})
+	_, err := postJSON(ctx, http.MethodPost, n.webhook, nil, body)
+	return err
+}
@@ -0,0 +1,202 @@
+// Package pubsub is an in-process fan-out hub. Publishing never blocks,
+// a subscriber that does not keep up is closed instead of slowing down
+// the publisher.
+package pubsub
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	ReasonClosed = "closed"
+	ReasonSlow   = "slow consumer"
+)
+
+// Options Per subscriber settings
+type Options[T any] struct {
+	// Filter Messages for which the filter returns false are not delivered, nil accepts all
+	Filter func(T) bool
+	// Buffer Channel size, the subscriber is dropped when it is full
+	Buffer int
+	// Rate Maximum messages per second, excess messages are skipped, 0 means unlimited
+	Rate float64
+}
+
+type Subscriber[T any] struct {
+	id         int64
+	hub        *Hub[T]
+	ch         chan T
+	filter     func(T) bool
+	rate       float64
+	tokens     float64
+	last       time.Time
+	mu         sync.Mutex
+	closed     bool
+	reason     string
+	done       chan struct{}
+	delivered  int64
+	suppressed int64
+}
+
+// C Messages for this subscriber, closed when the subscriber is dropped
+func (s *Subscriber[T]) C() <-chan T {
+	return s.ch
+}
+
+// Done Closed together with C
+func (s *Subscriber[T]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Reason Why the subscriber was closed
+func (s *Subscriber[T]) Reason() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.reason
+}
+
+// Suppressed Number of messages skipped by the rate limit
+func (s *Subscriber[T]) Suppressed() int64 {
+	return atomic.LoadInt64(&s.suppressed)
+}
+
+// Close Unsubscribe, safe to call more than once
+func (s *Subscriber[T]) Close() {
+	s.hub.remove(s, ReasonClosed)
+}
+
+// allow Token bucket with a burst of one second worth of messages
+func (s *Subscriber[T]) allow(now time.Time) bool {
+	if s.rate <= 0 {
+		return true
+	}
+	s.tokens += now.Sub(s.last).Seconds() * s.rate
+	s.last = now
+	if s.tokens > s.rate {
+		s.tokens = s.rate
+	}
+	if s.tokens < 1 {
+		return false
+	}
+	s.tokens--
+	return true
+}
+
+// deliver Called with the subscriber lock held
+func (s *Subscriber[T]) deliver(msg T, now time.Time) (slow bool) {
+	if s.closed {
+		return false
+	}
+	if s.filter != nil && !s.filter(msg) {
+		return false
+	}
+	if !s.allow(now) {
+		atomic.AddInt64(&s.suppressed, 1)
+		return false
+	}
+	select {
+	case s.ch <- msg:
+		atomic.AddInt64(&s.delivered, 1)
+		return false
+	default:
+		return true
+	}
+}
+
+// Stats Hub counters
+type Stats struct {
+	Subscribers int   `json:"subscribers"`
+	Published   int64 `json:"published"`
+	Dropped     int64 `json:"dropped"`
+}
+
+type Hub[T any] struct {
+	mu        sync.RWMutex
+	subs      map[int64]*Subscriber[T]
+	nextId    int64
+	published int64
+	dropped   int64
+}
+
+func NewHub[T any]() *Hub[T] {
+	return &Hub[T]{subs: make(map[int64]*Subscriber[T])}
+}
+
+// Subscribe Register a new subscriber
+func (h *Hub[T]) Subscribe(opts Options[T]) *Subscriber[T] {
+	if opts.Buffer <= 0 {
+		opts.Buffer = 256
+	}
+	sub := &Subscriber[T]{
+		hub:    h,
+		ch:     make(chan T, opts.Buffer),
+		filter: opts.Filter,
+		rate:   opts.Rate,
+		tokens: opts.Rate,
+		last:   time.Now(),
+		done:   make(chan struct{}),
+	}
+	h.mu.Lock()
+	h.nextId++
+	sub.id = h.nextId
+	h.subs[sub.id] = sub
+	h.mu.Unlock()
+	return sub
+}
+
+// HasSubscribers Lets publishers skip work when nobody is listening
+func (h *Hub[T]) HasSubscribers() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs) > 0
+}
+
+// Publish Deliver a message to all matching subscribers without blocking
+func (h *Hub[T]) Publish(msg T) {
+	now := time.Now()
+	var slow []*Subscriber[T]
+	h.mu.RLock()
+	for _, sub := range h.subs {
+		sub.mu.Lock()
+		if sub.deliver(msg, now) {
+			slow = append(slow, sub)
+		}
+		sub.mu.Unlock()
+	}
+	h.mu.RUnlock()
+	atomic.AddInt64(&h.published, 1)
+	for _, sub := range slow {
+		h.remove(sub, ReasonSlow)
+	}
+}
+
+func (h *Hub[T]) Stats() Stats {
+	h.mu.RLock()
+	n := len(h.subs)
+	h.mu.RUnlock()
+	return Stats{
+		Subscribers: n,
+		Published:   atomic.LoadInt64(&h.published),
+		Dropped:     atomic.LoadInt64(&h.dropped),
+	}
+}
+
+func (h *Hub[T]) remove(sub *Subscriber[T], reason string) {
+	h.mu.Lock()
+	delete(h.subs, sub.id)
+	h.mu.Unlock()
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	sub.reason = reason
+	if reason == ReasonSlow {
+		atomic.AddInt64(&h.dropped, 1)
+	}
+	close(sub.done)
+	close(sub.ch)
+}
@@ -0,0 +1,74 @@
+package pubsub
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPublishFilter(t *testing.T) {
+	h := NewHub[int]()
+	even := h.Subscribe(Options[int]{Filter: func(v int) bool { return v%2 == 0 }, Buffer: 10})
+	all := h.Subscribe(Options[int]{Buffer: 10})
+	for i := 0; i < 6; i++ {
+		h.Publish(i)
+	}
+	if len(even.C()) != 3 || len(all.C()) != 6 {
+		t.Fatalf("unexpected delivery even=%d all=%d", len(even.C()), len(all.C()))
+	}
+	even.Close()
+	even.Close()
+	if _, ok := <-even.Done(); ok {
+		t.Fatal("done should be closed")
+	}
+	if even.Reason() != ReasonClosed {
+		t.Fatalf("unexpected reason %s", even.Reason())
+	}
+	if h.Stats().Subscribers != 1 {
+		t.Fatalf("expected 1 subscriber, got %d", h.Stats().Subscribers)
+	}
+}
+
+func TestSlowSubscriberDropped(t *testing.T) {
+	h := NewHub[int]()
+	slow := h.Subscribe(Options[int]{Buffer: 2})
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			h.Publish(i)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("publish blocked on a slow subscriber")
+	}
+	<-slow.Done()
+	if slow.Reason() != ReasonSlow {
+		t.Fatalf("unexpected reason %s", slow.Reason())
+	}
+	n := 0
+	for range slow.C() {
+		n++
+	}
+	if n != 2 {
+		t.Fatalf("expected the buffered messages to remain readable, got %d", n)
+	}
+	if h.HasSubscribers() || h.Stats().Dropped != 1 {
+		t.Fatalf("unexpected stats %+v", h.Stats())
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	h := NewHub[int]()
+	sub := h.Subscribe(Options[int]{Buffer: 100, Rate: 5})
+	for i := 0; i < 50; i++ {
+		h.Publish(i)
+	}
+	if got := len(sub.C()); got != 5 {
+		t.Fatalf("expected a burst of 5, got %d", got)
+	}
+	if sub.Suppressed() != 45 {
+		t.Fatalf("expected 45 suppressed, got %d", sub.Suppressed())
+	}
+}
@@ -0,0 +1,245 @@
+package radius
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+)
+
+// Attribute types of RFC 2865, RFC 2866 and RFC 2869 used by accounting
+const (
+	AttrUserName            = 1
+	AttrNasIPAddress        = 4
+	AttrNasPort             = 5
+	AttrServiceType         = 6
+	AttrFramedIPAddress     = 8
+	AttrFramedIPNetmask     = 9
+	AttrClass               = 25
+	AttrVendorSpecific      = 26
+	AttrSessionTimeout      = 27
+	AttrCalledStationID     = 30
+	AttrCallingStationID    = 31
+	AttrNasIdentifier       = 32
+	AttrAcctStatusType      = 40
+	AttrAcctDelayTime       = 41
+	AttrAcctInputOctets     = 42
+	AttrAcctOutputOctets    = 43
+	AttrAcctSessionID       = 44
+	AttrAcctSessionTime     = 46
+	AttrAcctInputPackets    = 47
+	AttrAcctOutputPackets   = 48
+	AttrAcctTerminateCause  = 49
+	AttrAcctInputGigawords  = 52
+	AttrAcctOutputGigawords = 53
+	AttrEventTimestamp      = 55
+	AttrNasPortType         = 61
+	AttrAcctInterimInterval = 85
+	AttrNasPortID           = 87
+)
+
+// Acct-Status-Type values
+const (
+	StatusStart         = 1
+	StatusStop          = 2
+	StatusInterimUpdate = 3
+	StatusAccountingOn  = 7
+	StatusAccountingOff = 8
+)
+
+// Acct-Terminate-Cause values
+const (
+	CauseUserRequest    = 1
+	CauseLostCarrier    = 2
+	CauseLostService    = 3
+	CauseIdleTimeout    = 4
+	CauseSessionTimeout = 5
+	CauseAdminReset     = 6
+	CauseNasReboot      = 11
+)
+
+// StatusName The name of an Acct-Status-Type
+func StatusName(status uint32) string {
+	switch status {
+	case StatusStart:
+		return "Start"
+	case StatusStop:
+		return "Stop"
+	case StatusInterimUpdate:
+		return "Interim-Update"
+	case StatusAccountingOn:
+		return "Accounting-On"
+	case StatusAccountingOff:
+		return "Accounting-Off"
+	}
+	return fmt.Sprintf("Status-%d", status)
+}
+
+// Accounting The accounting attributes of an Accounting-Request
+type Accounting struct {
+	StatusType      uint32
+	Username        string
+	SessionID       string
+	NasIP           net.IP
+	NasID           string
+	NasPort         uint32
+	NasPortID       string
+	NasPortType     uint32
+	ServiceType     uint32
+	FramedIP        net.IP
+	FramedNetmask   net.IP
+	MacAddr         string // from Calling-Station-Id or a vendor attribute
+	CalledStation   string
+	Class           string
+	SessionTimeout  uint32
+	InterimInterval uint32 // seconds
+	SessionTime     uint32 // seconds
+	InputOctets     uint64 // including the gigawords
+	OutputOctets    uint64
+	InputPackets    uint32
+	OutputPackets   uint32
+	TerminateCause  uint32
+	DelayTime       uint32    // seconds
+	EventTime       time.Time // Event-Timestamp, zero when not sent
+}
+
+// VendorParser Maps the attributes of a vendor onto an accounting record
+type VendorParser func(acct *Accounting, attr VendorAttribute)
+
+// vendorParsers Vendor attribute parsers by vendor id, see vendor.go
+var vendorParsers = map[uint32]VendorParser{}
+
+// RegisterVendor Add or replace the attribute parser of a vendor
+func RegisterVendor(vendor uint32, parser VendorParser) {
+	vendorParsers[vendor] = parser
+}
+
+// ParseAccounting Decode the accounting attributes of a request
+func ParseAccounting(p *Packet) *Accounting {
+	acct := &Accounting{
+		StatusType:      p.Uint32(AttrAcctStatusType),
+		Username:        p.String(AttrUserName),
+		SessionID:       p.String(AttrAcctSessionID),
+		NasIP:           p.IP(AttrNasIPAddress),
+		NasID:           p.String(AttrNasIdentifier),
+		NasPort:         p.Uint32(AttrNasPort),
+		NasPortID:       p.String(AttrNasPortID),
+		NasPortType:     p.Uint32(AttrNasPortType),
+		ServiceType:     p.Uint32(AttrServiceType),
+		FramedIP:        p.IP(AttrFramedIPAddress),
+		FramedNetmask:   p.IP(AttrFramedIPNetmask),
+		MacAddr:         NormalizeMac(p.String(AttrCallingStationID)),
+		CalledStation:   p.String(AttrCalledStationID),
+		Class:           p.String(AttrClass),
+		SessionTimeout:  p.Uint32(AttrSessionTimeout),
+		InterimInterval: p.Uint32(AttrAcctInterimInterval),
+		SessionTime:     p.Uint32(AttrAcctSessionTime),
+		InputOctets:     uint64(p.Uint32(AttrAcctInputGigawords))<<32 | uint64(p.Uint32(AttrAcctInputOctets)),
+		OutputOctets:    uint64(p.Uint32(AttrAcctOutputGigawords))<<32 | uint64(p.Uint32(AttrAcctOutputOctets)),
+		InputPackets:    p.Uint32(AttrAcctInputPackets),
+		OutputPackets:   p.Uint32(AttrAcctOutputPackets),
+		TerminateCause:  p.Uint32(AttrAcctTerminateCause),
+		DelayTime:       p.Uint32(AttrAcctDelayTime),
+	}
+	if ts := p.Uint32(AttrEventTimestamp); ts > 0 {
+		acct.EventTime = time.Unix(int64(ts), 0)
+	}
+	for _, attr := range p.VendorAttributes() {
+		if parser, ok := vendorParsers[attr.Vendor]; ok {
+			parser(acct, attr)
+		}
+	}
+	return acct
+}
+
+// Time When the event happened, Event-Timestamp or the receive time less Acct-Delay-Time
+func (a *Accounting) Time(received time.Time) time.Time {
+	if !a.EventTime.IsZero() {
+		return a.EventTime
+	}
+	return received.Add(-time.Duration(a.DelayTime) * time.Second)
+}
+
+// StartTime When the session started, the event time less the session time
+func (a *Accounting) StartTime(received time.Time) time.Time {
+	return a.Time(received).Add(-time.Duration(a.SessionTime) * time.Second)
+}
+
+// ReopenCauses Terminate causes of closed sessions the record shows to be still online.
+// A collector closes the sessions whose NAS stopped sending updates with Lost-Service,
+// an Interim-Update afterwards means only the updates were lost
+func (a *Accounting) ReopenCauses() []int {
+	if a.StatusType == StatusInterimUpdate {
+		return []int{CauseLostService}
+	}
+	return nil
+}
+
+// Packet Encode the record as an Accounting-Request, used by the NAS client
+func (a *Accounting) Packet() *Packet {
+	p := &Packet{Code: CodeAccountingRequest}
+	p.AddUint32(AttrAcctStatusType, a.StatusType)
+	p.AddString(AttrUserName, a.Username)
+	p.AddString(AttrAcctSessionID, a.SessionID)
+	p.AddIP(AttrNasIPAddress, a.NasIP)
+	p.AddString(AttrNasIdentifier, a.NasID)
+	p.AddUint32(AttrNasPort, a.NasPort)
+	p.AddString(AttrNasPortID, a.NasPortID)
+	p.AddUint32(AttrNasPortType, a.NasPortType)
+	p.AddUint32(AttrServiceType, a.ServiceType)
+	p.AddIP(AttrFramedIPAddress, a.FramedIP)
+	p.AddIP(AttrFramedIPNetmask, a.FramedNetmask)
+	p.AddString(AttrCallingStationID, a.MacAddr)
+	p.AddString(AttrCalledStationID, a.CalledStation)
+	p.AddString(AttrClass, a.Class)
+	if a.SessionTimeout > 0 {
+		p.AddUint32(AttrSessionTimeout, a.SessionTimeout)
+	}
+	if a.InterimInterval > 0 {
+		p.AddUint32(AttrAcctInterimInterval, a.InterimInterval)
+	}
+	if a.StatusType == StatusInterimUpdate || a.StatusType == StatusStop {
+		p.AddUint32(AttrAcctSessionTime, a.SessionTime)
+		p.AddUint32(AttrAcctInputOctets, uint32(a.InputOctets))
+		p.AddUint32(AttrAcctOutputOctets, uint32(a.OutputOctets))
+		p.AddUint32(AttrAcctInputGigawords, uint32(a.InputOctets>>32))
+		p.AddUint32(AttrAcctOutputGigawords, uint32(a.OutputOctets>>32))
+		p.AddUint32(AttrAcctInputPackets, a.InputPackets)
+		p.AddUint32(AttrAcctOutputPackets, a.OutputPackets)
+	}
+	if a.StatusType == StatusStop && a.TerminateCause > 0 {
+		p.AddUint32(AttrAcctTerminateCause, a.TerminateCause)
+	}
+	if a.DelayTime > 0 {
+		p.AddUint32(AttrAcctDelayTime, a.DelayTime)
+	}
+	if !a.EventTime.IsZero() {
+		p.AddUint32(AttrEventTimestamp, uint32(a.EventTime.Unix()))
+	}
+	return p
+}
+
+// NormalizeMac Format a MAC address as aa:bb:cc:dd:ee:ff, the separators and
+// groupings NAS vendors use (aa-bb-cc-dd-ee-ff, aabb.ccdd.eeff, AABBCCDDEEFF)
+// are accepted, other values are returned unchanged
+func NormalizeMac(value string) string {
+	hex := strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9', r >= 'a' && r <= 'f':
+			return r
+		case r >= 'A' && r <= 'F':
+			return r + 'a' - 'A'
+		case r == ':' || r == '-' || r == '.':
+			return -1
+		}
+		return 'x'
+	}, strings.TrimSpace(value))
+	if len(hex) != 12 || strings.ContainsRune(hex, 'x') {
+		return value
+	}
+	parts := make([]string, 6)
+	for i := range parts {
+		parts[i] = hex[i*2 : i*2+2]
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,115 @@
+package radius
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+)
+
+// NAS Sends accounting requests like a NAS device does, it simulates
+// devices in tests and in the radsim command
+type NAS struct {
+	Server  string // host:port of the accounting server
+	Secret  string
+	Timeout time.Duration // of each attempt, 3 seconds by default
+	Retries int
+
+	id uint32
+}
+
+// Send Send an accounting request and wait for the response
+func (n *NAS) Send(ctx context.Context, acct *Accounting) error {
+	p := acct.Packet()
+	p.Identifier = byte(atomic.AddUint32(&n.id, 1))
+	b, err := p.EncodeAccountingRequest([]byte(n.Secret))
+	if err != nil {
+		return err
+	}
+	conn, err := net.Dial("udp", n.Server)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	timeout := n.Timeout
+	if timeout <= 0 {
+		timeout = 3 * time.Second
+	}
+	buf := make([]byte, MaxPacketSize)
+	for attempt := 0; attempt <= n.Retries; attempt++ {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		if _, err = conn.Write(b); err != nil {
+			return err
+		}
+		deadline := time.Now().Add(timeout)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		_ = conn.SetReadDeadline(deadline)
+		for {
+			var size int
+			size, err = conn.Read(buf)
+			if err != nil {
+				break
+			}
+			resp, perr := Parse(buf[:size])
+			if perr != nil || resp.Identifier != p.Identifier {
+				continue
+			}
+			if resp.Code != CodeAccountingResponse {
+				return fmt.Errorf("unexpected radius response code %d", resp.Code)
+			}
+			if !VerifyResponse(buf[:size], p, []byte(n.Secret)) {
+				return ErrBadAuthenticator
+			}
+			return nil
+		}
+		var netErr net.Error
+		if !errors.As(err, &netErr) || !netErr.Timeout() {
+			return err
+		}
+	}
+	return fmt.Errorf("no accounting response from %s", n.Server)
+}
+
+// Session A simulated user session
+type Session struct {
+	nas     *NAS
+	acct    Accounting
+	started time.Time
+}
+
+// StartSession Send the Start of a session, acct holds the user and NAS attributes
+func (n *NAS) StartSession(ctx context.Context, acct Accounting) (*Session, error) {
+	acct.StatusType = StatusStart
+	s := &Session{nas: n, acct: acct, started: time.Now()}
+	return s, n.Send(ctx, &s.acct)
+}
+
+// Interim Send an Interim-Update with the session time and the total traffic
+func (s *Session) Interim(ctx context.Context, sessionTime uint32, input, output uint64) error {
+	s.acct.StatusType = StatusInterimUpdate
+	s.update(sessionTime, input, output)
+	return s.nas.Send(ctx, &s.acct)
+}
+
+// Stop Send the Stop of the session
+func (s *Session) Stop(ctx context.Context, sessionTime uint32, input, output uint64, cause uint32) error {
+	s.acct.StatusType = StatusStop
+	s.acct.TerminateCause = cause
+	s.update(sessionTime, input, output)
+	return s.nas.Send(ctx, &s.acct)
+}
+
+func (s *Session) update(sessionTime uint32, input, output uint64) {
+	s.acct.SessionTime = sessionTime
+	s.acct.InputOctets = input
+	s.acct.OutputOctets = output
+	s.acct.InputPackets = uint32(input / 1000)
+	s.acct.OutputPackets = uint32(output / 1000)
+}
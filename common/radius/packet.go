@@ -0,0 +1,245 @@
+// Package radius implements the parts of RADIUS (RFC 2865) needed to
+// receive accounting (RFC 2866): the packet codec, request and response
+// authenticators, an accounting server and a NAS client for tests.
+package radius
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+)
+
+const (
+	CodeAccessRequest      = 1
+	CodeAccessAccept       = 2
+	CodeAccessReject       = 3
+	CodeAccountingRequest  = 4
+	CodeAccountingResponse = 5
+)
+
+const (
+	headerLen = 20
+	// MaxPacketSize The largest packet RFC 2865 allows
+	MaxPacketSize = 4096
+)
+
+var ErrBadAuthenticator = errors.New("radius packet authenticator mismatch, check the shared secret")
+
+// Attribute A raw attribute
+type Attribute struct {
+	Type  byte
+	Value []byte
+}
+
+// Packet A RADIUS packet
+type Packet struct {
+	Code          byte
+	Identifier    byte
+	Authenticator [16]byte
+	Attributes    []Attribute
+}
+
+// Parse Decode a packet
+func Parse(b []byte) (*Packet, error) {
+	if len(b) < headerLen {
+		return nil, errors.New("radius packet too short")
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < headerLen || length > MaxPacketSize || length > len(b) {
+		return nil, fmt.Errorf("bad radius packet length %d", length)
+	}
+	p := &Packet{Code: b[0], Identifier: b[1]}
+	copy(p.Authenticator[:], b[4:20])
+	attrs := b[headerLen:length]
+	for len(attrs) > 0 {
+		if len(attrs) < 2 || attrs[1] < 2 || int(attrs[1]) > len(attrs) {
+			return nil, errors.New("bad radius attribute length")
+		}
+		p.Attributes = append(p.Attributes, Attribute{Type: attrs[0], Value: attrs[2:attrs[1]]})
+		attrs = attrs[attrs[1]:]
+	}
+	return p, nil
+}
+
+// Encode Encode the packet as it is, see Request and Response for the authenticators
+func (p *Packet) Encode() ([]byte, error) {
+	buf := bytes.NewBuffer(make([]byte, headerLen, headerLen+64))
+	for _, attr := range p.Attributes {
+		if len(attr.Value) > 253 {
+			return nil, fmt.Errorf("radius attribute %d is too long", attr.Type)
+		}
+		buf.WriteByte(attr.Type)
+		buf.WriteByte(byte(len(attr.Value) + 2))
+		buf.Write(attr.Value)
+	}
+	b := buf.Bytes()
+	if len(b) > MaxPacketSize {
+		return nil, errors.New("radius packet too long")
+	}
+	b[0] = p.Code
+	b[1] = p.Identifier
+	binary.BigEndian.PutUint16(b[2:4], uint16(len(b)))
+	copy(b[4:20], p.Authenticator[:])
+	return b, nil
+}
+
+// Get The value of the first attribute of a type
+func (p *Packet) Get(t byte) ([]byte, bool) {
+	for _, attr := range p.Attributes {
+		if attr.Type == t {
+			return attr.Value, true
+		}
+	}
+	return nil, false
+}
+
+// String The text value of an attribute, empty when missing
+func (p *Packet) String(t byte) string {
+	v, _ := p.Get(t)
+	return string(v)
+}
+
+// Uint32 The integer value of an attribute, 0 when missing or malformed
+func (p *Packet) Uint32(t byte) uint32 {
+	v, ok := p.Get(t)
+	if !ok || len(v) != 4 {
+		return 0
+	}
+	return binary.BigEndian.Uint32(v)
+}
+
+// IP The address value of an attribute, nil when missing or malformed
+func (p *Packet) IP(t byte) net.IP {
+	v, ok := p.Get(t)
+	if !ok || len(v) != 4 {
+		return nil
+	}
+	return net.IPv4(v[0], v[1], v[2], v[3])
+}
+
+// Add Append an attribute
+func (p *Packet) Add(t byte, value []byte) {
+	p.Attributes = append(p.Attributes, Attribute{Type: t, Value: value})
+}
+
+// AddString Append a text attribute, empty values are skipped
+func (p *Packet) AddString(t byte, value string) {
+	if value != "" {
+		p.Add(t, []byte(value))
+	}
+}
+
+// AddUint32 Append an integer attribute
+func (p *Packet) AddUint32(t byte, value uint32) {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, value)
+	p.Add(t, b)
+}
+
+// AddIP Append an IPv4 address attribute, nil and IPv6 addresses are skipped
+func (p *Packet) AddIP(t byte, ip net.IP) {
+	if ip4 := ip.To4(); ip4 != nil {
+		p.Add(t, []byte(ip4))
+	}
+}
+
+// AddVendor Append a Vendor-Specific attribute holding one vendor attribute
+func (p *Packet) AddVendor(vendor uint32, t byte, value []byte) {
+	b := make([]byte, 6, 6+len(value))
+	binary.BigEndian.PutUint32(b, vendor)
+	b[4] = t
+	b[5] = byte(len(value) + 2)
+	p.Add(AttrVendorSpecific, append(b, value...))
+}
+
+// VendorAttribute An attribute inside a Vendor-Specific attribute
+type VendorAttribute struct {
+	Vendor uint32
+	Type   byte
+	Value  []byte
+}
+
+// VendorAttributes The vendor attributes of all Vendor-Specific attributes,
+// those not using the recommended type/length format are skipped
+func (p *Packet) VendorAttributes() []VendorAttribute {
+	var result []VendorAttribute
+	for _, attr := range p.Attributes {
+		if attr.Type != AttrVendorSpecific || len(attr.Value) < 6 {
+			continue
+		}
+		vendor := binary.BigEndian.Uint32(attr.Value[:4])
+		data := attr.Value[4:]
+		for len(data) >= 2 && data[1] >= 2 && int(data[1]) <= len(data) {
+			result = append(result, VendorAttribute{Vendor: vendor, Type: data[0], Value: data[2:data[1]]})
+			data = data[data[1]:]
+		}
+	}
+	return result
+}
+
+// accountingAuthenticator MD5(Code+Identifier+Length+auth+Attributes+Secret)
+func accountingAuthenticator(b []byte, auth []byte, secret []byte) [16]byte {
+	h := md5.New()
+	h.Write(b[:4])
+	h.Write(auth)
+	h.Write(b[headerLen:])
+	h.Write(secret)
+	var sum [16]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// VerifyAccountingRequest Check the request authenticator of an encoded Accounting-Request
+func VerifyAccountingRequest(b []byte, secret []byte) bool {
+	if len(b) < headerLen {
+		return false
+	}
+	length := int(binary.BigEndian.Uint16(b[2:4]))
+	if length < headerLen || length > len(b) {
+		return false
+	}
+	b = b[:length]
+	sum := accountingAuthenticator(b, make([]byte, 16), secret)
+	return bytes.Equal(sum[:], b[4:20])
+}
+
+// EncodeAccountingRequest Encode an Accounting-Request signing it with secret
+func (p *Packet) EncodeAccountingRequest(secret []byte) ([]byte, error) {
+	p.Code = CodeAccountingRequest
+	p.Authenticator = [16]byte{}
+	b, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	p.Authenticator = accountingAuthenticator(b, make([]byte, 16), secret)
+	copy(b[4:20], p.Authenticator[:])
+	return b, nil
+}
+
+// Response An empty reply to a request with its identifier
+func (p *Packet) Response(code byte) *Packet {
+	return &Packet{Code: code, Identifier: p.Identifier, Authenticator: p.Authenticator}
+}
+
+// EncodeResponse Encode a response, Authenticator must hold the request authenticator
+func (p *Packet) EncodeResponse(secret []byte) ([]byte, error) {
+	b, err := p.Encode()
+	if err != nil {
+		return nil, err
+	}
+	sum := accountingAuthenticator(b, p.Authenticator[:], secret)
+	copy(b[4:20], sum[:])
+	return b, nil
+}
+
+// VerifyResponse Check the response authenticator of an encoded response to request
+func VerifyResponse(b []byte, request *Packet, secret []byte) bool {
+	if len(b) < headerLen {
+		return false
+	}
+	sum := accountingAuthenticator(b, request.Authenticator[:], secret)
+	return bytes.Equal(sum[:], b[4:20])
+}
@@ -0,0 +1,207 @@
+package radius
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestPacketRoundTrip(t *testing.T) {
+	p := &Packet{Code: CodeAccountingRequest, Identifier: 7}
+	p.AddString(AttrUserName, "alice")
+	p.AddUint32(AttrAcctStatusType, StatusStart)
+	p.AddIP(AttrNasIPAddress, net.ParseIP("10.0.0.1"))
+	p.AddString(AttrClass, "")
+	b, err := p.EncodeAccountingRequest([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := Parse(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Identifier != 7 || len(q.Attributes) != 3 {
+		t.Fatalf("unexpected packet %+v", q)
+	}
+	if q.String(AttrUserName) != "alice" || q.Uint32(AttrAcctStatusType) != StatusStart ||
+		q.IP(AttrNasIPAddress).String() != "10.0.0.1" {
+		t.Fatalf("unexpected attributes %+v", q.Attributes)
+	}
+	if _, err = Parse(b[:headerLen-1]); err == nil {
+		t.Fatal("short packet accepted")
+	}
+	bad := append([]byte{}, b...)
+	bad[headerLen+1] = 200
+	if _, err = Parse(bad); err == nil {
+		t.Fatal("bad attribute length accepted")
+	}
+}
+
+func TestAuthenticators(t *testing.T) {
+	p := &Packet{Identifier: 1}
+	p.AddString(AttrUserName, "bob")
+	b, err := p.EncodeAccountingRequest([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyAccountingRequest(b, []byte("secret")) {
+		t.Fatal("request authenticator rejected")
+	}
+	if VerifyAccountingRequest(b, []byte("wrong")) {
+		t.Fatal("request authenticator accepted with the wrong secret")
+	}
+	resp, err := p.Response(CodeAccountingResponse).EncodeResponse([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyResponse(resp, p, []byte("secret")) {
+		t.Fatal("response authenticator rejected")
+	}
+	if VerifyResponse(resp, p, []byte("wrong")) {
+		t.Fatal("response authenticator accepted with the wrong secret")
+	}
+}
+
+func TestParseAccounting(t *testing.T) {
+	event := time.Unix(1792300000, 0)
+	src := &Accounting{
+		StatusType:   StatusStop,
+		Username:     "carol",
+		SessionID:    "s-1",
+		NasIP:        net.ParseIP("10.0.0.1"),
+		MacAddr:      "AABB.CCDD.EEFF",
+		SessionTime:  600,
+		InputOctets:  5<<32 + 10,
+		OutputOctets: 20,
+		EventTime:    event,
+	}
+	p := src.Packet()
+	p.AddVendor(VendorCisco, ciscoAVPair, []byte("nas-port-id=GigabitEthernet0/1"))
+	p.AddVendor(VendorH3C, h3cIPHostAddr, []byte("192.168.1.10 00-11-22-33-44-55"))
+	acct := ParseAccounting(p)
+	if acct.Username != "carol" || acct.SessionID != "s-1" || acct.StatusType != StatusStop {
+		t.Fatalf("unexpected accounting %+v", acct)
+	}
+	if acct.InputOctets != 5<<32+10 || acct.OutputOctets != 20 {
+		t.Fatalf("gigawords not applied: %d %d", acct.InputOctets, acct.OutputOctets)
+	}
+	if acct.MacAddr != "aa:bb:cc:dd:ee:ff" {
+		t.Fatalf("unexpected mac %s", acct.MacAddr)
+	}
+	if acct.NasPortID != "GigabitEthernet0/1" || acct.FramedIP.String() != "192.168.1.10" {
+		t.Fatalf("vendor attributes not applied: %+v", acct)
+	}
+	if !acct.Time(time.Now()).Equal(event) || !acct.StartTime(time.Now()).Equal(event.Add(-10*time.Minute)) {
+		t.Fatalf("unexpected times %s %s", acct.Time(time.Now()), acct.StartTime(time.Now()))
+	}
+	received := time.Unix(1792300100, 0)
+	acct.EventTime = time.Time{}
+	acct.DelayTime = 100
+	if !acct.Time(received).Equal(time.Unix(1792300000, 0)) {
+		t.Fatalf("delay time not applied: %s", acct.Time(received))
+	}
+}
+
+func TestReopenCauses(t *testing.T) {
+	acct := &Accounting{StatusType: StatusInterimUpdate}
+	if causes := acct.ReopenCauses(); len(causes) != 1 || causes[0] != CauseLostService {
+		t.Fatalf("interim update reopens %v", causes)
+	}
+	for _, status := range []uint32{StatusStart, StatusStop, StatusAccountingOn} {
+		acct.StatusType = status
+		if causes := acct.ReopenCauses(); len(causes) > 0 {
+			t.Errorf("%s reopens %v", StatusName(status), causes)
+		}
+	}
+}
+
+func TestNormalizeMac(t *testing.T) {
+	for value, expect := range map[string]string{
+		"AA-BB-CC-DD-EE-FF": "aa:bb:cc:dd:ee:ff",
+		"aabb.ccdd.eeff":    "aa:bb:cc:dd:ee:ff",
+		"AABBCCDDEEFF":      "aa:bb:cc:dd:ee:ff",
+		"station-1":         "station-1",
+		"":                  "",
+	} {
+		if got := NormalizeMac(value); got != expect {
+			t.Errorf("NormalizeMac(%q) = %q, want %q", value, got, expect)
+		}
+	}
+}
+
+func TestServerWithNAS(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	var mu sync.Mutex
+	var records []Accounting
+	var dropped []error
+	fail := true
+	server := &Server{
+		Secret: func(addr *net.UDPAddr) ([]byte, bool) {
+			return []byte("testing123"), addr.IP.IsLoopback()
+		},
+		Handler: func(req *Request) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if req.Accounting.StatusType == StatusStop && fail {
+				fail = false
+				return errors.New("storage unavailable")
+			}
+			records = append(records, *req.Accounting)
+			return nil
+		},
+		OnError: func(addr net.Addr, err error) {
+			mu.Lock()
+			dropped = append(dropped, err)
+			mu.Unlock()
+		},
+	}
+	go server.Serve(conn)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	nas := &NAS{Server: conn.LocalAddr().String(), Secret: "testing123", Timeout: 300 * time.Millisecond, Retries: 2}
+	session, err := nas.StartSession(ctx, Accounting{Username: "dave", SessionID: "s-2", NasIP: net.ParseIP("10.0.0.2")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = session.Interim(ctx, 60, 1000, 2000); err != nil {
+		t.Fatal(err)
+	}
+	// the first Stop is not answered, the NAS retransmits it
+	if err = session.Stop(ctx, 120, 3000, 4000, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	wrong := &NAS{Server: nas.Server, Secret: "wrong", Timeout: 100 * time.Millisecond}
+	if err = wrong.Send(ctx, &Accounting{StatusType: StatusStart, Username: "eve"}); err == nil {
+		t.Fatal("request with the wrong secret answered")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d", len(records))
+	}
+	for i, status := range []uint32{StatusStart, StatusInterimUpdate, StatusStop} {
+		if records[i].StatusType != status || records[i].Username != "dave" {
+			t.Fatalf("unexpected record %d: %+v", i, records[i])
+		}
+	}
+	if stop := records[2]; stop.SessionTime != 120 || stop.InputOctets != 3000 || stop.TerminateCause != 1 {
+		t.Fatalf("unexpected stop %+v", stop)
+	}
+	var badAuth bool
+	for _, err := range dropped {
+		badAuth = badAuth || errors.Is(err, ErrBadAuthenticator)
+	}
+	if !badAuth {
+		t.Fatalf("bad authenticator not reported: %v", dropped)
+	}
+}
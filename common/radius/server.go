@@ -0,0 +1,157 @@
+package radius
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sync"
+	"time"
+)
+
+// SecretFunc The shared secret of the NAS at a source address, false drops its packets
+type SecretFunc func(addr *net.UDPAddr) ([]byte, bool)
+
+// Handler Records an accounting request, the NAS only gets a response when
+// it returns nil so that it retransmits what could not be recorded (RFC 2866)
+type Handler func(req *Request) error
+
+// Request A verified Accounting-Request
+type Request struct {
+	Addr       *net.UDPAddr
+	Packet     *Packet
+	Accounting *Accounting
+	Received   time.Time
+}
+
+// Server An accounting server. Packets of a NAS are handled by one worker in
+// arrival order, so the Start, Interim-Update and Stop of a session are not reordered
+type Server struct {
+	Secret  SecretFunc
+	Handler Handler
+	Workers int
+	// OnError Is told about the dropped packets, may be nil
+	OnError func(addr net.Addr, err error)
+
+	mu   sync.Mutex
+	conn net.PacketConn
+}
+
+type datagram struct {
+	addr *net.UDPAddr
+	data []byte
+}
+
+// ListenAndServe Listen on a UDP address such as 0.0.0.0:1813 and serve until Close
+func (s *Server) ListenAndServe(addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(conn)
+}
+
+// Serve Serve the requests arriving on conn until it is closed
+func (s *Server) Serve(conn net.PacketConn) error {
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	workers := s.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+	queues := make([]chan datagram, workers)
+	var wg sync.WaitGroup
+	for i := range queues {
+		queues[i] = make(chan datagram, 1024)
+		wg.Add(1)
+		go func(queue chan datagram) {
+			defer wg.Done()
+			for d := range queue {
+				s.handle(conn, d)
+			}
+		}(queues[i])
+	}
+	defer func() {
+		for _, queue := range queues {
+			close(queue)
+		}
+		wg.Wait()
+	}()
+
+	buf := make([]byte, MaxPacketSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		udpAddr, ok := addr.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		d := datagram{addr: udpAddr, data: make([]byte, n)}
+		copy(d.data, buf[:n])
+		h := fnv.New32a()
+		h.Write(udpAddr.IP)
+		select {
+		case queues[h.Sum32()%uint32(workers)] <- d:
+		default:
+			// the NAS retransmits what is not answered
+			s.fail(addr, errors.New("radius accounting queue is full"))
+		}
+	}
+}
+
+// Close Stop serving
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+func (s *Server) fail(addr net.Addr, err error) {
+	if s.OnError != nil {
+		s.OnError(addr, err)
+	}
+}
+
+func (s *Server) handle(conn net.PacketConn, d datagram) {
+	secret, ok := s.Secret(d.addr)
+	if !ok {
+		s.fail(d.addr, fmt.Errorf("unknown NAS %s", d.addr.IP))
+		return
+	}
+	p, err := Parse(d.data)
+	if err != nil {
+		s.fail(d.addr, err)
+		return
+	}
+	if p.Code != CodeAccountingRequest {
+		s.fail(d.addr, fmt.Errorf("unsupported radius code %d", p.Code))
+		return
+	}
+	if !VerifyAccountingRequest(d.data, secret) {
+		s.fail(d.addr, ErrBadAuthenticator)
+		return
+	}
+	req := &Request{Addr: d.addr, Packet: p, Accounting: ParseAccounting(p), Received: time.Now()}
+	if err = s.Handler(req); err != nil {
+		s.fail(d.addr, err)
+		return
+	}
+	b, err := p.Response(CodeAccountingResponse).EncodeResponse(secret)
+	if err != nil {
+		s.fail(d.addr, err)
+		return
+	}
+	if _, err = conn.WriteTo(b, d.addr); err != nil {
+		s.fail(d.addr, err)
+	}
+}
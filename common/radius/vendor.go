@@ -0,0 +1,61 @@
+package radius
+
+import (
+	"net"
+	"strings"
+)
+
+const (
+	VendorCisco = 9
+	VendorH3C   = 25506
+)
+
+const (
+	// ciscoAVPair Cisco-AVPair, "name=value" pairs
+	ciscoAVPair = 1
+	// h3cIPHostAddr H3C-Ip-Host-Addr, "ip mac" of the user host
+	h3cIPHostAddr = 60
+)
+
+func init() {
+	RegisterVendor(VendorCisco, parseCisco)
+	RegisterVendor(VendorH3C, parseH3C)
+}
+
+// parseCisco Cisco BNGs send the subscriber MAC as client-mac-address=aabb.ccdd.eeff
+func parseCisco(acct *Accounting, attr VendorAttribute) {
+	if attr.Type != ciscoAVPair {
+		return
+	}
+	name, value, ok := strings.Cut(string(attr.Value), "=")
+	if !ok {
+		return
+	}
+	switch strings.TrimSpace(name) {
+	case "client-mac-address":
+		if acct.MacAddr == "" {
+			acct.MacAddr = NormalizeMac(value)
+		}
+	case "nas-port-id":
+		if acct.NasPortID == "" {
+			acct.NasPortID = value
+		}
+	}
+}
+
+// parseH3C H3C and HPE Comware devices send the host address and MAC in one attribute
+func parseH3C(acct *Accounting, attr VendorAttribute) {
+	if attr.Type != h3cIPHostAddr {
+		return
+	}
+	fields := strings.Fields(string(attr.Value))
+	if len(fields) != 2 {
+		return
+	}
+	if acct.FramedIP == nil {
+		acct.FramedIP = net.ParseIP(fields[0]).To4()
+	}
+	if acct.MacAddr == "" {
+		acct.MacAddr = NormalizeMac(fields[1])
+	}
+}
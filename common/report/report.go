@@ -0,0 +1,180 @@
+// Package report renders report documents, sections made of a bar chart
+// and a table, as a self-contained HTML page. The charts are plain CSS bars
+// so the page also displays in mail clients and offline.
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"time"
+
+	"github.com/talkincode/logsight/common/export"
+)
+
+const (
+	// ChartBar Horizontal bars, one per item, for rankings
+	ChartBar = "bar"
+	// ChartColumn Vertical columns, for values over time
+	ChartColumn = "column"
+)
+
+// Document A report
+type Document struct {
+	Title       string
+	Subtitle    string
+	GeneratedAt time.Time
+	Sections    []Section
+}
+
+// Section A part of a report, Chart and Table are optional
+type Section struct {
+	Title string
+	Chart *Chart
+	Table *Table
+}
+
+// Chart A bar or column chart
+type Chart struct {
+	Kind  string
+	Items []ChartItem
+}
+
+// ChartItem A labelled chart value
+type ChartItem struct {
+	Label string
+	Value float64
+}
+
+// Table A table with the values of a row in column order
+type Table struct {
+	Columns []string
+	Rows    [][]interface{}
+}
+
+// AddRow Append a row
+func (t *Table) AddRow(values ...interface{}) {
+	t.Rows = append(t.Rows, values)
+}
+
+// ChartOf A chart of two table columns, the labels and the values
+func (t *Table) ChartOf(kind string, label, value int) *Chart {
+	chart := &Chart{Kind: kind}
+	for _, row := range t.Rows {
+		var v float64
+		switch n := row[value].(type) {
+		case int:
+			v = float64(n)
+		case int64:
+			v = float64(n)
+		case float64:
+			v = n
+		}
+		chart.Items = append(chart.Items, ChartItem{Label: export.FormatValue(row[label]), Value: v})
+	}
+	return chart
+}
+
+// WriteTo Write the table in an export format, see package export
+func (t *Table) WriteTo(w io.Writer, format string) (int64, error) {
+	ew, err := export.New(format, w, t.Columns)
+	if err != nil {
+		return 0, err
+	}
+	for _, row := range t.Rows {
+		if err = ew.WriteRow(row); err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(t.Rows)), ew.Close()
+}
+
+// Bar A chart item scaled to the largest value
+type Bar struct {
+	Label   string
+	Value   string
+	Percent float64
+}
+
+// Bars The items scaled for drawing
+func (c *Chart) Bars() []Bar {
+	var max float64
+	for _, item := range c.Items {
+		if item.Value > max {
+			max = item.Value
+		}
+	}
+	bars := make([]Bar, 0, len(c.Items))
+	for _, item := range c.Items {
+		bar := Bar{Label: item.Label, Value: export.FormatValue(item.Value)}
+		if max > 0 {
+			bar.Percent = item.Value * 100 / max
+		}
+		bars = append(bars, bar)
+	}
+	return bars
+}
+
+var funcs = template.FuncMap{
+	"cell": export.FormatValue,
+	"last": func(items []ChartItem) int {
+		return len(items) - 1
+	},
+	"percent": func(v float64) template.CSS {
+		return template.CSS(fmt.Sprintf("%.1f%%", v))
+	},
+}
+
+var page = template.Must(template.New("report").Funcs(funcs).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", "Microsoft YaHei", sans-serif; color: #333; margin: 24px; }
+h1 { font-size: 22px; margin-bottom: 4px; }
+h2 { font-size: 17px; margin-top: 28px; border-bottom: 1px solid #ddd; padding-bottom: 4px; }
+.meta { color: #888; font-size: 13px; }
+table { border-collapse: collapse; font-size: 13px; margin-top: 8px; }
+th, td { border: 1px solid #e3e3e3; padding: 4px 10px; text-align: left; }
+th { background: #f5f7fa; }
+.bar-row { display: flex; align-items: center; font-size: 13px; margin: 3px 0; }
+.bar-label { width: 220px; overflow: hidden; text-overflow: ellipsis; white-space: nowrap; }
+.bar-track { flex: 1; max-width: 520px; background: #f0f2f5; }
+.bar { background: #3a84d8; height: 14px; }
+.bar-value { width: 100px; padding-left: 8px; }
+.columns { display: flex; align-items: flex-end; height: 160px; border-bottom: 1px solid #ccc; }
+.column { flex: 1; background: #3a84d8; margin: 0 1px; min-height: 1px; }
+.column-axis { display: flex; justify-content: space-between; font-size: 12px; color: #888; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<div class="meta">{{.Subtitle}}{{if .Subtitle}} · {{end}}{{.GeneratedAt.Format "2006-01-02 15:04:05"}}</div>
+{{range .Sections}}
+<h2>{{.Title}}</h2>
+{{with .Chart}}{{$items := .Items}}
+{{if eq .Kind "column"}}
+<div class="columns">{{range .Bars}}<div class="column" style="height: {{percent .Percent}}" title="{{.Label}}: {{.Value}}"></div>{{end}}</div>
+{{if $items}}<div class="column-axis"><span>{{(index $items 0).Label}}</span><span>{{(index $items (last $items)).Label}}</span></div>{{end}}
+{{else}}
+{{range .Bars}}<div class="bar-row"><div class="bar-label" title="{{.Label}}">{{.Label}}</div><div class="bar-track"><div class="bar" style="width: {{percent .Percent}}"></div></div><div class="bar-value">{{.Value}}</div></div>
+{{end}}
+{{end}}
+{{end}}
+{{with .Table}}
+<table>
+<tr>{{range .Columns}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr>{{range .}}<td>{{cell .}}</td>{{end}}</tr>
+{{end}}
+</table>
+{{end}}
+{{end}}
+</body>
+</html>
+`))
+
+// WriteHTML Render the document as an HTML page
+func (d *Document) WriteHTML(w io.Writer) error {
+	return page.Execute(w, d)
+}
@@ -0,0 +1,65 @@
+package report
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testTable() *Table {
+	table := &Table{Columns: []string{"hostname", "errors"}}
+	table.AddRow("sw-core-1", int64(200))
+	table.AddRow("<fw-1>", int64(50))
+	return table
+}
+
+func TestChartOf(t *testing.T) {
+	bars := testTable().ChartOf(ChartBar, 0, 1).Bars()
+	if len(bars) != 2 || bars[0].Percent != 100 || bars[1].Percent != 25 {
+		t.Fatalf("unexpected bars %v", bars)
+	}
+	if bars[1].Label != "<fw-1>" || bars[1].Value != "50" {
+		t.Errorf("unexpected bar %v", bars[1])
+	}
+}
+
+func TestWriteHTML(t *testing.T) {
+	table := testTable()
+	doc := &Document{
+		Title:       "Top error hosts",
+		Subtitle:    "2026-10-17 08:00 - 2026-10-18 08:00",
+		GeneratedAt: time.Date(2026, 10, 18, 8, 0, 0, 0, time.UTC),
+		Sections: []Section{
+			{Title: "Errors", Chart: table.ChartOf(ChartBar, 0, 1), Table: table},
+			{Title: "Timeline", Chart: &Chart{Kind: ChartColumn, Items: []ChartItem{{"08:00", 3}, {"09:00", 6}}}},
+		},
+	}
+	var buf bytes.Buffer
+	if err := doc.WriteHTML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	html := buf.String()
+	for _, want := range []string{
+		"<h1>Top error hosts</h1>",
+		`style="width: 25.0%"`,
+		"<td>&lt;fw-1&gt;</td>",
+		`style="height: 50.0%"`,
+		"<span>09:00</span>",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("missing %s", want)
+		}
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	var buf bytes.Buffer
+	rows, err := testTable().WriteTo(&buf, "csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows != 2 || buf.String() != "hostname,errors\nsw-core-1,200\n<fw-1>,50\n" {
+		t.Errorf("unexpected csv %d %s", rows, buf.String())
+	}
+}
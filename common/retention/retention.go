@@ -0,0 +1,139 @@
+// Package retention turns per-source retention rules into SQL delete steps.
+//
+// Rules are checked in order and the first matching rule decides how long a
+// record is kept, records matching no rule use the default retention. A
+// retention of 0 days keeps records forever.
+package retention
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/talkincode/logsight/common/glob"
+)
+
+// Rule Hostname and Appname are glob patterns, empty matches everything.
+// Severity matches records at this severity or less severe (a larger
+// number), -1 matches any severity, e.g. 7 selects debug messages only.
+type Rule struct {
+	ID       int64
+	Hostname string
+	Appname  string
+	Severity int
+	Days     int
+}
+
+// Step A delete step, Where selects the records to purge
+type Step struct {
+	RuleID int64 // 0 for the default retention
+	Cutoff time.Time
+	Where  string
+	Args   []interface{}
+}
+
+// Validate Check the glob patterns and the retention period
+func (r Rule) Validate() error {
+	if _, err := path.Match(r.Hostname, ""); err != nil {
+		return fmt.Errorf("bad hostname pattern %s", r.Hostname)
+	}
+	if _, err := path.Match(r.Appname, ""); err != nil {
+		return fmt.Errorf("bad appname pattern %s", r.Appname)
+	}
+	if r.Days < 0 {
+		return fmt.Errorf("retention days must not be negative")
+	}
+	if r.Severity < -1 || r.Severity > 7 {
+		return fmt.Errorf("severity must be between -1 and 7")
+	}
+	return nil
+}
+
+// match The SQL condition selecting the records of a rule, empty when it matches everything
+func (r Rule) match() (string, []interface{}) {
+	var conds []string
+	var args []interface{}
+	if r.Hostname != "" && r.Hostname != "*" {
+		conds = append(conds, "hostname LIKE ?")
+		args = append(args, glob.ToLike(r.Hostname))
+	}
+	if r.Appname != "" && r.Appname != "*" {
+		conds = append(conds, "appname LIKE ?")
+		args = append(args, glob.ToLike(r.Appname))
+	}
+	if r.Severity >= 0 {
+		conds = append(conds, "severity >= ?")
+		args = append(args, r.Severity)
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// Plan Build the delete steps for the rules and the default retention
+func Plan(rules []Rule, defaultDays int, timeField string, now time.Time) []Step {
+	var steps []Step
+	var previous []string
+	var previousArgs []interface{}
+	catchAll := false
+	for _, rule := range rules {
+		cond, args := rule.match()
+		if rule.Days > 0 {
+			step := Step{RuleID: rule.ID, Cutoff: now.AddDate(0, 0, -rule.Days)}
+			where := []string{timeField + " < ?"}
+			step.Args = append(step.Args, step.Cutoff)
+			if cond != "" {
+				where = append(where, cond)
+				step.Args = append(step.Args, args...)
+			}
+			if len(previous) > 0 {
+				where = append(where, "NOT ("+strings.Join(previous, " OR ")+")")
+				step.Args = append(step.Args, previousArgs...)
+			}
+			step.Where = strings.Join(where, " AND ")
+			steps = append(steps, step)
+		}
+		if cond == "" {
+			// later rules and the default can never match
+			catchAll = true
+			break
+		}
+		previous = append(previous, "("+cond+")")
+		previousArgs = append(previousArgs, args...)
+	}
+	if defaultDays > 0 && !catchAll {
+		step := Step{Cutoff: now.AddDate(0, 0, -defaultDays)}
+		where := []string{timeField + " < ?"}
+		step.Args = append(step.Args, step.Cutoff)
+		if len(previous) > 0 {
+			where = append(where, "NOT ("+strings.Join(previous, " OR ")+")")
+			step.Args = append(step.Args, previousArgs...)
+		}
+		step.Where = strings.Join(where, " AND ")
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// MaxRetention The longest retention of all rules, 0 when some records are kept forever.
+// Everything older than this can be dropped without looking at the rules
+func MaxRetention(rules []Rule, defaultDays int) int {
+	max := 0
+	for _, rule := range rules {
+		if rule.Days == 0 {
+			return 0
+		}
+		if rule.Days > max {
+			max = rule.Days
+		}
+		if cond, _ := rule.match(); cond == "" {
+			return max
+		}
+	}
+	if defaultDays == 0 {
+		return 0
+	}
+	if defaultDays > max {
+		max = defaultDays
+	}
+	return max
+}
@@ -0,0 +1,63 @@
+package retention
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func TestPlan(t *testing.T) {
+	rules := []Rule{
+		{ID: 1, Severity: 7, Days: 7},
+		{ID: 2, Appname: "sshd", Severity: -1, Days: 730},
+		{ID: 3, Hostname: "lab-*", Severity: -1, Days: 0},
+	}
+	steps := Plan(rules, 180, "timestamp", now)
+	if len(steps) != 3 {
+		t.Fatalf("expected 3 steps, got %d", len(steps))
+	}
+	if steps[0].Where != "timestamp < ? AND severity >= ?" || !reflect.DeepEqual(steps[0].Args, []interface{}{now.AddDate(0, 0, -7), 7}) {
+		t.Fatalf("unexpected step %+v", steps[0])
+	}
+	if steps[1].Where != "timestamp < ? AND appname LIKE ? AND NOT ((severity >= ?))" || len(steps[1].Args) != 3 {
+		t.Fatalf("unexpected step %+v", steps[1])
+	}
+	// rule 3 keeps lab hosts forever, so it only appears in the exclusions
+	last := steps[2]
+	if last.RuleID != 0 || last.Where != "timestamp < ? AND NOT ((severity >= ?) OR (appname LIKE ?) OR (hostname LIKE ?))" {
+		t.Fatalf("unexpected default step %+v", last)
+	}
+	if last.Args[3] != "lab-%" {
+		t.Fatalf("unexpected args %v", last.Args)
+	}
+}
+
+func TestPlanCatchAll(t *testing.T) {
+	steps := Plan([]Rule{{ID: 1, Severity: -1, Days: 30}, {ID: 2, Appname: "x", Severity: -1, Days: 1}}, 180, "ts", now)
+	if len(steps) != 1 || steps[0].Where != "ts < ?" {
+		t.Fatalf("a catch-all rule hides later rules and the default, got %+v", steps)
+	}
+	if got := MaxRetention([]Rule{{Severity: 7, Days: 7}, {Severity: -1, Days: 30}}, 365); got != 30 {
+		t.Fatalf("expected 30, got %d", got)
+	}
+	if got := MaxRetention([]Rule{{Severity: 7, Days: 7}, {Appname: "sshd", Severity: -1, Days: 730}}, 180); got != 730 {
+		t.Fatalf("expected 730, got %d", got)
+	}
+	if got := MaxRetention([]Rule{{Severity: 7, Days: 7}}, 0); got != 0 {
+		t.Fatalf("default forever, got %d", got)
+	}
+	if len(Plan(nil, 0, "ts", now)) != 0 {
+		t.Fatal("nothing to purge")
+	}
+}
+
+func TestGlobToLike(t *testing.T) {
+	if (Rule{Hostname: "[", Severity: -1}).Validate() == nil {
+		t.Fatal("expected pattern error")
+	}
+	if (Rule{Severity: 8}).Validate() == nil {
+		t.Fatal("expected severity error")
+	}
+}
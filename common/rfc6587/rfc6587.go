@@ -0,0 +1,77 @@
+// Package rfc6587 implements the transport framing used to carry syslog
+// messages over TCP and TLS streams, as described in RFC 6587.
+package rfc6587
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strconv"
+)
+
+// maxMsgLenDigits is the longest MSG-LEN prefix accepted for octet-counting
+// framing, anything longer is treated as a non-transparent frame.
+const maxMsgLenDigits = 9
+
+// ScanFrames is a bufio.SplitFunc that splits a syslog stream into messages.
+//
+// The framing method is detected per frame, so a single connection may mix
+// octet-counting ("MSG-LEN SP SYSLOG-MSG") and non-transparent framing, where
+// each message is terminated by LF or NUL. Trailing CR characters are removed
+// from non-transparent frames.
+func ScanFrames(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+
+	if data[0] >= '1' && data[0] <= '9' {
+		sp := bytes.IndexByte(data, ' ')
+		switch {
+		case sp > 0 && sp <= maxMsgLenDigits && isDigits(data[:sp]):
+			msglen, _ := strconv.Atoi(string(data[:sp]))
+			end := sp + 1 + msglen
+			if len(data) >= end {
+				return end, data[sp+1 : end], nil
+			}
+			if atEOF {
+				// the peer went away in the middle of a frame, keep what we have
+				return len(data), data[sp+1:], nil
+			}
+			return 0, nil, nil
+		case sp < 0 && len(data) <= maxMsgLenDigits && isDigits(data) && !atEOF:
+			// still reading MSG-LEN
+			return 0, nil, nil
+		}
+	}
+
+	if i := bytes.IndexAny(data, "\n\x00"); i >= 0 {
+		return i + 1, bytes.TrimRight(data[:i], "\r"), nil
+	}
+	if atEOF {
+		return len(data), bytes.TrimRight(data, "\r"), nil
+	}
+	return 0, nil, nil
+}
+
+// NewScanner returns a bufio.Scanner that reads syslog frames from the stream,
+// rejecting frames larger than maxFrameSize bytes.
+func NewScanner(r io.Reader, maxFrameSize int) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	initSize := 4096
+	if maxFrameSize < initSize {
+		initSize = maxFrameSize
+	}
+	// leave room for the MSG-LEN prefix in front of a full sized frame
+	scanner.Buffer(make([]byte, initSize), maxFrameSize+maxMsgLenDigits+1)
+	scanner.Split(ScanFrames)
+	return scanner
+}
+
+func isDigits(bs []byte) bool {
+	for _, b := range bs {
+		if b < '0' || b > '9' {
+			return false
+		}
+	}
+	return len(bs) > 0
+}
@@ -0,0 +1,70 @@
+package rfc6587
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func scanAll(t *testing.T, input string, maxFrameSize int) []string {
+	scanner := NewScanner(strings.NewReader(input), maxFrameSize)
+	var frames []string
+	for scanner.Scan() {
+		frames = append(frames, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return frames
+}
+
+func TestScanFramesOctetCounting(t *testing.T) {
+	input := "17 <13>1 - - - - - -26 <14>Jan  1 00:00:00 host a"
+	frames := scanAll(t, input, 1024)
+	if len(frames) != 2 {
+		t.Fatalf("expected 2 frames, got %d: %q", len(frames), frames)
+	}
+	if frames[0] != "<13>1 - - - - - -" {
+		t.Errorf("unexpected frame %q", frames[0])
+	}
+	if frames[1] != "<14>Jan  1 00:00:00 host a" {
+		t.Errorf("unexpected frame %q", frames[1])
+	}
+}
+
+func TestScanFramesNonTransparent(t *testing.T) {
+	input := "<13>first line\r\n<14>second line\n<15>third\x00<11>last"
+	frames := scanAll(t, input, 1024)
+	expect := []string{"<13>first line", "<14>second line", "<15>third", "<11>last"}
+	if len(frames) != len(expect) {
+		t.Fatalf("expected %d frames, got %d: %q", len(expect), len(frames), frames)
+	}
+	for i := range expect {
+		if frames[i] != expect[i] {
+			t.Errorf("frame %d: expected %q, got %q", i, expect[i], frames[i])
+		}
+	}
+}
+
+func TestScanFramesMixed(t *testing.T) {
+	input := "<13>plain\n9 <14>hello2021-01-01 text starting with a date\n"
+	frames := scanAll(t, input, 1024)
+	expect := []string{"<13>plain", "<14>hello", "2021-01-01 text starting with a date"}
+	if len(frames) != len(expect) {
+		t.Fatalf("expected %d frames, got %d: %q", len(expect), len(frames), frames)
+	}
+	for i := range expect {
+		if frames[i] != expect[i] {
+			t.Errorf("frame %d: expected %q, got %q", i, expect[i], frames[i])
+		}
+	}
+}
+
+func TestScanFramesTooLong(t *testing.T) {
+	scanner := NewScanner(strings.NewReader(strings.Repeat("x", 200)+"\n"), 64)
+	for scanner.Scan() {
+	}
+	if scanner.Err() != bufio.ErrTooLong {
+		t.Fatalf("expected ErrTooLong, got %v", scanner.Err())
+	}
+}
@@ -0,0 +1,179 @@
+package snmp
+
+import (
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gosnmp/gosnmp"
+)
+
+// testAgent A minimal SNMP agent on the loopback answering Get, GetNext and
+// GetBulk from a fixed set of values, over v2c or over v3 for one USM user
+type testAgent struct {
+	t         *testing.T
+	conn      *net.UDPConn
+	params    *gosnmp.GoSNMP
+	engineID  string
+	community string
+	oids      []string
+	values    map[string]gosnmp.SnmpPDU
+}
+
+const testEngineID = "\x80\x00\x1f\x88\x80logsight-test"
+
+func newTestAgent(t *testing.T, params *gosnmp.GoSNMP, values []gosnmp.SnmpPDU) *testAgent {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	agent := &testAgent{
+		t:         t,
+		conn:      conn,
+		params:    params,
+		engineID:  testEngineID,
+		community: params.Community,
+		values:    make(map[string]gosnmp.SnmpPDU),
+	}
+	if usm, ok := params.SecurityParameters.(*gosnmp.UsmSecurityParameters); ok {
+		usm.AuthoritativeEngineID = agent.engineID
+		usm.AuthoritativeEngineBoots = 1
+		usm.AuthoritativeEngineTime = 100
+	}
+	for _, pdu := range values {
+		pdu.Name = "." + strings.TrimPrefix(pdu.Name, ".")
+		agent.values[pdu.Name] = pdu
+		agent.oids = append(agent.oids, pdu.Name)
+	}
+	sort.Slice(agent.oids, func(i, j int) bool {
+		return oidLess(agent.oids[i], agent.oids[j])
+	})
+	go agent.serve()
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return agent
+}
+
+func (a *testAgent) port() int {
+	return a.conn.LocalAddr().(*net.UDPAddr).Port
+}
+
+func oidLess(a, b string) bool {
+	as := strings.Split(strings.Trim(a, "."), ".")
+	bs := strings.Split(strings.Trim(b, "."), ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		x, _ := strconv.Atoi(as[i])
+		y, _ := strconv.Atoi(bs[i])
+		if x != y {
+			return x < y
+		}
+	}
+	return len(as) < len(bs)
+}
+
+func (a *testAgent) serve() {
+	buf := make([]byte, 65536)
+	for {
+		n, addr, err := a.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		msg := make([]byte, n)
+		copy(msg, buf[:n])
+		// requests are checked against the credentials the agent was
+		// given, unauthentic or undecryptable ones are dropped
+		req, err := a.params.UnmarshalTrap(msg, true)
+		if err != nil {
+			continue
+		}
+		resp := a.respond(req)
+		if resp == nil {
+			continue
+		}
+		out, err := resp.MarshalMsg()
+		if err != nil {
+			a.t.Errorf("agent marshal error %s", err)
+			continue
+		}
+		_, _ = a.conn.WriteToUDP(out, addr)
+	}
+}
+
+func (a *testAgent) respond(req *gosnmp.SnmpPacket) *gosnmp.SnmpPacket {
+	if req.Version != a.params.Version {
+		return nil
+	}
+	if req.Version != gosnmp.Version3 {
+		if req.Community != a.community {
+			return nil
+		}
+	} else {
+		usm := req.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+		if usm.AuthoritativeEngineID != a.engineID {
+			// engine discovery, report the engine id, boots and time
+			usm.AuthoritativeEngineID = a.engineID
+			usm.AuthoritativeEngineBoots = 1
+			usm.AuthoritativeEngineTime = 100
+			req.PDUType = gosnmp.Report
+			req.MsgFlags = gosnmp.NoAuthNoPriv
+			req.Variables = []gosnmp.SnmpPDU{{Name: ".1.3.6.1.6.3.15.1.1.4.0", Type: gosnmp.Counter32, Value: uint32(1)}}
+			return req
+		}
+		// the security level must be the one the user is configured with
+		if req.MsgFlags&gosnmp.AuthPriv != a.params.MsgFlags&gosnmp.AuthPriv {
+			return nil
+		}
+		req.MsgFlags &= gosnmp.AuthPriv
+	}
+
+	var vars []gosnmp.SnmpPDU
+	switch req.PDUType {
+	case gosnmp.GetRequest:
+		for _, v := range req.Variables {
+			if pdu, ok := a.values[v.Name]; ok {
+				vars = append(vars, pdu)
+			} else {
+				vars = append(vars, gosnmp.SnmpPDU{Name: v.Name, Type: gosnmp.NoSuchObject})
+			}
+		}
+	case gosnmp.GetNextRequest:
+		for _, v := range req.Variables {
+			vars = append(vars, a.next(v.Name))
+		}
+	case gosnmp.GetBulkRequest:
+		for _, v := range req.Variables {
+			name := v.Name
+			for i := 0; i < int(req.MaxRepetitions); i++ {
+				pdu := a.next(name)
+				vars = append(vars, pdu)
+				if pdu.Type == gosnmp.EndOfMibView {
+					break
+				}
+				name = pdu.Name
+			}
+		}
+	default:
+		return nil
+	}
+	req.PDUType = gosnmp.GetResponse
+	req.Error = gosnmp.NoError
+	req.ErrorIndex = 0
+	req.NonRepeaters = 0
+	req.MaxRepetitions = 0
+	req.Variables = vars
+	return req
+}
+
+// next The value following an oid in lexicographic order
+func (a *testAgent) next(oid string) gosnmp.SnmpPDU {
+	i := sort.Search(len(a.oids), func(i int) bool {
+		return oidLess(oid, a.oids[i])
+	})
+	if i == len(a.oids) {
+		return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.EndOfMibView}
+	}
+	return a.values[a.oids[i]]
+}
@@ -1,12 +1,26 @@
 package snmp
 
+import (
+	"strings"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+)
+
+// Vendor codes are the IANA enterprise numbers of the vendors
 const (
 	VendorMikrotik = "14988"
 	VendorH3C      = "25506"
 	VendorHuawei   = "2011"
 	VendorRuijie   = "4881"
+
+	// VendorAuto The vendor is detected from the sysObjectID of the device
+	VendorAuto = "0"
 )
 
+// enterprisesOID The prefix of sysObjectID values, followed by the enterprise number
+const enterprisesOID = ".1.3.6.1.4.1."
+
 func NewMikrotikDevice() *SnmpDevice {
 	return &SnmpDevice{
 		VendorName:         "Mikrotik",
@@ -58,3 +72,55 @@ func NewRuijieDevice() *SnmpDevice {
 		IfOctetsMap:        nil,
 	}
 }
+
+// NewGenericDevice A device of an unknown vendor, only the standard MIB-II system group is read
+func NewGenericDevice() *SnmpDevice {
+	return &SnmpDevice{
+		VendorName:    "Generic",
+		SystemNameOid: ".1.3.6.1.2.1.1.5.0",
+		ModelOid:      ".1.3.6.1.2.1.1.1.0",
+		UptimeOid:     ".1.3.6.1.2.1.1.3.0",
+		IfOctetsMap:   nil,
+	}
+}
+
+// NewVendorDevice The device template of a vendor code, generic for unknown vendors
+func NewVendorDevice(vendorCode string) *SnmpDevice {
+	switch vendorCode {
+	case VendorMikrotik:
+		return NewMikrotikDevice()
+	case VendorH3C:
+		return NewH3CDevice()
+	case VendorHuawei:
+		return NewHuaweiDevice()
+	case VendorRuijie:
+		return NewRuijieDevice()
+	}
+	return NewGenericDevice()
+}
+
+// VendorOf The vendor code of a sysObjectID such as .1.3.6.1.4.1.25506.1.590,
+// empty when it is not under the enterprises arc
+func VendorOf(sysObjectID string) string {
+	oid := "." + strings.Trim(sysObjectID, ".") + "."
+	if !strings.HasPrefix(oid, enterprisesOID) {
+		return ""
+	}
+	oid = strings.TrimPrefix(oid, enterprisesOID)
+	return oid[:strings.Index(oid, ".")]
+}
+
+// DetectVendor The vendor code of the device from its sysObjectID, the code of
+// a vendor without a device template gets the generic one
+func (c *SnmpV2Client) DetectVendor() (string, error) {
+	rs, err := c.Snmpc.Get([]string{hostresmib.SNMPv2_MIB_sysObjectID_OID + ".0"})
+	if err != nil {
+		return "", err
+	}
+	for _, pdu := range rs.Variables {
+		if pdu.Type == gosnmp.ObjectIdentifier {
+			return VendorOf(pdu.Value.(string)), nil
+		}
+	}
+	return "", nil
+}
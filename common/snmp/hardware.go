@@ -0,0 +1,543 @@
+package snmp
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/montanaflynn/stats"
+	"github.com/talkincode/logsight/common/snmp/mibs/entitymib"
+	"github.com/talkincode/logsight/common/snmp/mibs/h3c"
+	"github.com/talkincode/logsight/common/snmp/mibs/huawei"
+	"github.com/talkincode/logsight/common/snmp/mibs/mikrotik"
+	"github.com/talkincode/logsight/common/snmp/mibs/ruijie"
+)
+
+// Kinds of the hardware sensors
+const (
+	SensorTemperature = "temperature"
+	SensorFan         = "fan"
+	SensorPower       = "power"
+)
+
+// States of the fans and power supplies, and of the temperatures with a threshold
+const (
+	SensorNormal = "normal"
+	SensorFault  = "fault"
+)
+
+// DeviceSensor A temperature, fan or power supply of a device
+type DeviceSensor struct {
+	Kind  string `json:"kind"`
+	Index string `json:"index"` // the row of the vendor table
+	Name  string `json:"name"`
+	// Value degrees Celsius of a temperature or rpm of a fan, 0 when the vendor only reports a state
+	Value float64 `json:"value"`
+	// Status normal or fault, empty when the vendor only reports a value
+	Status string `json:"status"`
+}
+
+// DeviceOptical The optical power of a transceiver
+type DeviceOptical struct {
+	Index   string  `json:"index"`
+	Name    string  `json:"name"`
+	RxPower float64 `json:"rx_power"` // dBm
+	TxPower float64 `json:"tx_power"` // dBm
+}
+
+// SensorStatus The state of the sensors of a kind, fault when one of them is
+// and empty when none of them has a state
+func (dev *SnmpDevice) SensorStatus(kind string) string {
+	status := ""
+	for _, sensor := range dev.Sensors {
+		if sensor.Kind != kind || sensor.Status == "" {
+			continue
+		}
+		if sensor.Status == SensorFault {
+			return SensorFault
+		}
+		status = SensorNormal
+	}
+	return status
+}
+
+func (dev *SnmpDevice) addSensor(kind, index, name string, value float64, status string) {
+	dev.Sensors = append(dev.Sensors, DeviceSensor{Kind: kind, Index: index, Name: name, Value: value, Status: status})
+}
+
+// addTemperature A temperature reading, the device temperature is the highest one
+func (dev *SnmpDevice) addTemperature(index, name string, celsius float64, status string) {
+	dev.addSensor(SensorTemperature, index, name, celsius, status)
+	if math.IsNaN(dev.Temperature) || celsius > dev.Temperature {
+		dev.Temperature = celsius
+	}
+}
+
+// collectDeviceHardware 采集温度、风扇、电源与光模块, 只有厂商表中才有
+func (c *SnmpV2Client) collectDeviceHardware(device *SnmpDevice) error {
+	device.Temperature = math.NaN()
+	device.Sensors = nil
+	device.Opticals = nil
+	switch device.VendorCode {
+	case VendorMikrotik:
+		return c.collectMikrotikHardware(device)
+	case VendorH3C:
+		return c.collectH3CHardware(device)
+	case VendorHuawei:
+		return c.collectHuaweiHardware(device)
+	case VendorRuijie:
+		return c.collectRuijieHardware(device)
+	}
+	return nil
+}
+
+// walkColumn The values of a table column by row index, the OID suffix after the column
+func (c *SnmpV2Client) walkColumn(oid string) (map[string]SnmpValue, error) {
+	prefix := "." + strings.Trim(oid, ".") + "."
+	rs, err := c.Snmpc.BulkWalkAll(oid)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]SnmpValue, len(rs))
+	for _, pdu := range rs {
+		switch pdu.Type {
+		case gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+			continue
+		}
+		if strings.HasPrefix(pdu.Name, prefix) {
+			values[strings.TrimPrefix(pdu.Name, prefix)] = SnmpValue(pdu)
+		}
+	}
+	return values, nil
+}
+
+// sortedIndexes The row indexes of a column in table order
+func sortedIndexes(values map[string]SnmpValue) []string {
+	indexes := make([]string, 0, len(values))
+	for index := range values {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool {
+		a, b := strings.Split(indexes[i], "."), strings.Split(indexes[j], ".")
+		for k := 0; k < len(a) && k < len(b); k++ {
+			x, _ := strconv.ParseInt(a[k], 10, 64)
+			y, _ := strconv.ParseInt(b[k], 10, 64)
+			if x != y {
+				return x < y
+			}
+		}
+		return len(a) < len(b)
+	})
+	return indexes
+}
+
+// collectEntityUsage The average of the percentages of a column, NaN when no row
+// has one. Boards without a CPU or memory of their own report 0 and are left out
+func (c *SnmpV2Client) collectEntityUsage(oid string) (float64, error) {
+	values, err := c.walkColumn(oid)
+	if err != nil {
+		return math.NaN(), err
+	}
+	var total, count int64
+	for _, v := range values {
+		if usage := v.Int64Value(); usage > 0 && usage <= 100 {
+			total += usage
+			count++
+		}
+	}
+	if count == 0 {
+		return math.NaN(), nil
+	}
+	usage, _ := stats.Round(float64(total)/float64(count), 2)
+	return usage, nil
+}
+
+// collectEntityMemory The memory of the boards from their usage percentage, weighted
+// by their memory size in bytes when the vendor has it. No disk is reported
+func (c *SnmpV2Client) collectEntityMemory(device *SnmpDevice, usageOid, sizeOid string) (bool, error) {
+	usages, err := c.walkColumn(usageOid)
+	if err != nil {
+		return false, err
+	}
+	sizes := make(map[string]SnmpValue)
+	if sizeOid != "" {
+		if sizes, err = c.walkColumn(sizeOid); err != nil {
+			return false, err
+		}
+	}
+	var used, total, percents float64
+	var count int
+	for index, v := range usages {
+		usage := v.Int64Value()
+		if usage <= 0 || usage > 100 {
+			continue
+		}
+		count++
+		percents += float64(usage)
+		if size, ok := sizes[index]; ok && size.Int64Value() > 0 {
+			total += float64(size.Int64Value())
+			used += float64(size.Int64Value()) * float64(usage) / 100
+		}
+	}
+	if count == 0 {
+		return false, nil
+	}
+	if total > 0 {
+		device.MemTotal = int64(total)
+		device.MemUsage = int64(used)
+		device.MemPercent, _ = stats.Round(used/total*100, 2)
+	} else {
+		device.MemPercent, _ = stats.Round(percents/float64(count), 2)
+	}
+	device.DiskPercent = math.NaN()
+	return true, nil
+}
+
+// entityNames The entPhysicalName of the entities by entPhysicalIndex
+func (c *SnmpV2Client) entityNames() (map[string]string, error) {
+	values, err := c.walkColumn(entitymib.ENTITY_MIB_entPhysicalName_OID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(values))
+	for index, v := range values {
+		if name := strings.TrimSpace(v.StringValue()); name != "" {
+			names[index] = name
+		}
+	}
+	return names, nil
+}
+
+func entityName(names map[string]string, index string) string {
+	if name, ok := names[index]; ok {
+		return name
+	}
+	return "entity " + index
+}
+
+// ifaceName The name of the interface of a transceiver table keyed by ifIndex
+func ifaceName(device *SnmpDevice, index string) string {
+	ifindex, _ := strconv.ParseInt(index, 10, 64)
+	if iface, ok := device.IfOctetsMap[ifindex]; ok && iface.Name != "" {
+		return iface.Name
+	}
+	return "ifindex " + index
+}
+
+// temperatureStatus The state of a temperature against its threshold, empty without one
+func temperatureStatus(celsius int64, thresholds map[string]SnmpValue, index string) string {
+	threshold, ok := thresholds[index]
+	if !ok || threshold.Int64Value() <= 0 || threshold.Int64Value() >= 65535 {
+		return ""
+	}
+	if celsius >= threshold.Int64Value() {
+		return SensorFault
+	}
+	return SensorNormal
+}
+
+// mtxrGaugeUnit values of MIKROTIK-MIB
+const (
+	mikrotikGaugeCelsius = 1
+	mikrotikGaugeRpm     = 2
+	mikrotikGaugeStatus  = 6
+)
+
+// collectMikrotikHardware RouterOS 7 lists its sensors in mtxrGaugeTable, earlier
+// versions only have the mtxrHealth scalars
+func (c *SnmpV2Client) collectMikrotikHardware(device *SnmpDevice) error {
+	names, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrGaugeName_OID)
+	if err != nil {
+		return err
+	}
+	values, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrGaugeValue_OID)
+	if err != nil {
+		return err
+	}
+	units, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrGaugeUnit_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(names) {
+		name := names[index].StringValue()
+		v, ok := values[index]
+		if !ok {
+			continue
+		}
+		value := float64(v.Int64Value())
+		switch units[index].Int64Value() {
+		case mikrotikGaugeCelsius:
+			device.addTemperature(index, name, value, "")
+		case mikrotikGaugeRpm:
+			device.addSensor(SensorFan, index, name, value, "")
+		case mikrotikGaugeStatus:
+			// psu1-state, fan-state, 1 when it is ok
+			status := SensorFault
+			if value == 1 {
+				status = SensorNormal
+			}
+			switch {
+			case strings.Contains(name, "psu") || strings.Contains(name, "power"):
+				device.addSensor(SensorPower, index, name, 0, status)
+			case strings.Contains(name, "fan"):
+				device.addSensor(SensorFan, index, name, 0, status)
+			}
+		}
+	}
+	if len(names) == 0 {
+		if err = c.collectMikrotikHealth(device); err != nil {
+			return err
+		}
+	}
+
+	opticalNames, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrOpticalName_OID)
+	if err != nil {
+		return err
+	}
+	rxPowers, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrOpticalRxPower_OID)
+	if err != nil {
+		return err
+	}
+	txPowers, err := c.walkColumn(mikrotik.MIKROTIK_MIB_mtxrOpticalTxPower_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(opticalNames) {
+		rx, rxOk := rxPowers[index]
+		tx, txOk := txPowers[index]
+		if !rxOk && !txOk {
+			continue
+		}
+		// thousandths of a dBm
+		device.Opticals = append(device.Opticals, DeviceOptical{
+			Index:   index,
+			Name:    opticalNames[index].StringValue(),
+			RxPower: float64(rx.Int64Value()) / 1000,
+			TxPower: float64(tx.Int64Value()) / 1000,
+		})
+	}
+	return nil
+}
+
+// collectMikrotikHealth The mtxrHealth scalars of RouterOS 6, temperatures in tenths of a degree
+func (c *SnmpV2Client) collectMikrotikHealth(device *SnmpDevice) error {
+	scalars := []struct {
+		oid, name, kind string
+	}{
+		{mikrotik.MIKROTIK_MIB_mtxrHlTemperature_OID, "temperature", SensorTemperature},
+		{mikrotik.MIKROTIK_MIB_mtxrHlProcessorTemperature_OID, "cpu-temperature", SensorTemperature},
+		{mikrotik.MIKROTIK_MIB_mtxrHlBoardTemperature_OID, "board-temperature", SensorTemperature},
+		{mikrotik.MIKROTIK_MIB_mtxrHlFanSpeed1_OID, "fan1-speed", SensorFan},
+		{mikrotik.MIKROTIK_MIB_mtxrHlFanSpeed2_OID, "fan2-speed", SensorFan},
+		{mikrotik.MIKROTIK_MIB_mtxrHlPowerSupplyState_OID, "psu1-state", SensorPower},
+		{mikrotik.MIKROTIK_MIB_mtxrHlBackupPowerSupplyState_OID, "psu2-state", SensorPower},
+	}
+	oids := make([]string, 0, len(scalars))
+	for _, scalar := range scalars {
+		oids = append(oids, "."+scalar.oid+".0")
+	}
+	rs, err := c.CollectOids(oids)
+	if err != nil {
+		return err
+	}
+	for i, scalar := range scalars {
+		v, ok := rs[oids[i]]
+		if !ok || v.Type == gosnmp.NoSuchObject || v.Type == gosnmp.NoSuchInstance {
+			continue
+		}
+		// the scalars are told apart by their number in mtxrHealth
+		index := scalar.oid[strings.LastIndex(scalar.oid, ".")+1:]
+		value := v.Int64Value()
+		switch scalar.kind {
+		case SensorTemperature:
+			device.addTemperature(index, scalar.name, float64(value)/10, "")
+		case SensorFan:
+			device.addSensor(SensorFan, index, scalar.name, float64(value), "")
+		case SensorPower:
+			status := SensorFault
+			if value == 1 {
+				status = SensorNormal
+			}
+			device.addSensor(SensorPower, index, scalar.name, 0, status)
+		}
+	}
+	return nil
+}
+
+// collectH3CHardware Temperatures of the boards in HH3C-ENTITY-EXT-MIB, fans and
+// power supplies in HH3C-LSW-DEV-ADM-MIB and transceivers by ifIndex
+func (c *SnmpV2Client) collectH3CHardware(device *SnmpDevice) error {
+	names, err := c.entityNames()
+	if err != nil {
+		return err
+	}
+	temps, err := c.walkColumn(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_OID)
+	if err != nil {
+		return err
+	}
+	thresholds, err := c.walkColumn(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperatureThreshold_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(temps) {
+		// 65535 for an entity without a sensor
+		celsius := temps[index].Int64Value()
+		if celsius <= 0 || celsius >= 65535 {
+			continue
+		}
+		device.addTemperature(index, entityName(names, index), float64(celsius), temperatureStatus(celsius, thresholds, index))
+	}
+
+	// active(1), deactive(2), not-install(3), unsupport(4)
+	for _, table := range []struct{ oid, kind string }{
+		{h3c.HH3C_LSW_DEV_ADM_MIB_hh3cDevMFanStatus_OID, SensorFan},
+		{h3c.HH3C_LSW_DEV_ADM_MIB_hh3cDevMPowerStatus_OID, SensorPower},
+	} {
+		states, err := c.walkColumn(table.oid)
+		if err != nil {
+			return err
+		}
+		for _, index := range sortedIndexes(states) {
+			switch states[index].Int64Value() {
+			case 1:
+				device.addSensor(table.kind, index, table.kind+" "+index, 0, SensorNormal)
+			case 2:
+				device.addSensor(table.kind, index, table.kind+" "+index, 0, SensorFault)
+			}
+		}
+	}
+
+	// hundredths of a dBm, 2147483647 when the transceiver has no diagnostics
+	rxPowers, err := c.walkColumn(h3c.HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurRXPower_OID)
+	if err != nil {
+		return err
+	}
+	txPowers, err := c.walkColumn(h3c.HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurTXPower_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(rxPowers) {
+		rx := rxPowers[index].Int64Value()
+		tx := txPowers[index].Int64Value()
+		if rx == math.MaxInt32 || tx == math.MaxInt32 {
+			continue
+		}
+		device.Opticals = append(device.Opticals, DeviceOptical{
+			Index:   index,
+			Name:    ifaceName(device, index),
+			RxPower: float64(rx) / 100,
+			TxPower: float64(tx) / 100,
+		})
+	}
+	return nil
+}
+
+// collectHuaweiHardware Temperatures, fans, power supplies and transceivers of
+// HUAWEI-ENTITY-EXTENT-MIB, the boards and transceivers by entPhysicalIndex
+func (c *SnmpV2Client) collectHuaweiHardware(device *SnmpDevice) error {
+	names, err := c.entityNames()
+	if err != nil {
+		return err
+	}
+	temps, err := c.walkColumn(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperature_OID)
+	if err != nil {
+		return err
+	}
+	thresholds, err := c.walkColumn(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperatureThreshold_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(temps) {
+		// 0 for an entity without a sensor
+		celsius := temps[index].Int64Value()
+		if celsius <= 0 {
+			continue
+		}
+		device.addTemperature(index, entityName(names, index), float64(celsius), temperatureStatus(celsius, thresholds, index))
+	}
+
+	// fans and power supplies by slot and number, present(1) or absent(2). A fan
+	// is normal(1) or abnormal(2), a power supply supply(1), notSupply(2), sleep(3) or unknown(4)
+	for _, table := range []struct {
+		presentOid, stateOid, kind string
+		states                     map[int64]string
+	}{
+		{huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanPresent_OID, huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanState_OID, SensorFan,
+			map[int64]string{1: SensorNormal, 2: SensorFault}},
+		{huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrPresent_OID, huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrState_OID, SensorPower,
+			map[int64]string{1: SensorNormal, 2: SensorFault, 3: SensorNormal}},
+	} {
+		present, err := c.walkColumn(table.presentOid)
+		if err != nil {
+			return err
+		}
+		states, err := c.walkColumn(table.stateOid)
+		if err != nil {
+			return err
+		}
+		for _, index := range sortedIndexes(states) {
+			if p, ok := present[index]; ok && p.Int64Value() != 1 {
+				continue
+			}
+			if status, ok := table.states[states[index].Int64Value()]; ok {
+				device.addSensor(table.kind, index, table.kind+" "+index, 0, status)
+			}
+		}
+	}
+
+	// notSupported(1), singleMode(2), multiMode5(3), multiMode6(4), noValue(5), the
+	// power in hundredths of a dBm
+	modes, err := c.walkColumn(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalMode_OID)
+	if err != nil {
+		return err
+	}
+	rxPowers, err := c.walkColumn(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalRxPower_OID)
+	if err != nil {
+		return err
+	}
+	txPowers, err := c.walkColumn(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTxPower_OID)
+	if err != nil {
+		return err
+	}
+	for _, index := range sortedIndexes(modes) {
+		if mode := modes[index].Int64Value(); mode < 2 || mode > 4 {
+			continue
+		}
+		device.Opticals = append(device.Opticals, DeviceOptical{
+			Index:   index,
+			Name:    entityName(names, index),
+			RxPower: float64(rxPowers[index].Int64Value()) / 100,
+			TxPower: float64(txPowers[index].Int64Value()) / 100,
+		})
+	}
+	return nil
+}
+
+// collectRuijieHardware The states of MY-ENTITY-MIB by device and module index,
+// Ruijie reports no temperature values and no transceiver table here
+func (c *SnmpV2Client) collectRuijieHardware(device *SnmpDevice) error {
+	for _, table := range []struct {
+		oid, kind string
+		states    map[int64]string
+	}{
+		// temperatureNormal(1), temperatureWarning(2)
+		{ruijie.MY_ENTITY_MIB_myModuleTempState_OID, SensorTemperature, map[int64]string{1: SensorNormal, 2: SensorFault}},
+		// work(1), stop(2)
+		{ruijie.MY_ENTITY_MIB_myFanState_OID, SensorFan, map[int64]string{1: SensorNormal, 2: SensorFault}},
+		// noLink(1), linkAndNoPower(2), linkAndReadyForPower(3), linkAndPowerOn(4), linkAndPowerAbnormal(5)
+		{ruijie.MY_ENTITY_MIB_myPowerState_OID, SensorPower, map[int64]string{2: SensorFault, 3: SensorNormal, 4: SensorNormal, 5: SensorFault}},
+	} {
+		states, err := c.walkColumn(table.oid)
+		if err != nil {
+			return err
+		}
+		for _, index := range sortedIndexes(states) {
+			if status, ok := table.states[states[index].Int64Value()]; ok {
+				device.addSensor(table.kind, index, table.kind+" "+index, 0, status)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package snmp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs/entitymib"
+	"github.com/talkincode/logsight/common/snmp/mibs/h3c"
+	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+	"github.com/talkincode/logsight/common/snmp/mibs/huawei"
+	"github.com/talkincode/logsight/common/snmp/mibs/mikrotik"
+	"github.com/talkincode/logsight/common/snmp/mibs/ruijie"
+)
+
+// vendorClient A v2c client of an agent with the system values and those of a vendor
+func vendorClient(t *testing.T, values ...gosnmp.SnmpPDU) *SnmpClient {
+	agent := newTestAgent(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "secret"},
+		append(values, testSystemValues...))
+	client, err := NewSnmpClient(SnmpAuth{Ipaddr: "127.0.0.1", Port: agent.port(), Community: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = client.Close()
+	})
+	shortTimeout(client)
+	return client
+}
+
+func integer(oid string, value int) gosnmp.SnmpPDU {
+	return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.Integer, Value: value}
+}
+
+func octets(oid string, value string) gosnmp.SnmpPDU {
+	return gosnmp.SnmpPDU{Name: oid, Type: gosnmp.OctetString, Value: []byte(value)}
+}
+
+func findSensor(device *SnmpDevice, kind, index string) *DeviceSensor {
+	for i, sensor := range device.Sensors {
+		if sensor.Kind == kind && sensor.Index == index {
+			return &device.Sensors[i]
+		}
+	}
+	return nil
+}
+
+func TestVendorOf(t *testing.T) {
+	cases := map[string]string{
+		".1.3.6.1.4.1.25506.1.590": VendorH3C,
+		"1.3.6.1.4.1.2011.2.23.96": VendorHuawei,
+		".1.3.6.1.4.1.14988.1":     VendorMikrotik,
+		".1.3.6.1.4.1.8072":        "8072",
+		".1.3.6.1.2.1.1":           "",
+		"":                         "",
+	}
+	for oid, want := range cases {
+		if got := VendorOf(oid); got != want {
+			t.Errorf("VendorOf(%q) = %q, want %q", oid, got, want)
+		}
+	}
+}
+
+func TestDetectVendor(t *testing.T) {
+	client := vendorClient(t, gosnmp.SnmpPDU{
+		Name: hostresmib.SNMPv2_MIB_sysObjectID_OID + ".0", Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.4.1.4881.1.1.10.1.83",
+	})
+	vendor, err := client.DetectVendor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vendor != VendorRuijie {
+		t.Errorf("vendor %q", vendor)
+	}
+	if device := NewVendorDevice(vendor); device.VendorName != "Ruijie" {
+		t.Errorf("device template %+v", device)
+	}
+}
+
+func TestCollectH3CDevice(t *testing.T) {
+	const devm, transceiver = "1.3.6.1.4.1.25506.8.35.9.1", "1.3.6.1.4.1.25506.2.70.1.1.1"
+	client := vendorClient(t,
+		octets(entitymib.ENTITY_MIB_entPhysicalName_OID+".2", "Board 1"),
+		octets(entitymib.ENTITY_MIB_entPhysicalName_OID+".4", "Board 3"),
+		// the chassis has no CPU of its own
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_OID+".1", 0),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_OID+".2", 30),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_OID+".4", 50),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsage_OID+".2", 40),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsage_OID+".4", 60),
+		gosnmp.SnmpPDU{Name: h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemSize_OID + ".2", Type: gosnmp.Gauge32, Value: uint(1000)},
+		gosnmp.SnmpPDU{Name: h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemSize_OID + ".4", Type: gosnmp.Gauge32, Value: uint(3000)},
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_OID+".1", 65535),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_OID+".2", 45),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_OID+".4", 90),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperatureThreshold_OID+".2", 80),
+		integer(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperatureThreshold_OID+".4", 85),
+		integer(devm+".1.1.2.1", 1),
+		// not installed
+		integer(devm+".1.1.2.2", 3),
+		integer(devm+".2.1.2.1", 1),
+		integer(devm+".2.1.2.2", 2),
+		integer(transceiver+".12.1", -523),
+		integer(transceiver+".9.1", -210),
+		// no diagnostics
+		integer(transceiver+".12.2", math.MaxInt32),
+		integer(transceiver+".9.2", math.MaxInt32),
+	)
+	device := NewH3CDevice()
+	if err := client.CollectDevice(device); err != nil {
+		t.Fatal(err)
+	}
+	if device.CpuLoad != 40 {
+		t.Errorf("cpu load %v", device.CpuLoad)
+	}
+	if device.MemTotal != 4000 || device.MemUsage != 2200 || device.MemPercent != 55 || !math.IsNaN(device.DiskPercent) {
+		t.Errorf("memory %d %d %v disk %v", device.MemTotal, device.MemUsage, device.MemPercent, device.DiskPercent)
+	}
+	if device.Temperature != 90 {
+		t.Errorf("temperature %v", device.Temperature)
+	}
+	if s := findSensor(device, SensorTemperature, "2"); s == nil || s.Name != "Board 1" || s.Value != 45 || s.Status != SensorNormal {
+		t.Errorf("board 1 temperature %+v", s)
+	}
+	if findSensor(device, SensorTemperature, "1") != nil || findSensor(device, SensorFan, "2") != nil {
+		t.Errorf("sensors without a reading %+v", device.Sensors)
+	}
+	if device.SensorStatus(SensorTemperature) != SensorFault || device.SensorStatus(SensorFan) != SensorNormal ||
+		device.SensorStatus(SensorPower) != SensorFault {
+		t.Errorf("sensors %+v", device.Sensors)
+	}
+	if len(device.Opticals) != 1 {
+		t.Fatalf("opticals %+v", device.Opticals)
+	}
+	if o := device.Opticals[0]; o.Name != "ether1" || o.RxPower != -5.23 || o.TxPower != -2.1 {
+		t.Errorf("optical %+v", o)
+	}
+}
+
+func TestCollectHuaweiDevice(t *testing.T) {
+	const fan, power = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1", "1.3.6.1.4.1.2011.5.25.31.1.1.18.1"
+	client := vendorClient(t,
+		octets(entitymib.ENTITY_MIB_entPhysicalName_OID+".16842753", "MPU Board 1"),
+		octets(entitymib.ENTITY_MIB_entPhysicalName_OID+".16850946", "GigabitEthernet0/0/2"),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsage_OID+".16842753", 12),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsage_OID+".16842753", 35),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperature_OID+".16842753", 41),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperature_OID+".16850946", 0),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperatureThreshold_OID+".16842753", 62),
+		integer(fan+".6.0.1", 1),
+		integer(fan+".7.0.1", 2),
+		// absent
+		integer(fan+".6.0.2", 2),
+		integer(fan+".7.0.2", 2),
+		integer(power+".5.0.1", 1),
+		integer(power+".6.0.1", 1),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalMode_OID+".16842753", 1),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalMode_OID+".16850946", 2),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalRxPower_OID+".16850946", -1250),
+		integer(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTxPower_OID+".16850946", -480),
+	)
+	device := NewHuaweiDevice()
+	if err := client.CollectDevice(device); err != nil {
+		t.Fatal(err)
+	}
+	// without sizes the memory is the usage of the boards
+	if device.CpuLoad != 12 || device.MemPercent != 35 || device.MemTotal != 0 {
+		t.Errorf("cpu %v memory %v %d", device.CpuLoad, device.MemPercent, device.MemTotal)
+	}
+	if s := findSensor(device, SensorTemperature, "16842753"); s == nil || s.Name != "MPU Board 1" || s.Value != 41 || s.Status != SensorNormal {
+		t.Errorf("temperature %+v", s)
+	}
+	if len(device.Sensors) != 3 || device.SensorStatus(SensorFan) != SensorFault || device.SensorStatus(SensorPower) != SensorNormal {
+		t.Errorf("sensors %+v", device.Sensors)
+	}
+	if len(device.Opticals) != 1 {
+		t.Fatalf("opticals %+v", device.Opticals)
+	}
+	if o := device.Opticals[0]; o.Name != "GigabitEthernet0/0/2" || o.RxPower != -12.5 || o.TxPower != -4.8 {
+		t.Errorf("optical %+v", o)
+	}
+}
+
+func TestCollectRuijieDevice(t *testing.T) {
+	client := vendorClient(t,
+		gosnmp.SnmpPDU{Name: ruijie.MY_PROCESS_MIB_myCPUUtilization1Min_OID + ".0", Type: gosnmp.Gauge32, Value: uint(7)},
+		integer(ruijie.MY_MEMORY_POOL_MIB_myMemoryPoolCurrentUtilization_OID+".1", 48),
+		integer(ruijie.MY_ENTITY_MIB_myModuleTempState_OID+".1.1", 1),
+		integer(ruijie.MY_ENTITY_MIB_myFanState_OID+".1.1", 1),
+		integer(ruijie.MY_ENTITY_MIB_myPowerState_OID+".1.1", 4),
+		// no power module in the slot
+		integer(ruijie.MY_ENTITY_MIB_myPowerState_OID+".1.2", 1),
+	)
+	device := NewRuijieDevice()
+	if err := client.CollectDevice(device); err != nil {
+		t.Fatal(err)
+	}
+	if device.CpuLoad != 7 || device.MemPercent != 48 {
+		t.Errorf("cpu %v memory %v", device.CpuLoad, device.MemPercent)
+	}
+	// only states, no temperature value
+	if !math.IsNaN(device.Temperature) || len(device.Sensors) != 3 || device.SensorStatus(SensorTemperature) != SensorNormal {
+		t.Errorf("temperature %v sensors %+v", device.Temperature, device.Sensors)
+	}
+}
+
+func TestCollectMikrotikDevice(t *testing.T) {
+	client := vendorClient(t,
+		octets(mikrotik.MIKROTIK_MIB_mtxrGaugeName_OID+".1", "cpu-temperature"),
+		octets(mikrotik.MIKROTIK_MIB_mtxrGaugeName_OID+".2", "fan1-speed"),
+		octets(mikrotik.MIKROTIK_MIB_mtxrGaugeName_OID+".3", "psu1-state"),
+		octets(mikrotik.MIKROTIK_MIB_mtxrGaugeName_OID+".4", "psu2-state"),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeValue_OID+".1", 52),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeValue_OID+".2", 4200),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeValue_OID+".3", 1),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeValue_OID+".4", 0),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeUnit_OID+".1", 1),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeUnit_OID+".2", 2),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeUnit_OID+".3", 6),
+		integer(mikrotik.MIKROTIK_MIB_mtxrGaugeUnit_OID+".4", 6),
+		octets(mikrotik.MIKROTIK_MIB_mtxrOpticalName_OID+".1", "sfp-sfpplus1"),
+		integer(mikrotik.MIKROTIK_MIB_mtxrOpticalRxPower_OID+".1", -6500),
+		integer(mikrotik.MIKROTIK_MIB_mtxrOpticalTxPower_OID+".1", -2125),
+	)
+	device := NewMikrotikDevice()
+	if err := client.CollectDevice(device); err != nil {
+		t.Fatal(err)
+	}
+	// RouterOS has the HOST-RESOURCES processors and storage
+	if device.CpuLoad != 20 || device.MemPercent != 25 {
+		t.Errorf("cpu %v memory %v", device.CpuLoad, device.MemPercent)
+	}
+	if device.Temperature != 52 {
+		t.Errorf("temperature %v", device.Temperature)
+	}
+	if s := findSensor(device, SensorFan, "2"); s == nil || s.Value != 4200 || s.Status != "" {
+		t.Errorf("fan %+v", s)
+	}
+	if s := findSensor(device, SensorPower, "4"); s == nil || s.Name != "psu2-state" || s.Status != SensorFault {
+		t.Errorf("psu2 %+v", s)
+	}
+	if len(device.Opticals) != 1 || device.Opticals[0].RxPower != -6.5 || device.Opticals[0].TxPower != -2.125 {
+		t.Errorf("opticals %+v", device.Opticals)
+	}
+}
+
+func TestCollectMikrotikHealth(t *testing.T) {
+	// RouterOS 6 has no gauge table
+	client := vendorClient(t,
+		gosnmp.SnmpPDU{Name: mikrotik.MIKROTIK_MIB_mtxrHlTemperature_OID + ".0", Type: gosnmp.Gauge32, Value: uint(415)},
+		integer(mikrotik.MIKROTIK_MIB_mtxrHlPowerSupplyState_OID+".0", 1),
+	)
+	device := NewMikrotikDevice()
+	if err := client.collectDeviceHardware(device); err != nil {
+		t.Fatal(err)
+	}
+	if device.Temperature != 41.5 || len(device.Sensors) != 2 || device.SensorStatus(SensorPower) != SensorNormal {
+		t.Errorf("temperature %v sensors %+v", device.Temperature, device.Sensors)
+	}
+	if s := findSensor(device, SensorTemperature, "10"); s == nil || s.Name != "temperature" {
+		t.Errorf("temperature sensor %+v", s)
+	}
+}
@@ -12,8 +12,10 @@ type DeviceIfOctets struct {
 	Name      string `json:"name"`
 	Index     int64  `json:"-"`
 	Type      int64  `json:"-"`
-	InOctets  int64  `json:"in_octets"`
-	OutOctets int64  `json:"out_octets"`
+	InOctets  uint64 `json:"in_octets"`
+	OutOctets uint64 `json:"out_octets"`
+	// CounterBits 64 for the ifHC counters, 32 when the agent only has ifInOctets
+	CounterBits int `json:"-"`
 }
 
 // SNMP Get interface definition data
@@ -67,39 +69,60 @@ func (c *SnmpV2Client) queryInterfaces() (map[int64]*DeviceIfOctets, error) {
 
 }
 
-func (c *SnmpV2Client) collectInterfacesInOctets(ifmap map[int64]*DeviceIfOctets) error {
-	bytes, err := c.Snmpc.BulkWalkAll(hostresmib.IF_MIB_ifInOctets_OID)
+// collectInterfacesOctets Read the 64-bit ifHCInOctets and ifHCOutOctets, the
+// interfaces without them (v1 agents, no ifXTable) fall back to the 32-bit
+// ifInOctets and ifOutOctets
+func (c *SnmpV2Client) collectInterfacesOctets(ifmap map[int64]*DeviceIfOctets) error {
+	err := c.walkIfCounter(ifmap, hostresmib.IF_MIB_ifHCInOctets_OID, func(iface *DeviceIfOctets, value uint64) {
+		iface.InOctets = value
+		iface.CounterBits = 64
+	})
 	if err != nil {
 		return err
 	}
-	// Get interface name
-	for _, _item := range bytes {
-		nameoid := _item.Name
-		idxval, err := strconv.ParseInt(nameoid[strings.LastIndex(nameoid, ".")+1:], 10, 64)
-		if err != nil {
-			continue
-		}
-		if _, flag := ifmap[idxval]; flag {
-			ifmap[idxval].InOctets = gosnmp.ToBigInt(_item.Value).Int64()
+	err = c.walkIfCounter(ifmap, hostresmib.IF_MIB_ifHCOutOctets_OID, func(iface *DeviceIfOctets, value uint64) {
+		iface.OutOctets = value
+	})
+	if err != nil {
+		return err
+	}
+	fallback := make(map[int64]*DeviceIfOctets)
+	for index, iface := range ifmap {
+		if iface.CounterBits != 64 {
+			iface.CounterBits = 32
+			fallback[index] = iface
 		}
 	}
-	return nil
+	if len(fallback) == 0 {
+		return nil
+	}
+	err = c.walkIfCounter(fallback, hostresmib.IF_MIB_ifInOctets_OID, func(iface *DeviceIfOctets, value uint64) {
+		iface.InOctets = value
+	})
+	if err != nil {
+		return err
+	}
+	return c.walkIfCounter(fallback, hostresmib.IF_MIB_ifOutOctets_OID, func(iface *DeviceIfOctets, value uint64) {
+		iface.OutOctets = value
+	})
 }
 
-func (c *SnmpV2Client) collectInterfacesOutOctets(ifmap map[int64]*DeviceIfOctets) error {
-	bytes, err := c.Snmpc.BulkWalkAll(hostresmib.IF_MIB_ifOutOctets_OID)
+// walkIfCounter Walk a counter column of the interfaces in ifmap
+func (c *SnmpV2Client) walkIfCounter(ifmap map[int64]*DeviceIfOctets, oid string, set func(iface *DeviceIfOctets, value uint64)) error {
+	items, err := c.Snmpc.BulkWalkAll(oid)
 	if err != nil {
 		return err
 	}
-	// Get interface name
-	for _, _item := range bytes {
-		nameoid := _item.Name
-		idxval, err := strconv.ParseInt(nameoid[strings.LastIndex(nameoid, ".")+1:], 10, 64)
+	for _, item := range items {
+		if item.Type != gosnmp.Counter64 && item.Type != gosnmp.Counter32 {
+			continue
+		}
+		idxval, err := strconv.ParseInt(item.Name[strings.LastIndex(item.Name, ".")+1:], 10, 64)
 		if err != nil {
 			continue
 		}
-		if _, flag := ifmap[idxval]; flag {
-			ifmap[idxval].OutOctets = gosnmp.ToBigInt(_item.Value).Int64()
+		if iface, ok := ifmap[idxval]; ok {
+			set(iface, gosnmp.ToBigInt(item.Value).Uint64())
 		}
 	}
 	return nil
@@ -0,0 +1,40 @@
+package entitymib
+
+const (
+	ENTITY_MIB_entityMIB_OID                = "1.3.6.1.2.1.47"
+	ENTITY_MIB_entityMIB_NAME               = "ENTITY-MIB::entityMIB"
+	ENTITY_MIB_entityMIBObjects_OID         = "1.3.6.1.2.1.47.1"
+	ENTITY_MIB_entityMIBObjects_NAME        = "ENTITY-MIB::entityMIBObjects"
+	ENTITY_MIB_entityPhysical_OID           = "1.3.6.1.2.1.47.1.1"
+	ENTITY_MIB_entityPhysical_NAME          = "ENTITY-MIB::entityPhysical"
+	ENTITY_MIB_entPhysicalTable_OID         = "1.3.6.1.2.1.47.1.1.1"
+	ENTITY_MIB_entPhysicalTable_NAME        = "ENTITY-MIB::entPhysicalTable"
+	ENTITY_MIB_entPhysicalEntry_OID         = "1.3.6.1.2.1.47.1.1.1.1"
+	ENTITY_MIB_entPhysicalEntry_NAME        = "ENTITY-MIB::entPhysicalEntry"
+	ENTITY_MIB_entPhysicalIndex_OID         = "1.3.6.1.2.1.47.1.1.1.1.1"
+	ENTITY_MIB_entPhysicalIndex_NAME        = "ENTITY-MIB::entPhysicalIndex"
+	ENTITY_MIB_entPhysicalDescr_OID         = "1.3.6.1.2.1.47.1.1.1.1.2"
+	ENTITY_MIB_entPhysicalDescr_NAME        = "ENTITY-MIB::entPhysicalDescr"
+	ENTITY_MIB_entPhysicalVendorType_OID    = "1.3.6.1.2.1.47.1.1.1.1.3"
+	ENTITY_MIB_entPhysicalVendorType_NAME   = "ENTITY-MIB::entPhysicalVendorType"
+	ENTITY_MIB_entPhysicalContainedIn_OID   = "1.3.6.1.2.1.47.1.1.1.1.4"
+	ENTITY_MIB_entPhysicalContainedIn_NAME  = "ENTITY-MIB::entPhysicalContainedIn"
+	ENTITY_MIB_entPhysicalClass_OID         = "1.3.6.1.2.1.47.1.1.1.1.5"
+	ENTITY_MIB_entPhysicalClass_NAME        = "ENTITY-MIB::entPhysicalClass"
+	ENTITY_MIB_entPhysicalParentRelPos_OID  = "1.3.6.1.2.1.47.1.1.1.1.6"
+	ENTITY_MIB_entPhysicalParentRelPos_NAME = "ENTITY-MIB::entPhysicalParentRelPos"
+	ENTITY_MIB_entPhysicalName_OID          = "1.3.6.1.2.1.47.1.1.1.1.7"
+	ENTITY_MIB_entPhysicalName_NAME         = "ENTITY-MIB::entPhysicalName"
+	ENTITY_MIB_entPhysicalHardwareRev_OID   = "1.3.6.1.2.1.47.1.1.1.1.8"
+	ENTITY_MIB_entPhysicalHardwareRev_NAME  = "ENTITY-MIB::entPhysicalHardwareRev"
+	ENTITY_MIB_entPhysicalFirmwareRev_OID   = "1.3.6.1.2.1.47.1.1.1.1.9"
+	ENTITY_MIB_entPhysicalFirmwareRev_NAME  = "ENTITY-MIB::entPhysicalFirmwareRev"
+	ENTITY_MIB_entPhysicalSoftwareRev_OID   = "1.3.6.1.2.1.47.1.1.1.1.10"
+	ENTITY_MIB_entPhysicalSoftwareRev_NAME  = "ENTITY-MIB::entPhysicalSoftwareRev"
+	ENTITY_MIB_entPhysicalSerialNum_OID     = "1.3.6.1.2.1.47.1.1.1.1.11"
+	ENTITY_MIB_entPhysicalSerialNum_NAME    = "ENTITY-MIB::entPhysicalSerialNum"
+	ENTITY_MIB_entPhysicalMfgName_OID       = "1.3.6.1.2.1.47.1.1.1.1.12"
+	ENTITY_MIB_entPhysicalMfgName_NAME      = "ENTITY-MIB::entPhysicalMfgName"
+	ENTITY_MIB_entPhysicalModelName_OID     = "1.3.6.1.2.1.47.1.1.1.1.13"
+	ENTITY_MIB_entPhysicalModelName_NAME    = "ENTITY-MIB::entPhysicalModelName"
+)
@@ -0,0 +1,111 @@
+//go:build ignore
+
+// gen.go Collects the X_OID / X_NAME constant pairs of the vendor MIB
+// packages into names.go, run it with go generate after changing a MIB table
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var packages = []string{"hostresmib", "entitymib", "ucdmib", "mikrotik", "ruijie", "h3c", "huawei"}
+
+func main() {
+	names := make(map[string]string)
+	for _, pkg := range packages {
+		files, err := filepath.Glob(filepath.Join(pkg, "*.go"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			if err = collect(file, names); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	oids := make([]string, 0, len(names))
+	for oid := range names {
+		oids = append(oids, oid)
+	}
+	sort.Strings(oids)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen.go; DO NOT EDIT.\n\npackage mibs\n\n")
+	buf.WriteString("// oidNames Symbolic names of the OIDs in the vendor MIB packages\n")
+	buf.WriteString("var oidNames = map[string]string{\n")
+	for _, oid := range oids {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", oid, names[oid])
+	}
+	buf.WriteString("}\n")
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile("names.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// collect Adds the constant pairs of a file, the first package declaring an OID wins
+func collect(file string, names map[string]string) error {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, ident := range vs.Names {
+				if i >= len(vs.Values) {
+					continue
+				}
+				lit, ok := vs.Values[i].(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING {
+					continue
+				}
+				value, err := strconv.Unquote(lit.Value)
+				if err != nil {
+					return err
+				}
+				values[ident.Name] = value
+			}
+		}
+	}
+	idents := make([]string, 0, len(values))
+	for ident := range values {
+		if strings.HasSuffix(ident, "_OID") {
+			idents = append(idents, ident)
+		}
+	}
+	sort.Strings(idents)
+	for _, ident := range idents {
+		oid := values[ident]
+		name, ok := values[strings.TrimSuffix(ident, "_OID")+"_NAME"]
+		if !ok {
+			continue
+		}
+		oid = strings.Trim(oid, ".")
+		if _, exists := names[oid]; !exists {
+			names[oid] = name
+		}
+	}
+	return nil
+}
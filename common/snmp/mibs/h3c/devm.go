@@ -0,0 +1,22 @@
+package h3c
+
+const (
+	HH3C_LSW_DEV_ADM_MIB_hh3cLswdevMMibObject_OID      = "1.3.6.1.4.1.25506.8.35.9.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cLswdevMMibObject_NAME     = "HH3C-LSW-DEV-ADM-MIB::hh3cLswdevMMibObject"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMFanStatusTable_OID    = "1.3.6.1.4.1.25506.8.35.9.1.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMFanStatusTable_NAME   = "HH3C-LSW-DEV-ADM-MIB::hh3cdevMFanStatusTable"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMFanStatusEntry_OID    = "1.3.6.1.4.1.25506.8.35.9.1.1.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMFanStatusEntry_NAME   = "HH3C-LSW-DEV-ADM-MIB::hh3cdevMFanStatusEntry"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMFanNum_OID            = "1.3.6.1.4.1.25506.8.35.9.1.1.1.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMFanNum_NAME           = "HH3C-LSW-DEV-ADM-MIB::hh3cDevMFanNum"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMFanStatus_OID         = "1.3.6.1.4.1.25506.8.35.9.1.1.1.2"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMFanStatus_NAME        = "HH3C-LSW-DEV-ADM-MIB::hh3cDevMFanStatus"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMPowerStatusTable_OID  = "1.3.6.1.4.1.25506.8.35.9.1.2"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMPowerStatusTable_NAME = "HH3C-LSW-DEV-ADM-MIB::hh3cdevMPowerStatusTable"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMPowerStatusEntry_OID  = "1.3.6.1.4.1.25506.8.35.9.1.2.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cdevMPowerStatusEntry_NAME = "HH3C-LSW-DEV-ADM-MIB::hh3cdevMPowerStatusEntry"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMPowerNum_OID          = "1.3.6.1.4.1.25506.8.35.9.1.2.1.1"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMPowerNum_NAME         = "HH3C-LSW-DEV-ADM-MIB::hh3cDevMPowerNum"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMPowerStatus_OID       = "1.3.6.1.4.1.25506.8.35.9.1.2.1.2"
+	HH3C_LSW_DEV_ADM_MIB_hh3cDevMPowerStatus_NAME      = "HH3C-LSW-DEV-ADM-MIB::hh3cDevMPowerStatus"
+)
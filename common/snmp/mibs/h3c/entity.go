@@ -0,0 +1,62 @@
+package h3c
+
+const (
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtend_OID                           = "1.3.6.1.4.1.25506.2.6"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtend_NAME                          = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtend"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtObjects_OID                       = "1.3.6.1.4.1.25506.2.6.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtObjects_NAME                      = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtObjects"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtState_OID                         = "1.3.6.1.4.1.25506.2.6.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtState_NAME                        = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtState"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStateTable_OID                    = "1.3.6.1.4.1.25506.2.6.1.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStateTable_NAME                   = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStateTable"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStateEntry_OID                    = "1.3.6.1.4.1.25506.2.6.1.1.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStateEntry_NAME                   = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStateEntry"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtPhysicalIndex_OID                 = "1.3.6.1.4.1.25506.2.6.1.1.1.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtPhysicalIndex_NAME                = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtPhysicalIndex"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtAdminStatus_OID                   = "1.3.6.1.4.1.25506.2.6.1.1.1.1.2"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtAdminStatus_NAME                  = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtAdminStatus"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtOperStatus_OID                    = "1.3.6.1.4.1.25506.2.6.1.1.1.1.3"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtOperStatus_NAME                   = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtOperStatus"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStandbyStatus_OID                 = "1.3.6.1.4.1.25506.2.6.1.1.1.1.4"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtStandbyStatus_NAME                = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStandbyStatus"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtAlarmLight_OID                    = "1.3.6.1.4.1.25506.2.6.1.1.1.1.5"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtAlarmLight_NAME                   = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtAlarmLight"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_OID                      = "1.3.6.1.4.1.25506.2.6.1.1.1.1.6"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_NAME                     = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCpuUsage"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsageThreshold_OID             = "1.3.6.1.4.1.25506.2.6.1.1.1.1.7"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsageThreshold_NAME            = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCpuUsageThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsage_OID                      = "1.3.6.1.4.1.25506.2.6.1.1.1.1.8"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsage_NAME                     = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemUsage"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsageThreshold_OID             = "1.3.6.1.4.1.25506.2.6.1.1.1.1.9"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsageThreshold_NAME            = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemUsageThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemSize_OID                       = "1.3.6.1.4.1.25506.2.6.1.1.1.1.10"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemSize_NAME                      = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemSize"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtUpTime_OID                        = "1.3.6.1.4.1.25506.2.6.1.1.1.1.11"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtUpTime_NAME                       = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtUpTime"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_OID                   = "1.3.6.1.4.1.25506.2.6.1.1.1.1.12"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperature_NAME                  = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtTemperature"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperatureThreshold_OID          = "1.3.6.1.4.1.25506.2.6.1.1.1.1.13"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtTemperatureThreshold_NAME         = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtTemperatureThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltage_OID                       = "1.3.6.1.4.1.25506.2.6.1.1.1.1.14"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltage_NAME                      = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltage"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltageLowThreshold_OID           = "1.3.6.1.4.1.25506.2.6.1.1.1.1.15"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltageLowThreshold_NAME          = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltageLowThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltageHighThreshold_OID          = "1.3.6.1.4.1.25506.2.6.1.1.1.1.16"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtVoltageHighThreshold_NAME         = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltageHighThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCriticalTemperatureThreshold_OID  = "1.3.6.1.4.1.25506.2.6.1.1.1.1.17"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtCriticalTemperatureThreshold_NAME = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCriticalTemperatureThreshold"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMacAddress_OID                    = "1.3.6.1.4.1.25506.2.6.1.1.1.1.18"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtMacAddress_NAME                   = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMacAddress"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtErrorStatus_OID                   = "1.3.6.1.4.1.25506.2.6.1.1.1.1.19"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtErrorStatus_NAME                  = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtErrorStatus"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManu_OID                          = "1.3.6.1.4.1.25506.2.6.1.2"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManu_NAME                         = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManu"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuTable_OID                     = "1.3.6.1.4.1.25506.2.6.1.2.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuTable_NAME                    = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuTable"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuEntry_OID                     = "1.3.6.1.4.1.25506.2.6.1.2.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuEntry_NAME                    = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuEntry"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuPhysicalIndex_OID             = "1.3.6.1.4.1.25506.2.6.1.2.1.1.1"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtManuPhysicalIndex_NAME            = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuPhysicalIndex"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtDeviceSerialNumber_OID            = "1.3.6.1.4.1.25506.2.6.1.2.1.1.2"
+	HH3C_ENTITY_EXT_MIB_hh3cEntityExtDeviceSerialNumber_NAME           = "HH3C-ENTITY-EXT-MIB::hh3cEntityExtDeviceSerialNumber"
+)
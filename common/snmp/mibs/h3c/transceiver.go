@@ -0,0 +1,46 @@
+package h3c
+
+const (
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiver_OID                   = "1.3.6.1.4.1.25506.2.70"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiver_NAME                  = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiver"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoObjects_OID        = "1.3.6.1.4.1.25506.2.70.1"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoObjects_NAME       = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoObjects"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoTable_OID          = "1.3.6.1.4.1.25506.2.70.1.1"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoTable_NAME         = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoTable"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoEntry_OID          = "1.3.6.1.4.1.25506.2.70.1.1.1"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverInfoEntry_NAME         = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoEntry"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverHardwareType_OID       = "1.3.6.1.4.1.25506.2.70.1.1.1.1"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverHardwareType_NAME      = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverHardwareType"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverType_OID               = "1.3.6.1.4.1.25506.2.70.1.1.1.2"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverType_NAME              = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverType"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverWaveLength_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.3"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverWaveLength_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverWaveLength"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverVendorName_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.4"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverVendorName_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverVendorName"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverSerialNumber_OID       = "1.3.6.1.4.1.25506.2.70.1.1.1.5"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverSerialNumber_NAME      = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverSerialNumber"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverFiberDiameterType_OID  = "1.3.6.1.4.1.25506.2.70.1.1.1.6"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverFiberDiameterType_NAME = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverFiberDiameterType"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverTransferDistance_OID   = "1.3.6.1.4.1.25506.2.70.1.1.1.7"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverTransferDistance_NAME  = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverTransferDistance"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverDiagnostic_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.8"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverDiagnostic_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverDiagnostic"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurTXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.9"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurTXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverCurTXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMaxTXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.10"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMaxTXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMaxTXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMinTXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.11"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMinTXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMinTXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurRXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.12"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverCurRXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverCurRXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMaxRXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.13"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMaxRXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMaxRXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMinRXPower_OID         = "1.3.6.1.4.1.25506.2.70.1.1.1.14"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverMinRXPower_NAME        = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMinRXPower"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverTemperature_OID        = "1.3.6.1.4.1.25506.2.70.1.1.1.15"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverTemperature_NAME       = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverTemperature"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverVoltage_OID            = "1.3.6.1.4.1.25506.2.70.1.1.1.16"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverVoltage_NAME           = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverVoltage"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverBiasCurrent_OID        = "1.3.6.1.4.1.25506.2.70.1.1.1.17"
+	HH3C_TRANSCEIVER_INFO_MIB_hh3cTransceiverBiasCurrent_NAME       = "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverBiasCurrent"
+)
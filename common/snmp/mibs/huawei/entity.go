@@ -0,0 +1,96 @@
+package huawei
+
+const (
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityExtentMIB_OID                = "1.3.6.1.4.1.2011.5.25.31"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityExtentMIB_NAME               = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityExtentMIB"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityExtentObjects_OID            = "1.3.6.1.4.1.2011.5.25.31.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityExtentObjects_NAME           = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityExtentObjects"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityState_OID                    = "1.3.6.1.4.1.2011.5.25.31.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityState_NAME                   = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityState"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStateTable_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStateTable_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStateTable"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStateEntry_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStateEntry_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStateEntry"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityAdminStatus_OID              = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityAdminStatus_NAME             = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityAdminStatus"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOperStatus_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.2"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOperStatus_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOperStatus"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStandbyStatus_OID            = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.3"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityStandbyStatus_NAME           = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStandbyStatus"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityAlarmLight_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.4"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityAlarmLight_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityAlarmLight"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsage_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.5"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsage_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityCpuUsage"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsageThreshold_OID        = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.6"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsageThreshold_NAME       = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityCpuUsageThreshold"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsage_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.7"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsage_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemUsage"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsageThreshold_OID        = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.8"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsageThreshold_NAME       = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemUsageThreshold"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemSize_OID                  = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.9"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemSize_NAME                 = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemSize"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityUpTime_OID                   = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.10"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityUpTime_NAME                  = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityUpTime"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperature_OID              = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.11"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperature_NAME             = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityTemperature"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperatureThreshold_OID     = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.12"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityTemperatureThreshold_NAME    = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityTemperatureThreshold"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityVoltage_OID                  = "1.3.6.1.4.1.2011.5.25.31.1.1.1.1.13"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityVoltage_NAME                 = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityVoltage"
+	HUAWEI_ENTITY_EXTENT_MIB_hwOpticalModuleInfoTable_OID         = "1.3.6.1.4.1.2011.5.25.31.1.1.3"
+	HUAWEI_ENTITY_EXTENT_MIB_hwOpticalModuleInfoTable_NAME        = "HUAWEI-ENTITY-EXTENT-MIB::hwOpticalModuleInfoTable"
+	HUAWEI_ENTITY_EXTENT_MIB_hwOpticalModuleInfoEntry_OID         = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwOpticalModuleInfoEntry_NAME        = "HUAWEI-ENTITY-EXTENT-MIB::hwOpticalModuleInfoEntry"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalMode_OID              = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalMode_NAME             = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalMode"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalWaveLength_OID        = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.2"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalWaveLength_NAME       = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalWaveLength"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTransferDistance_OID  = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.3"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTransferDistance_NAME = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTransferDistance"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalVendorSn_OID          = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.4"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalVendorSn_NAME         = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalVendorSn"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTemperature_OID       = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.5"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTemperature_NAME      = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTemperature"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalVoltage_OID           = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.6"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalVoltage_NAME          = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalVoltage"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalBiasCurrent_OID       = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.7"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalBiasCurrent_NAME      = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalBiasCurrent"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalRxPower_OID           = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.8"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalRxPower_NAME          = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalRxPower"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTxPower_OID           = "1.3.6.1.4.1.2011.5.25.31.1.1.3.1.9"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityOpticalTxPower_NAME          = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTxPower"
+	HUAWEI_ENTITY_EXTENT_MIB_hwFanStatusTable_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.10"
+	HUAWEI_ENTITY_EXTENT_MIB_hwFanStatusTable_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwFanStatusTable"
+	HUAWEI_ENTITY_EXTENT_MIB_hwFanStatusEntry_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwFanStatusEntry_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwFanStatusEntry"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSlot_OID                  = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSlot_NAME                 = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSlot"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSn_OID                    = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.2"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSn_NAME                   = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSn"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanReg_OID                   = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.3"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanReg_NAME                  = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanReg"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSpdAdjMode_OID            = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.4"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSpdAdjMode_NAME           = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSpdAdjMode"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSpeed_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.5"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanSpeed_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSpeed"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanPresent_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.6"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanPresent_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanPresent"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanState_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.10.1.7"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityFanState_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanState"
+	HUAWEI_ENTITY_EXTENT_MIB_hwPwrStatusTable_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.18"
+	HUAWEI_ENTITY_EXTENT_MIB_hwPwrStatusTable_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwPwrStatusTable"
+	HUAWEI_ENTITY_EXTENT_MIB_hwPwrStatusEntry_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwPwrStatusEntry_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwPwrStatusEntry"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrSlot_OID                  = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.1"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrSlot_NAME                 = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrSlot"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrSn_OID                    = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.2"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrSn_NAME                   = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrSn"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrReg_OID                   = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.3"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrReg_NAME                  = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrReg"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrMode_OID                  = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.4"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrMode_NAME                 = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrMode"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrPresent_OID               = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.5"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrPresent_NAME              = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrPresent"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrState_OID                 = "1.3.6.1.4.1.2011.5.25.31.1.1.18.1.6"
+	HUAWEI_ENTITY_EXTENT_MIB_hwEntityPwrState_NAME                = "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrState"
+)
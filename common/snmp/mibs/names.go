@@ -0,0 +1,1320 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+package mibs
+
+// oidNames Symbolic names of the OIDs in the vendor MIB packages
+var oidNames = map[string]string{
+	"1.3":                                   "SNMPv2-SMI::org",
+	"1.3.6":                                 "SNMPv2-SMI::dod",
+	"1.3.6.1":                               "SNMPv2-SMI::internet",
+	"1.3.6.1.1":                             "SNMPv2-SMI::directory",
+	"1.3.6.1.2":                             "SNMPv2-SMI::mgmt",
+	"1.3.6.1.2.1":                           "SNMPv2-SMI::mib-2",
+	"1.3.6.1.2.1.1":                         "SNMPv2-MIB::system",
+	"1.3.6.1.2.1.1.1":                       "SNMPv2-MIB::sysDescr",
+	"1.3.6.1.2.1.1.2":                       "SNMPv2-MIB::sysObjectID",
+	"1.3.6.1.2.1.1.3":                       "SNMPv2-MIB::sysUpTime",
+	"1.3.6.1.2.1.1.4":                       "SNMPv2-MIB::sysContact",
+	"1.3.6.1.2.1.1.5":                       "SNMPv2-MIB::sysName",
+	"1.3.6.1.2.1.1.6":                       "SNMPv2-MIB::sysLocation",
+	"1.3.6.1.2.1.1.7":                       "SNMPv2-MIB::sysServices",
+	"1.3.6.1.2.1.1.8":                       "SNMPv2-MIB::sysORLastChange",
+	"1.3.6.1.2.1.1.9":                       "SNMPv2-MIB::sysORTable",
+	"1.3.6.1.2.1.1.9.1":                     "SNMPv2-MIB::sysOREntry",
+	"1.3.6.1.2.1.1.9.1.1":                   "SNMPv2-MIB::sysORIndex",
+	"1.3.6.1.2.1.1.9.1.2":                   "SNMPv2-MIB::sysORID",
+	"1.3.6.1.2.1.1.9.1.3":                   "SNMPv2-MIB::sysORDescr",
+	"1.3.6.1.2.1.1.9.1.4":                   "SNMPv2-MIB::sysORUpTime",
+	"1.3.6.1.2.1.10":                        "SNMPv2-SMI::transmission",
+	"1.3.6.1.2.1.11":                        "SNMPv2-MIB::snmp",
+	"1.3.6.1.2.1.11.1":                      "SNMPv2-MIB::snmpInPkts",
+	"1.3.6.1.2.1.11.10":                     "SNMPv2-MIB::snmpInBadValues",
+	"1.3.6.1.2.1.11.11":                     "SNMPv2-MIB::snmpInReadOnlys",
+	"1.3.6.1.2.1.11.12":                     "SNMPv2-MIB::snmpInGenErrs",
+	"1.3.6.1.2.1.11.13":                     "SNMPv2-MIB::snmpInTotalReqVars",
+	"1.3.6.1.2.1.11.14":                     "SNMPv2-MIB::snmpInTotalSetVars",
+	"1.3.6.1.2.1.11.15":                     "SNMPv2-MIB::snmpInGetRequests",
+	"1.3.6.1.2.1.11.16":                     "SNMPv2-MIB::snmpInGetNexts",
+	"1.3.6.1.2.1.11.17":                     "SNMPv2-MIB::snmpInSetRequests",
+	"1.3.6.1.2.1.11.18":                     "SNMPv2-MIB::snmpInGetResponses",
+	"1.3.6.1.2.1.11.19":                     "SNMPv2-MIB::snmpInTraps",
+	"1.3.6.1.2.1.11.2":                      "SNMPv2-MIB::snmpOutPkts",
+	"1.3.6.1.2.1.11.20":                     "SNMPv2-MIB::snmpOutTooBigs",
+	"1.3.6.1.2.1.11.21":                     "SNMPv2-MIB::snmpOutNoSuchNames",
+	"1.3.6.1.2.1.11.22":                     "SNMPv2-MIB::snmpOutBadValues",
+	"1.3.6.1.2.1.11.24":                     "SNMPv2-MIB::snmpOutGenErrs",
+	"1.3.6.1.2.1.11.25":                     "SNMPv2-MIB::snmpOutGetRequests",
+	"1.3.6.1.2.1.11.26":                     "SNMPv2-MIB::snmpOutGetNexts",
+	"1.3.6.1.2.1.11.27":                     "SNMPv2-MIB::snmpOutSetRequests",
+	"1.3.6.1.2.1.11.28":                     "SNMPv2-MIB::snmpOutGetResponses",
+	"1.3.6.1.2.1.11.29":                     "SNMPv2-MIB::snmpOutTraps",
+	"1.3.6.1.2.1.11.3":                      "SNMPv2-MIB::snmpInBadVersions",
+	"1.3.6.1.2.1.11.30":                     "SNMPv2-MIB::snmpEnableAuthenTraps",
+	"1.3.6.1.2.1.11.31":                     "SNMPv2-MIB::snmpSilentDrops",
+	"1.3.6.1.2.1.11.32":                     "SNMPv2-MIB::snmpProxyDrops",
+	"1.3.6.1.2.1.11.4":                      "SNMPv2-MIB::snmpInBadCommunityNames",
+	"1.3.6.1.2.1.11.5":                      "SNMPv2-MIB::snmpInBadCommunityUses",
+	"1.3.6.1.2.1.11.6":                      "SNMPv2-MIB::snmpInASNParseErrs",
+	"1.3.6.1.2.1.11.8":                      "SNMPv2-MIB::snmpInTooBigs",
+	"1.3.6.1.2.1.11.9":                      "SNMPv2-MIB::snmpInNoSuchNames",
+	"1.3.6.1.2.1.2":                         "IF-MIB::interfaces",
+	"1.3.6.1.2.1.2.1":                       "IF-MIB::ifNumber",
+	"1.3.6.1.2.1.2.2":                       "IF-MIB::ifTable",
+	"1.3.6.1.2.1.2.2.1":                     "IF-MIB::ifEntry",
+	"1.3.6.1.2.1.2.2.1.1":                   "IF-MIB::ifIndex",
+	"1.3.6.1.2.1.2.2.1.10":                  "IF-MIB::ifInOctets",
+	"1.3.6.1.2.1.2.2.1.11":                  "IF-MIB::ifInUcastPkts",
+	"1.3.6.1.2.1.2.2.1.12":                  "IF-MIB::ifInNUcastPkts",
+	"1.3.6.1.2.1.2.2.1.13":                  "IF-MIB::ifInDiscards",
+	"1.3.6.1.2.1.2.2.1.14":                  "IF-MIB::ifInErrors",
+	"1.3.6.1.2.1.2.2.1.15":                  "IF-MIB::ifInUnknownProtos",
+	"1.3.6.1.2.1.2.2.1.16":                  "IF-MIB::ifOutOctets",
+	"1.3.6.1.2.1.2.2.1.17":                  "IF-MIB::ifOutUcastPkts",
+	"1.3.6.1.2.1.2.2.1.18":                  "IF-MIB::ifOutNUcastPkts",
+	"1.3.6.1.2.1.2.2.1.19":                  "IF-MIB::ifOutDiscards",
+	"1.3.6.1.2.1.2.2.1.2":                   "IF-MIB::ifDescr",
+	"1.3.6.1.2.1.2.2.1.20":                  "IF-MIB::ifOutErrors",
+	"1.3.6.1.2.1.2.2.1.21":                  "IF-MIB::ifOutQLen",
+	"1.3.6.1.2.1.2.2.1.22":                  "IF-MIB::ifSpecific",
+	"1.3.6.1.2.1.2.2.1.3":                   "IF-MIB::ifType",
+	"1.3.6.1.2.1.2.2.1.4":                   "IF-MIB::ifMtu",
+	"1.3.6.1.2.1.2.2.1.5":                   "IF-MIB::ifSpeed",
+	"1.3.6.1.2.1.2.2.1.6":                   "IF-MIB::ifPhysAddress",
+	"1.3.6.1.2.1.2.2.1.7":                   "IF-MIB::ifAdminStatus",
+	"1.3.6.1.2.1.2.2.1.8":                   "IF-MIB::ifOperStatus",
+	"1.3.6.1.2.1.2.2.1.9":                   "IF-MIB::ifLastChange",
+	"1.3.6.1.2.1.25":                        "HOST-RESOURCES-MIB::host",
+	"1.3.6.1.2.1.25.1":                      "HOST-RESOURCES-MIB::hrSystem",
+	"1.3.6.1.2.1.25.1.1":                    "HOST-RESOURCES-MIB::hrSystemUptime",
+	"1.3.6.1.2.1.25.1.2":                    "HOST-RESOURCES-MIB::hrSystemDate",
+	"1.3.6.1.2.1.25.1.3":                    "HOST-RESOURCES-MIB::hrSystemInitialLoadDevice",
+	"1.3.6.1.2.1.25.1.4":                    "HOST-RESOURCES-MIB::hrSystemInitialLoadParameters",
+	"1.3.6.1.2.1.25.1.5":                    "HOST-RESOURCES-MIB::hrSystemNumUsers",
+	"1.3.6.1.2.1.25.1.6":                    "HOST-RESOURCES-MIB::hrSystemProcesses",
+	"1.3.6.1.2.1.25.1.7":                    "HOST-RESOURCES-MIB::hrSystemMaxProcesses",
+	"1.3.6.1.2.1.25.2":                      "HOST-RESOURCES-MIB::hrStorage",
+	"1.3.6.1.2.1.25.2.1":                    "HOST-RESOURCES-MIB::hrStorageTypes",
+	"1.3.6.1.2.1.25.2.2":                    "HOST-RESOURCES-MIB::hrMemorySize",
+	"1.3.6.1.2.1.25.2.3":                    "HOST-RESOURCES-MIB::hrStorageTable",
+	"1.3.6.1.2.1.25.2.3.1":                  "HOST-RESOURCES-MIB::hrStorageEntry",
+	"1.3.6.1.2.1.25.2.3.1.1":                "HOST-RESOURCES-MIB::hrStorageIndex",
+	"1.3.6.1.2.1.25.2.3.1.2":                "HOST-RESOURCES-MIB::hrStorageType",
+	"1.3.6.1.2.1.25.2.3.1.3":                "HOST-RESOURCES-MIB::hrStorageDescr",
+	"1.3.6.1.2.1.25.2.3.1.4":                "HOST-RESOURCES-MIB::hrStorageAllocationUnits",
+	"1.3.6.1.2.1.25.2.3.1.5":                "HOST-RESOURCES-MIB::hrStorageSize",
+	"1.3.6.1.2.1.25.2.3.1.6":                "HOST-RESOURCES-MIB::hrStorageUsed",
+	"1.3.6.1.2.1.25.2.3.1.7":                "HOST-RESOURCES-MIB::hrStorageAllocationFailures",
+	"1.3.6.1.2.1.25.3":                      "HOST-RESOURCES-MIB::hrDevice",
+	"1.3.6.1.2.1.25.3.1":                    "HOST-RESOURCES-MIB::hrDeviceTypes",
+	"1.3.6.1.2.1.25.3.2":                    "HOST-RESOURCES-MIB::hrDeviceTable",
+	"1.3.6.1.2.1.25.3.2.1":                  "HOST-RESOURCES-MIB::hrDeviceEntry",
+	"1.3.6.1.2.1.25.3.2.1.1":                "HOST-RESOURCES-MIB::hrDeviceIndex",
+	"1.3.6.1.2.1.25.3.2.1.2":                "HOST-RESOURCES-MIB::hrDeviceType",
+	"1.3.6.1.2.1.25.3.2.1.3":                "HOST-RESOURCES-MIB::hrDeviceDescr",
+	"1.3.6.1.2.1.25.3.2.1.4":                "HOST-RESOURCES-MIB::hrDeviceID",
+	"1.3.6.1.2.1.25.3.2.1.5":                "HOST-RESOURCES-MIB::hrDeviceStatus",
+	"1.3.6.1.2.1.25.3.2.1.6":                "HOST-RESOURCES-MIB::hrDeviceErrors",
+	"1.3.6.1.2.1.25.3.3":                    "HOST-RESOURCES-MIB::hrProcessorTable",
+	"1.3.6.1.2.1.25.3.3.1":                  "HOST-RESOURCES-MIB::hrProcessorEntry",
+	"1.3.6.1.2.1.25.3.3.1.1":                "HOST-RESOURCES-MIB::hrProcessorFrwID",
+	"1.3.6.1.2.1.25.3.3.1.2":                "HOST-RESOURCES-MIB::hrProcessorLoad",
+	"1.3.6.1.2.1.25.3.4":                    "HOST-RESOURCES-MIB::hrNetworkTable",
+	"1.3.6.1.2.1.25.3.4.1":                  "HOST-RESOURCES-MIB::hrNetworkEntry",
+	"1.3.6.1.2.1.25.3.4.1.1":                "HOST-RESOURCES-MIB::hrNetworkIfIndex",
+	"1.3.6.1.2.1.25.3.5":                    "HOST-RESOURCES-MIB::hrPrinterTable",
+	"1.3.6.1.2.1.25.3.5.1":                  "HOST-RESOURCES-MIB::hrPrinterEntry",
+	"1.3.6.1.2.1.25.3.5.1.1":                "HOST-RESOURCES-MIB::hrPrinterStatus",
+	"1.3.6.1.2.1.25.3.5.1.2":                "HOST-RESOURCES-MIB::hrPrinterDetectedErrorState",
+	"1.3.6.1.2.1.25.3.6":                    "HOST-RESOURCES-MIB::hrDiskStorageTable",
+	"1.3.6.1.2.1.25.3.6.1":                  "HOST-RESOURCES-MIB::hrDiskStorageEntry",
+	"1.3.6.1.2.1.25.3.6.1.1":                "HOST-RESOURCES-MIB::hrDiskStorageAccess",
+	"1.3.6.1.2.1.25.3.6.1.2":                "HOST-RESOURCES-MIB::hrDiskStorageMedia",
+	"1.3.6.1.2.1.25.3.6.1.3":                "HOST-RESOURCES-MIB::hrDiskStorageRemoveble",
+	"1.3.6.1.2.1.25.3.6.1.4":                "HOST-RESOURCES-MIB::hrDiskStorageCapacity",
+	"1.3.6.1.2.1.25.3.7":                    "HOST-RESOURCES-MIB::hrPartitionTable",
+	"1.3.6.1.2.1.25.3.7.1":                  "HOST-RESOURCES-MIB::hrPartitionEntry",
+	"1.3.6.1.2.1.25.3.7.1.1":                "HOST-RESOURCES-MIB::hrPartitionIndex",
+	"1.3.6.1.2.1.25.3.7.1.2":                "HOST-RESOURCES-MIB::hrPartitionLabel",
+	"1.3.6.1.2.1.25.3.7.1.3":                "HOST-RESOURCES-MIB::hrPartitionID",
+	"1.3.6.1.2.1.25.3.7.1.4":                "HOST-RESOURCES-MIB::hrPartitionSize",
+	"1.3.6.1.2.1.25.3.7.1.5":                "HOST-RESOURCES-MIB::hrPartitionFSIndex",
+	"1.3.6.1.2.1.25.3.8":                    "HOST-RESOURCES-MIB::hrFSTable",
+	"1.3.6.1.2.1.25.3.8.1":                  "HOST-RESOURCES-MIB::hrFSEntry",
+	"1.3.6.1.2.1.25.3.8.1.1":                "HOST-RESOURCES-MIB::hrFSIndex",
+	"1.3.6.1.2.1.25.3.8.1.2":                "HOST-RESOURCES-MIB::hrFSMountPoint",
+	"1.3.6.1.2.1.25.3.8.1.3":                "HOST-RESOURCES-MIB::hrFSRemoteMountPoint",
+	"1.3.6.1.2.1.25.3.8.1.4":                "HOST-RESOURCES-MIB::hrFSType",
+	"1.3.6.1.2.1.25.3.8.1.5":                "HOST-RESOURCES-MIB::hrFSAccess",
+	"1.3.6.1.2.1.25.3.8.1.6":                "HOST-RESOURCES-MIB::hrFSBootable",
+	"1.3.6.1.2.1.25.3.8.1.7":                "HOST-RESOURCES-MIB::hrFSStorageIndex",
+	"1.3.6.1.2.1.25.3.8.1.8":                "HOST-RESOURCES-MIB::hrFSLastFullBackupDate",
+	"1.3.6.1.2.1.25.3.8.1.9":                "HOST-RESOURCES-MIB::hrFSLastPartialBackupDate",
+	"1.3.6.1.2.1.25.3.9":                    "HOST-RESOURCES-MIB::hrFSTypes",
+	"1.3.6.1.2.1.25.4":                      "HOST-RESOURCES-MIB::hrSWRun",
+	"1.3.6.1.2.1.25.4.1":                    "HOST-RESOURCES-MIB::hrSWOSIndex",
+	"1.3.6.1.2.1.25.4.2":                    "HOST-RESOURCES-MIB::hrSWRunTable",
+	"1.3.6.1.2.1.25.4.2.1":                  "HOST-RESOURCES-MIB::hrSWRunEntry",
+	"1.3.6.1.2.1.25.4.2.1.1":                "HOST-RESOURCES-MIB::hrSWRunIndex",
+	"1.3.6.1.2.1.25.4.2.1.2":                "HOST-RESOURCES-MIB::hrSWRunName",
+	"1.3.6.1.2.1.25.4.2.1.3":                "HOST-RESOURCES-MIB::hrSWRunID",
+	"1.3.6.1.2.1.25.4.2.1.4":                "HOST-RESOURCES-MIB::hrSWRunPath",
+	"1.3.6.1.2.1.25.4.2.1.5":                "HOST-RESOURCES-MIB::hrSWRunParameters",
+	"1.3.6.1.2.1.25.4.2.1.6":                "HOST-RESOURCES-MIB::hrSWRunType",
+	"1.3.6.1.2.1.25.4.2.1.7":                "HOST-RESOURCES-MIB::hrSWRunStatus",
+	"1.3.6.1.2.1.25.5":                      "HOST-RESOURCES-MIB::hrSWRunPerf",
+	"1.3.6.1.2.1.25.5.1":                    "HOST-RESOURCES-MIB::hrSWRunPerfTable",
+	"1.3.6.1.2.1.25.5.1.1":                  "HOST-RESOURCES-MIB::hrSWRunPerfEntry",
+	"1.3.6.1.2.1.25.5.1.1.1":                "HOST-RESOURCES-MIB::hrSWRunPerfCPU",
+	"1.3.6.1.2.1.25.5.1.1.2":                "HOST-RESOURCES-MIB::hrSWRunPerfMem",
+	"1.3.6.1.2.1.25.6":                      "HOST-RESOURCES-MIB::hrSWInstalled",
+	"1.3.6.1.2.1.25.6.1":                    "HOST-RESOURCES-MIB::hrSWInstalledLastChange",
+	"1.3.6.1.2.1.25.6.2":                    "HOST-RESOURCES-MIB::hrSWInstalledLastUpdateTime",
+	"1.3.6.1.2.1.25.6.3":                    "HOST-RESOURCES-MIB::hrSWInstalledTable",
+	"1.3.6.1.2.1.25.6.3.1":                  "HOST-RESOURCES-MIB::hrSWInstalledEntry",
+	"1.3.6.1.2.1.25.6.3.1.1":                "HOST-RESOURCES-MIB::hrSWInstalledIndex",
+	"1.3.6.1.2.1.25.6.3.1.2":                "HOST-RESOURCES-MIB::hrSWInstalledName",
+	"1.3.6.1.2.1.25.6.3.1.3":                "HOST-RESOURCES-MIB::hrSWInstalledID",
+	"1.3.6.1.2.1.25.6.3.1.4":                "HOST-RESOURCES-MIB::hrSWInstalledType",
+	"1.3.6.1.2.1.25.6.3.1.5":                "HOST-RESOURCES-MIB::hrSWInstalledDate",
+	"1.3.6.1.2.1.25.7":                      "HOST-RESOURCES-MIB::hrMIBAdminInfo",
+	"1.3.6.1.2.1.25.7.1":                    "HOST-RESOURCES-MIB::hostResourcesMibModule",
+	"1.3.6.1.2.1.25.7.2":                    "HOST-RESOURCES-MIB::hrMIBCompliances",
+	"1.3.6.1.2.1.25.7.3":                    "HOST-RESOURCES-MIB::hrMIBGroups",
+	"1.3.6.1.2.1.30":                        "IANAifType-MIB::ianaifType",
+	"1.3.6.1.2.1.31":                        "IF-MIB::ifMIB",
+	"1.3.6.1.2.1.31.1":                      "IF-MIB::ifMIBObjects",
+	"1.3.6.1.2.1.31.1.1":                    "IF-MIB::ifXTable",
+	"1.3.6.1.2.1.31.1.1.1":                  "IF-MIB::ifXEntry",
+	"1.3.6.1.2.1.31.1.1.1.1":                "IF-MIB::ifName",
+	"1.3.6.1.2.1.31.1.1.1.10":               "IF-MIB::ifHCOutOctets",
+	"1.3.6.1.2.1.31.1.1.1.11":               "IF-MIB::ifHCOutUcastPkts",
+	"1.3.6.1.2.1.31.1.1.1.12":               "IF-MIB::ifHCOutMulticastPkts",
+	"1.3.6.1.2.1.31.1.1.1.13":               "IF-MIB::ifHCOutBroadcastPkts",
+	"1.3.6.1.2.1.31.1.1.1.14":               "IF-MIB::ifLinkUpDownTrapEnable",
+	"1.3.6.1.2.1.31.1.1.1.15":               "IF-MIB::ifHighSpeed",
+	"1.3.6.1.2.1.31.1.1.1.16":               "IF-MIB::ifPromiscuousMode",
+	"1.3.6.1.2.1.31.1.1.1.17":               "IF-MIB::ifConnectorPresent",
+	"1.3.6.1.2.1.31.1.1.1.18":               "IF-MIB::ifAlias",
+	"1.3.6.1.2.1.31.1.1.1.19":               "IF-MIB::ifCounterDiscontinuityTime",
+	"1.3.6.1.2.1.31.1.1.1.2":                "IF-MIB::ifInMulticastPkts",
+	"1.3.6.1.2.1.31.1.1.1.3":                "IF-MIB::ifInBroadcastPkts",
+	"1.3.6.1.2.1.31.1.1.1.4":                "IF-MIB::ifOutMulticastPkts",
+	"1.3.6.1.2.1.31.1.1.1.5":                "IF-MIB::ifOutBroadcastPkts",
+	"1.3.6.1.2.1.31.1.1.1.6":                "IF-MIB::ifHCInOctets",
+	"1.3.6.1.2.1.31.1.1.1.7":                "IF-MIB::ifHCInUcastPkts",
+	"1.3.6.1.2.1.31.1.1.1.8":                "IF-MIB::ifHCInMulticastPkts",
+	"1.3.6.1.2.1.31.1.1.1.9":                "IF-MIB::ifHCInBroadcastPkts",
+	"1.3.6.1.2.1.31.1.2":                    "IF-MIB::ifStackTable",
+	"1.3.6.1.2.1.31.1.2.1":                  "IF-MIB::ifStackEntry",
+	"1.3.6.1.2.1.31.1.2.1.1":                "IF-MIB::ifStackHigherLayer",
+	"1.3.6.1.2.1.31.1.2.1.2":                "IF-MIB::ifStackLowerLayer",
+	"1.3.6.1.2.1.31.1.2.1.3":                "IF-MIB::ifStackStatus",
+	"1.3.6.1.2.1.31.1.3":                    "IF-MIB::ifTestTable",
+	"1.3.6.1.2.1.31.1.3.1":                  "IF-MIB::ifTestEntry",
+	"1.3.6.1.2.1.31.1.3.1.1":                "IF-MIB::ifTestId",
+	"1.3.6.1.2.1.31.1.3.1.2":                "IF-MIB::ifTestStatus",
+	"1.3.6.1.2.1.31.1.3.1.3":                "IF-MIB::ifTestType",
+	"1.3.6.1.2.1.31.1.3.1.4":                "IF-MIB::ifTestResult",
+	"1.3.6.1.2.1.31.1.3.1.5":                "IF-MIB::ifTestCode",
+	"1.3.6.1.2.1.31.1.3.1.6":                "IF-MIB::ifTestOwner",
+	"1.3.6.1.2.1.31.1.4":                    "IF-MIB::ifRcvAddressTable",
+	"1.3.6.1.2.1.31.1.4.1":                  "IF-MIB::ifRcvAddressEntry",
+	"1.3.6.1.2.1.31.1.4.1.1":                "IF-MIB::ifRcvAddressAddress",
+	"1.3.6.1.2.1.31.1.4.1.2":                "IF-MIB::ifRcvAddressStatus",
+	"1.3.6.1.2.1.31.1.4.1.3":                "IF-MIB::ifRcvAddressType",
+	"1.3.6.1.2.1.31.1.5":                    "IF-MIB::ifTableLastChange",
+	"1.3.6.1.2.1.31.1.6":                    "IF-MIB::ifStackLastChange",
+	"1.3.6.1.2.1.31.2":                      "IF-MIB::ifConformance",
+	"1.3.6.1.2.1.31.2.1":                    "IF-MIB::ifGroups",
+	"1.3.6.1.2.1.31.2.2":                    "IF-MIB::ifCompliances",
+	"1.3.6.1.2.1.47":                        "ENTITY-MIB::entityMIB",
+	"1.3.6.1.2.1.47.1":                      "ENTITY-MIB::entityMIBObjects",
+	"1.3.6.1.2.1.47.1.1":                    "ENTITY-MIB::entityPhysical",
+	"1.3.6.1.2.1.47.1.1.1":                  "ENTITY-MIB::entPhysicalTable",
+	"1.3.6.1.2.1.47.1.1.1.1":                "ENTITY-MIB::entPhysicalEntry",
+	"1.3.6.1.2.1.47.1.1.1.1.1":              "ENTITY-MIB::entPhysicalIndex",
+	"1.3.6.1.2.1.47.1.1.1.1.10":             "ENTITY-MIB::entPhysicalSoftwareRev",
+	"1.3.6.1.2.1.47.1.1.1.1.11":             "ENTITY-MIB::entPhysicalSerialNum",
+	"1.3.6.1.2.1.47.1.1.1.1.12":             "ENTITY-MIB::entPhysicalMfgName",
+	"1.3.6.1.2.1.47.1.1.1.1.13":             "ENTITY-MIB::entPhysicalModelName",
+	"1.3.6.1.2.1.47.1.1.1.1.2":              "ENTITY-MIB::entPhysicalDescr",
+	"1.3.6.1.2.1.47.1.1.1.1.3":              "ENTITY-MIB::entPhysicalVendorType",
+	"1.3.6.1.2.1.47.1.1.1.1.4":              "ENTITY-MIB::entPhysicalContainedIn",
+	"1.3.6.1.2.1.47.1.1.1.1.5":              "ENTITY-MIB::entPhysicalClass",
+	"1.3.6.1.2.1.47.1.1.1.1.6":              "ENTITY-MIB::entPhysicalParentRelPos",
+	"1.3.6.1.2.1.47.1.1.1.1.7":              "ENTITY-MIB::entPhysicalName",
+	"1.3.6.1.2.1.47.1.1.1.1.8":              "ENTITY-MIB::entPhysicalHardwareRev",
+	"1.3.6.1.2.1.47.1.1.1.1.9":              "ENTITY-MIB::entPhysicalFirmwareRev",
+	"1.3.6.1.2.1.76":                        "INET-ADDRESS-MIB::inetAddressMIB",
+	"1.3.6.1.3":                             "SNMPv2-SMI::experimental",
+	"1.3.6.1.4":                             "SNMPv2-SMI::private",
+	"1.3.6.1.4.1":                           "SNMPv2-SMI::enterprises",
+	"1.3.6.1.4.1.14988":                     "MIKROTIK-MIB::mikrotik",
+	"1.3.6.1.4.1.14988.1":                   "MIKROTIK-MIB::mikrotikExperimentalModule",
+	"1.3.6.1.4.1.14988.1.1":                 "MIKROTIK-MIB::mtXRouterOs",
+	"1.3.6.1.4.1.14988.1.1.1":               "MIKROTIK-MIB::mtxrWireless",
+	"1.3.6.1.4.1.14988.1.1.1.1":             "MIKROTIK-MIB::mtxrWlStatTable",
+	"1.3.6.1.4.1.14988.1.1.1.1.1":           "MIKROTIK-MIB::mtxrWlStatEntry",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.1":         "MIKROTIK-MIB::mtxrWlStatIndex",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.10":        "MIKROTIK-MIB::mtxrWlStatRxCCQ",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.2":         "MIKROTIK-MIB::mtxrWlStatTxRate",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.3":         "MIKROTIK-MIB::mtxrWlStatRxRate",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.4":         "MIKROTIK-MIB::mtxrWlStatStrength",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.5":         "MIKROTIK-MIB::mtxrWlStatSsid",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.6":         "MIKROTIK-MIB::mtxrWlStatBssid",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.7":         "MIKROTIK-MIB::mtxrWlStatFreq",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.8":         "MIKROTIK-MIB::mtxrWlStatBand",
+	"1.3.6.1.4.1.14988.1.1.1.1.1.9":         "MIKROTIK-MIB::mtxrWlStatTxCCQ",
+	"1.3.6.1.4.1.14988.1.1.1.10":            "MIKROTIK-MIB::mtxrWlCMREntryCount",
+	"1.3.6.1.4.1.14988.1.1.1.11":            "MIKROTIK-MIB::mtxrWlCMRemoteTable",
+	"1.3.6.1.4.1.14988.1.1.1.11.1":          "MIKROTIK-MIB::mtxrWlCMRemoteEntry",
+	"1.3.6.1.4.1.14988.1.1.1.11.1.1":        "MIKROTIK-MIB::mtxrWlCMRemoteIndex",
+	"1.3.6.1.4.1.14988.1.1.1.11.1.2":        "MIKROTIK-MIB::mtxrWlCMRemoteName",
+	"1.3.6.1.4.1.14988.1.1.1.11.1.3":        "MIKROTIK-MIB::mtxrWlCMRemoteState",
+	"1.3.6.1.4.1.14988.1.1.1.11.1.4":        "MIKROTIK-MIB::mtxrWlCMRemoteAddress",
+	"1.3.6.1.4.1.14988.1.1.1.11.1.5":        "MIKROTIK-MIB::mtxrWlCMRemoteRadios",
+	"1.3.6.1.4.1.14988.1.1.1.2":             "MIKROTIK-MIB::mtxrWlRtabTable",
+	"1.3.6.1.4.1.14988.1.1.1.2.1":           "MIKROTIK-MIB::mtxrWlRtabEntry",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.1":         "MIKROTIK-MIB::mtxrWlRtabAddr",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.10":        "MIKROTIK-MIB::mtxrWlRtabRouterOSVersion",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.11":        "MIKROTIK-MIB::mtxrWlRtabUptime",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.12":        "MIKROTIK-MIB::mtxrWlRtabSignalToNoise",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.13":        "MIKROTIK-MIB::mtxrWlRtabTxStrengthCh0",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.14":        "MIKROTIK-MIB::mtxrWlRtabRxStrengthCh0",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.15":        "MIKROTIK-MIB::mtxrWlRtabTxStrengthCh1",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.16":        "MIKROTIK-MIB::mtxrWlRtabRxStrengthCh1",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.17":        "MIKROTIK-MIB::mtxrWlRtabTxStrengthCh2",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.18":        "MIKROTIK-MIB::mtxrWlRtabRxStrengthCh2",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.19":        "MIKROTIK-MIB::mtxrWlRtabTxStrength",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.2":         "MIKROTIK-MIB::mtxrWlRtabIface",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.20":        "MIKROTIK-MIB::mtxrWlRtabRadioName",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.3":         "MIKROTIK-MIB::mtxrWlRtabStrength",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.4":         "MIKROTIK-MIB::mtxrWlRtabTxBytes",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.5":         "MIKROTIK-MIB::mtxrWlRtabRxBytes",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.6":         "MIKROTIK-MIB::mtxrWlRtabTxPackets",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.7":         "MIKROTIK-MIB::mtxrWlRtabRxPackets",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.8":         "MIKROTIK-MIB::mtxrWlRtabTxRate",
+	"1.3.6.1.4.1.14988.1.1.1.2.1.9":         "MIKROTIK-MIB::mtxrWlRtabRxRate",
+	"1.3.6.1.4.1.14988.1.1.1.3":             "MIKROTIK-MIB::mtxrWlApTable",
+	"1.3.6.1.4.1.14988.1.1.1.3.1":           "MIKROTIK-MIB::mtxrWlApEntry",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.1":         "MIKROTIK-MIB::mtxrWlApIndex",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.10":        "MIKROTIK-MIB::mtxrWlApOverallTxCCQ",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.11":        "MIKROTIK-MIB::mtxrWlApAuthClientCount",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.2":         "MIKROTIK-MIB::mtxrWlApTxRate",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.3":         "MIKROTIK-MIB::mtxrWlApRxRate",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.4":         "MIKROTIK-MIB::mtxrWlApSsid",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.5":         "MIKROTIK-MIB::mtxrWlApBssid",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.6":         "MIKROTIK-MIB::mtxrWlApClientCount",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.7":         "MIKROTIK-MIB::mtxrWlApFreq",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.8":         "MIKROTIK-MIB::mtxrWlApBand",
+	"1.3.6.1.4.1.14988.1.1.1.3.1.9":         "MIKROTIK-MIB::mtxrWlApNoiseFloor",
+	"1.3.6.1.4.1.14988.1.1.1.4":             "MIKROTIK-MIB::mtxrWlRtabEntryCount",
+	"1.3.6.1.4.1.14988.1.1.1.5":             "MIKROTIK-MIB::mtxrWlCMRtabTable",
+	"1.3.6.1.4.1.14988.1.1.1.5.1":           "MIKROTIK-MIB::mtxrWlCMRtabEntry",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.1":         "MIKROTIK-MIB::mtxrWlCMRtabAddr",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.10":        "MIKROTIK-MIB::mtxrWlCMRtabTxStrength",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.11":        "MIKROTIK-MIB::mtxrWlCMRtabRxStrength",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.12":        "MIKROTIK-MIB::mtxrWlCMRtabSsid",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.13":        "MIKROTIK-MIB::mtxrWlCMRtabEapIdent",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.2":         "MIKROTIK-MIB::mtxrWlCMRtabIface",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.3":         "MIKROTIK-MIB::mtxrWlCMRtabUptime",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.4":         "MIKROTIK-MIB::mtxrWlCMRtabTxBytes",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.5":         "MIKROTIK-MIB::mtxrWlCMRtabRxBytes",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.6":         "MIKROTIK-MIB::mtxrWlCMRtabTxPackets",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.7":         "MIKROTIK-MIB::mtxrWlCMRtabRxPackets",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.8":         "MIKROTIK-MIB::mtxrWlCMRtabTxRate",
+	"1.3.6.1.4.1.14988.1.1.1.5.1.9":         "MIKROTIK-MIB::mtxrWlCMRtabRxRate",
+	"1.3.6.1.4.1.14988.1.1.1.6":             "MIKROTIK-MIB::mtxrWlCMRtabEntryCount",
+	"1.3.6.1.4.1.14988.1.1.1.7":             "MIKROTIK-MIB::mtxrWlCMTable",
+	"1.3.6.1.4.1.14988.1.1.1.7.1":           "MIKROTIK-MIB::mtxrWlCMEntry",
+	"1.3.6.1.4.1.14988.1.1.1.7.1.1":         "MIKROTIK-MIB::mtxrWlCMIndex",
+	"1.3.6.1.4.1.14988.1.1.1.7.1.2":         "MIKROTIK-MIB::mtxrWlCMRegClientCount",
+	"1.3.6.1.4.1.14988.1.1.1.7.1.3":         "MIKROTIK-MIB::mtxrWlCMAuthClientCount",
+	"1.3.6.1.4.1.14988.1.1.1.7.1.4":         "MIKROTIK-MIB::mtxrWlCMState",
+	"1.3.6.1.4.1.14988.1.1.1.7.1.5":         "MIKROTIK-MIB::mtxrWlCMChannel",
+	"1.3.6.1.4.1.14988.1.1.1.8":             "MIKROTIK-MIB::mtxrWl60GTable",
+	"1.3.6.1.4.1.14988.1.1.1.8.1":           "MIKROTIK-MIB::mtxrWl60GEntry",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.1":         "MIKROTIK-MIB::mtxrWl60GIndex",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.11":        "MIKROTIK-MIB::mtxrWl60GTxSectorInfo",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.12":        "MIKROTIK-MIB::mtxrWl60GRssi",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.13":        "MIKROTIK-MIB::mtxrWl60GPhyRate",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.2":         "MIKROTIK-MIB::mtxrWl60GMode",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.3":         "MIKROTIK-MIB::mtxrWl60GSsid",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.4":         "MIKROTIK-MIB::mtxrWl60GConnected",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.5":         "MIKROTIK-MIB::mtxrWl60GRemote",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.6":         "MIKROTIK-MIB::mtxrWl60GFreq",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.7":         "MIKROTIK-MIB::mtxrWl60GMcs",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.8":         "MIKROTIK-MIB::mtxrWl60GSignal",
+	"1.3.6.1.4.1.14988.1.1.1.8.1.9":         "MIKROTIK-MIB::mtxrWl60GTxSector",
+	"1.3.6.1.4.1.14988.1.1.1.9":             "MIKROTIK-MIB::mtxrWl60GStaTable",
+	"1.3.6.1.4.1.14988.1.1.1.9.1":           "MIKROTIK-MIB::mtxrWl60GStaEntry",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.1":         "MIKROTIK-MIB::mtxrWl60GStaIndex",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.10":        "MIKROTIK-MIB::mtxrWl60GStaDistance",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.2":         "MIKROTIK-MIB::mtxrWl60GStaConnected",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.3":         "MIKROTIK-MIB::mtxrWl60GStaRemote",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.4":         "MIKROTIK-MIB::mtxrWl60GStaMcs",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.5":         "MIKROTIK-MIB::mtxrWl60GStaSignal",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.6":         "MIKROTIK-MIB::mtxrWl60GStaTxSector",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.8":         "MIKROTIK-MIB::mtxrWl60GStaPhyRate",
+	"1.3.6.1.4.1.14988.1.1.1.9.1.9":         "MIKROTIK-MIB::mtxrWl60GStaRssi",
+	"1.3.6.1.4.1.14988.1.1.10":              "MIKROTIK-MIB::mtxrNstremeDual",
+	"1.3.6.1.4.1.14988.1.1.10.1":            "MIKROTIK-MIB::mtxrDnStatTable",
+	"1.3.6.1.4.1.14988.1.1.10.1.1":          "MIKROTIK-MIB::mtxrDnStatEntry",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.1":        "MIKROTIK-MIB::mtxrDnStatIndex",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.2":        "MIKROTIK-MIB::mtxrDnStatTxRate",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.3":        "MIKROTIK-MIB::mtxrDnStatRxRate",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.4":        "MIKROTIK-MIB::mtxrDnStatTxStrength",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.5":        "MIKROTIK-MIB::mtxrDnStatRxStrength",
+	"1.3.6.1.4.1.14988.1.1.10.1.1.6":        "MIKROTIK-MIB::mtxrDnConnected",
+	"1.3.6.1.4.1.14988.1.1.11":              "MIKROTIK-MIB::mtxrNeighbor",
+	"1.3.6.1.4.1.14988.1.1.11.1":            "MIKROTIK-MIB::mtxrNeighborTable",
+	"1.3.6.1.4.1.14988.1.1.11.1.1":          "MIKROTIK-MIB::mtxrNeighborTableEntry",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.1":        "MIKROTIK-MIB::mtxrNeighborIndex",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.2":        "MIKROTIK-MIB::mtxrNeighborIpAddress",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.3":        "MIKROTIK-MIB::mtxrNeighborMacAddress",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.4":        "MIKROTIK-MIB::mtxrNeighborVersion",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.5":        "MIKROTIK-MIB::mtxrNeighborPlatform",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.6":        "MIKROTIK-MIB::mtxrNeighborIdentity",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.7":        "MIKROTIK-MIB::mtxrNeighborSoftwareID",
+	"1.3.6.1.4.1.14988.1.1.11.1.1.8":        "MIKROTIK-MIB::mtxrNeighborInterfaceID",
+	"1.3.6.1.4.1.14988.1.1.12":              "MIKROTIK-MIB::mtxrGps",
+	"1.3.6.1.4.1.14988.1.1.12.1":            "MIKROTIK-MIB::mtxrDate",
+	"1.3.6.1.4.1.14988.1.1.12.2":            "MIKROTIK-MIB::mtxrLongtitude",
+	"1.3.6.1.4.1.14988.1.1.12.3":            "MIKROTIK-MIB::mtxrLatitude",
+	"1.3.6.1.4.1.14988.1.1.12.4":            "MIKROTIK-MIB::mtxrAltitude",
+	"1.3.6.1.4.1.14988.1.1.12.5":            "MIKROTIK-MIB::mtxrSpeed",
+	"1.3.6.1.4.1.14988.1.1.12.6":            "MIKROTIK-MIB::mtxrSattelites",
+	"1.3.6.1.4.1.14988.1.1.12.7":            "MIKROTIK-MIB::mtxrValid",
+	"1.3.6.1.4.1.14988.1.1.13":              "MIKROTIK-MIB::mtxrWirelessModem",
+	"1.3.6.1.4.1.14988.1.1.13.1":            "MIKROTIK-MIB::mtxrWirelessModemSignalStrength",
+	"1.3.6.1.4.1.14988.1.1.13.2":            "MIKROTIK-MIB::mtxrWirelessModemSignalECIO",
+	"1.3.6.1.4.1.14988.1.1.14":              "MIKROTIK-MIB::mtxrInterfaceStats",
+	"1.3.6.1.4.1.14988.1.1.14.1":            "MIKROTIK-MIB::mtxrInterfaceStatsTable",
+	"1.3.6.1.4.1.14988.1.1.14.1.1":          "MIKROTIK-MIB::mtxrInterfaceStatsEntry",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.1":        "MIKROTIK-MIB::mtxrInterfaceStatsIndex",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.11":       "MIKROTIK-MIB::mtxrInterfaceStatsDriverRxBytes",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.12":       "MIKROTIK-MIB::mtxrInterfaceStatsDriverRxPackets",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.13":       "MIKROTIK-MIB::mtxrInterfaceStatsDriverTxBytes",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.14":       "MIKROTIK-MIB::mtxrInterfaceStatsDriverTxPackets",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.15":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx64",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.16":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx65To127",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.17":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx128To255",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.18":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx256To511",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.19":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx512To1023",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.2":        "MIKROTIK-MIB::mtxrInterfaceStatsName",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.20":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx1024To1518",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.21":       "MIKROTIK-MIB::mtxrInterfaceStatsTxRx1519ToMax",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.31":       "MIKROTIK-MIB::mtxrInterfaceStatsRxBytes",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.32":       "MIKROTIK-MIB::mtxrInterfaceStatsRxPackets",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.33":       "MIKROTIK-MIB::mtxrInterfaceStatsRxTooShort",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.34":       "MIKROTIK-MIB::mtxrInterfaceStatsRx64",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.35":       "MIKROTIK-MIB::mtxrInterfaceStatsRx65To127",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.36":       "MIKROTIK-MIB::mtxrInterfaceStatsRx128To255",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.37":       "MIKROTIK-MIB::mtxrInterfaceStatsRx256To511",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.38":       "MIKROTIK-MIB::mtxrInterfaceStatsRx512To1023",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.39":       "MIKROTIK-MIB::mtxrInterfaceStatsRx1024To1518",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.40":       "MIKROTIK-MIB::mtxrInterfaceStatsRx1519ToMax",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.41":       "MIKROTIK-MIB::mtxrInterfaceStatsRxTooLong",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.42":       "MIKROTIK-MIB::mtxrInterfaceStatsRxBroadcast",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.43":       "MIKROTIK-MIB::mtxrInterfaceStatsRxPause",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.44":       "MIKROTIK-MIB::mtxrInterfaceStatsRxMulticast",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.45":       "MIKROTIK-MIB::mtxrInterfaceStatsRxFCSError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.46":       "MIKROTIK-MIB::mtxrInterfaceStatsRxAlignError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.47":       "MIKROTIK-MIB::mtxrInterfaceStatsRxFragment",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.48":       "MIKROTIK-MIB::mtxrInterfaceStatsRxOverflow",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.49":       "MIKROTIK-MIB::mtxrInterfaceStatsRxControl",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.50":       "MIKROTIK-MIB::mtxrInterfaceStatsRxUnknownOp",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.51":       "MIKROTIK-MIB::mtxrInterfaceStatsRxLengthError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.52":       "MIKROTIK-MIB::mtxrInterfaceStatsRxCodeError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.53":       "MIKROTIK-MIB::mtxrInterfaceStatsRxCarrierError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.54":       "MIKROTIK-MIB::mtxrInterfaceStatsRxJabber",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.55":       "MIKROTIK-MIB::mtxrInterfaceStatsRxDrop",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.61":       "MIKROTIK-MIB::mtxrInterfaceStatsTxBytes",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.62":       "MIKROTIK-MIB::mtxrInterfaceStatsTxPackets",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.63":       "MIKROTIK-MIB::mtxrInterfaceStatsTxTooShort",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.64":       "MIKROTIK-MIB::mtxrInterfaceStatsTx64",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.65":       "MIKROTIK-MIB::mtxrInterfaceStatsTx65To127",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.66":       "MIKROTIK-MIB::mtxrInterfaceStatsTx128To255",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.67":       "MIKROTIK-MIB::mtxrInterfaceStatsTx256To511",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.68":       "MIKROTIK-MIB::mtxrInterfaceStatsTx512To1023",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.69":       "MIKROTIK-MIB::mtxrInterfaceStatsTx1024To1518",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.70":       "MIKROTIK-MIB::mtxrInterfaceStatsTx1519ToMax",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.71":       "MIKROTIK-MIB::mtxrInterfaceStatsTxTooLong",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.72":       "MIKROTIK-MIB::mtxrInterfaceStatsTxBroadcast",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.73":       "MIKROTIK-MIB::mtxrInterfaceStatsTxPause",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.74":       "MIKROTIK-MIB::mtxrInterfaceStatsTxMulticast",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.75":       "MIKROTIK-MIB::mtxrInterfaceStatsTxUnderrun",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.76":       "MIKROTIK-MIB::mtxrInterfaceStatsTxCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.77":       "MIKROTIK-MIB::mtxrInterfaceStatsTxExcessiveCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.78":       "MIKROTIK-MIB::mtxrInterfaceStatsTxMultipleCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.79":       "MIKROTIK-MIB::mtxrInterfaceStatsTxSingleCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.80":       "MIKROTIK-MIB::mtxrInterfaceStatsTxExcessiveDeferred",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.81":       "MIKROTIK-MIB::mtxrInterfaceStatsTxDeferred",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.82":       "MIKROTIK-MIB::mtxrInterfaceStatsTxLateCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.83":       "MIKROTIK-MIB::mtxrInterfaceStatsTxTotalCollision",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.84":       "MIKROTIK-MIB::mtxrInterfaceStatsTxPauseHonored",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.85":       "MIKROTIK-MIB::mtxrInterfaceStatsTxDrop",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.86":       "MIKROTIK-MIB::mtxrInterfaceStatsTxJabber",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.87":       "MIKROTIK-MIB::mtxrInterfaceStatsTxFCSError",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.88":       "MIKROTIK-MIB::mtxrInterfaceStatsTxControl",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.89":       "MIKROTIK-MIB::mtxrInterfaceStatsTxFragment",
+	"1.3.6.1.4.1.14988.1.1.14.1.1.90":       "MIKROTIK-MIB::mtxrInterfaceStatsLinkDowns",
+	"1.3.6.1.4.1.14988.1.1.15":              "MIKROTIK-MIB::mtxrPOE",
+	"1.3.6.1.4.1.14988.1.1.15.1":            "MIKROTIK-MIB::mtxrPOETable",
+	"1.3.6.1.4.1.14988.1.1.15.1.1":          "MIKROTIK-MIB::mtxrPOEEntry",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.1":        "MIKROTIK-MIB::mtxrPOEInterfaceIndex",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.2":        "MIKROTIK-MIB::mtxrPOEName",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.3":        "MIKROTIK-MIB::mtxrPOEStatus",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.4":        "MIKROTIK-MIB::mtxrPOEVoltage",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.5":        "MIKROTIK-MIB::mtxrPOECurrent",
+	"1.3.6.1.4.1.14988.1.1.15.1.1.6":        "MIKROTIK-MIB::mtxrPOEPower",
+	"1.3.6.1.4.1.14988.1.1.16":              "MIKROTIK-MIB::mtxrLTEModem",
+	"1.3.6.1.4.1.14988.1.1.16.1":            "MIKROTIK-MIB::mtxrLTEModemTable",
+	"1.3.6.1.4.1.14988.1.1.16.1.1":          "MIKROTIK-MIB::mtxrLTEModemEntry",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.1":        "MIKROTIK-MIB::mtxrLTEModemInterfaceIndex",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.10":       "MIKROTIK-MIB::mtxrLTEModemLac",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.11":       "MIKROTIK-MIB::mtxrLTEModemIMEI",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.12":       "MIKROTIK-MIB::mtxrLTEModemIMSI",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.13":       "MIKROTIK-MIB::mtxrLTEModemUICC",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.14":       "MIKROTIK-MIB::mtxrLTEModemRAT",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.2":        "MIKROTIK-MIB::mtxrLTEModemSignalRSSI",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.3":        "MIKROTIK-MIB::mtxrLTEModemSignalRSRQ",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.4":        "MIKROTIK-MIB::mtxrLTEModemSignalRSRP",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.5":        "MIKROTIK-MIB::mtxrLTEModemCellId",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.6":        "MIKROTIK-MIB::mtxrLTEModemAccessTechnology",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.7":        "MIKROTIK-MIB::mtxrLTEModemSignalSINR",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.8":        "MIKROTIK-MIB::mtxrLTEModemEnbId",
+	"1.3.6.1.4.1.14988.1.1.16.1.1.9":        "MIKROTIK-MIB::mtxrLTEModemSectorId",
+	"1.3.6.1.4.1.14988.1.1.17":              "MIKROTIK-MIB::mtxrPartition",
+	"1.3.6.1.4.1.14988.1.1.17.1":            "MIKROTIK-MIB::mtxrPartitionTable",
+	"1.3.6.1.4.1.14988.1.1.17.1.1":          "MIKROTIK-MIB::mtxrPartitionEntry",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.1":        "MIKROTIK-MIB::mtxrPartitionIndex",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.2":        "MIKROTIK-MIB::mtxrPartitionName",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.3":        "MIKROTIK-MIB::mtxrPartitionSize",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.4":        "MIKROTIK-MIB::mtxrPartitionVersion",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.5":        "MIKROTIK-MIB::mtxrPartitionActive",
+	"1.3.6.1.4.1.14988.1.1.17.1.1.6":        "MIKROTIK-MIB::mtxrPartitionRunning",
+	"1.3.6.1.4.1.14988.1.1.18":              "MIKROTIK-MIB::mtxrScriptRun",
+	"1.3.6.1.4.1.14988.1.1.18.1":            "MIKROTIK-MIB::mtxrScriptRunTable",
+	"1.3.6.1.4.1.14988.1.1.18.1.1":          "MIKROTIK-MIB::mtxrScriptRunTableEntry",
+	"1.3.6.1.4.1.14988.1.1.18.1.1.1":        "MIKROTIK-MIB::mtxrScriptRunIndex",
+	"1.3.6.1.4.1.14988.1.1.18.1.1.2":        "MIKROTIK-MIB::mtxrScriptRunOutput",
+	"1.3.6.1.4.1.14988.1.1.19":              "MIKROTIK-MIB::mtxrOptical",
+	"1.3.6.1.4.1.14988.1.1.19.1":            "MIKROTIK-MIB::mtxrOpticalTable",
+	"1.3.6.1.4.1.14988.1.1.19.1.1":          "MIKROTIK-MIB::mtxrOpticalTableEntry",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.1":        "MIKROTIK-MIB::mtxrOpticalIndex",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.10":       "MIKROTIK-MIB::mtxrOpticalRxPower",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.2":        "MIKROTIK-MIB::mtxrOpticalName",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.3":        "MIKROTIK-MIB::mtxrOpticalRxLoss",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.4":        "MIKROTIK-MIB::mtxrOpticalTxFault",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.5":        "MIKROTIK-MIB::mtxrOpticalWavelength",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.6":        "MIKROTIK-MIB::mtxrOpticalTemperature",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.7":        "MIKROTIK-MIB::mtxrOpticalSupplyVoltage",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.8":        "MIKROTIK-MIB::mtxrOpticalTxBiasCurrent",
+	"1.3.6.1.4.1.14988.1.1.19.1.1.9":        "MIKROTIK-MIB::mtxrOpticalTxPower",
+	"1.3.6.1.4.1.14988.1.1.2":               "MIKROTIK-MIB::mtxrQueues",
+	"1.3.6.1.4.1.14988.1.1.2.1":             "MIKROTIK-MIB::mtxrQueueSimpleTable",
+	"1.3.6.1.4.1.14988.1.1.2.1.1":           "MIKROTIK-MIB::mtxrQueueSimpleEntry",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.1":         "MIKROTIK-MIB::mtxrQueueSimpleIndex",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.10":        "MIKROTIK-MIB::mtxrQueueSimplePacketsIn",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.11":        "MIKROTIK-MIB::mtxrQueueSimplePacketsOut",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.12":        "MIKROTIK-MIB::mtxrQueueSimplePCQQueuesIn",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.13":        "MIKROTIK-MIB::mtxrQueueSimplePCQQueuesOut",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.14":        "MIKROTIK-MIB::mtxrQueueSimpleDroppedIn",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.15":        "MIKROTIK-MIB::mtxrQueueSimpleDroppedOut",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.2":         "MIKROTIK-MIB::mtxrQueueSimpleName",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.3":         "MIKROTIK-MIB::mtxrQueueSimpleSrcAddr",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.4":         "MIKROTIK-MIB::mtxrQueueSimpleSrcMask",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.5":         "MIKROTIK-MIB::mtxrQueueSimpleDstAddr",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.6":         "MIKROTIK-MIB::mtxrQueueSimpleDstMask",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.7":         "MIKROTIK-MIB::mtxrQueueSimpleIface",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.8":         "MIKROTIK-MIB::mtxrQueueSimpleBytesIn",
+	"1.3.6.1.4.1.14988.1.1.2.1.1.9":         "MIKROTIK-MIB::mtxrQueueSimpleBytesOut",
+	"1.3.6.1.4.1.14988.1.1.2.2":             "MIKROTIK-MIB::mtxrQueueTreeTable",
+	"1.3.6.1.4.1.14988.1.1.2.2.1":           "MIKROTIK-MIB::mtxrQueueTreeEntry",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.1":         "MIKROTIK-MIB::mtxrQueueTreeIndex",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.2":         "MIKROTIK-MIB::mtxrQueueTreeName",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.3":         "MIKROTIK-MIB::mtxrQueueTreeFlow",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.4":         "MIKROTIK-MIB::mtxrQueueTreeParentIndex",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.5":         "MIKROTIK-MIB::mtxrQueueTreeBytes",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.6":         "MIKROTIK-MIB::mtxrQueueTreePackets",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.7":         "MIKROTIK-MIB::mtxrQueueTreeHCBytes",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.8":         "MIKROTIK-MIB::mtxrQueueTreePCQQueues",
+	"1.3.6.1.4.1.14988.1.1.2.2.1.9":         "MIKROTIK-MIB::mtxrQueueTreeDropped",
+	"1.3.6.1.4.1.14988.1.1.20":              "MIKROTIK-MIB::mtxrIPSec",
+	"1.3.6.1.4.1.14988.1.1.20.1":            "MIKROTIK-MIB::mtxrIkeSACount",
+	"1.3.6.1.4.1.14988.1.1.20.2":            "MIKROTIK-MIB::mtxrIkeSATable",
+	"1.3.6.1.4.1.14988.1.1.20.2.1":          "MIKROTIK-MIB::mtxrIkeSATableEntry",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.1":        "MIKROTIK-MIB::mtxrIkeSAIndex",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.10":       "MIKROTIK-MIB::mtxrIkeSAIdentity",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.11":       "MIKROTIK-MIB::mtxrIkeSAPh2Count",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.12":       "MIKROTIK-MIB::mtxrIkeSALocalAddressType",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.13":       "MIKROTIK-MIB::mtxrIkeSALocalAddress",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.14":       "MIKROTIK-MIB::mtxrIkeSALocalPort",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.15":       "MIKROTIK-MIB::mtxrIkeSAPeerAddressType",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.16":       "MIKROTIK-MIB::mtxrIkeSAPeerAddress",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.17":       "MIKROTIK-MIB::mtxrIkeSAPeerPort",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.18":       "MIKROTIK-MIB::mtxrIkeSADynamicAddressType",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.19":       "MIKROTIK-MIB::mtxrIkeSADynamicAddress",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.2":        "MIKROTIK-MIB::mtxrIkeSAInitiatorCookie",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.20":       "MIKROTIK-MIB::mtxrIkeSATxBytes",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.21":       "MIKROTIK-MIB::mtxrIkeSARxBytes",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.22":       "MIKROTIK-MIB::mtxrIkeSATxPackets",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.23":       "MIKROTIK-MIB::mtxrIkeSARxPackets",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.3":        "MIKROTIK-MIB::mtxrIkeSAResponderCookie",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.4":        "MIKROTIK-MIB::mtxrIkeSAResponder",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.5":        "MIKROTIK-MIB::mtxrIkeSANatt",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.6":        "MIKROTIK-MIB::mtxrIkeSAVersion",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.7":        "MIKROTIK-MIB::mtxrIkeSAState",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.8":        "MIKROTIK-MIB::mtxrIkeSAUptime",
+	"1.3.6.1.4.1.14988.1.1.20.2.1.9":        "MIKROTIK-MIB::mtxrIkeSASeen",
+	"1.3.6.1.4.1.14988.1.1.3":               "MIKROTIK-MIB::mtxrHealth",
+	"1.3.6.1.4.1.14988.1.1.3.1":             "MIKROTIK-MIB::mtxrHlCoreVoltage",
+	"1.3.6.1.4.1.14988.1.1.3.10":            "MIKROTIK-MIB::mtxrHlTemperature",
+	"1.3.6.1.4.1.14988.1.1.3.100":           "MIKROTIK-MIB::mtxrGaugeTable",
+	"1.3.6.1.4.1.14988.1.1.3.100.1":         "MIKROTIK-MIB::mtxrGaugeTableEntry",
+	"1.3.6.1.4.1.14988.1.1.3.100.1.1":       "MIKROTIK-MIB::mtxrGaugeIndex",
+	"1.3.6.1.4.1.14988.1.1.3.100.1.2":       "MIKROTIK-MIB::mtxrGaugeName",
+	"1.3.6.1.4.1.14988.1.1.3.100.1.3":       "MIKROTIK-MIB::mtxrGaugeValue",
+	"1.3.6.1.4.1.14988.1.1.3.100.1.4":       "MIKROTIK-MIB::mtxrGaugeUnit",
+	"1.3.6.1.4.1.14988.1.1.3.11":            "MIKROTIK-MIB::mtxrHlProcessorTemperature",
+	"1.3.6.1.4.1.14988.1.1.3.12":            "MIKROTIK-MIB::mtxrHlPower",
+	"1.3.6.1.4.1.14988.1.1.3.13":            "MIKROTIK-MIB::mtxrHlCurrent",
+	"1.3.6.1.4.1.14988.1.1.3.14":            "MIKROTIK-MIB::mtxrHlProcessorFrequency",
+	"1.3.6.1.4.1.14988.1.1.3.15":            "MIKROTIK-MIB::mtxrHlPowerSupplyState",
+	"1.3.6.1.4.1.14988.1.1.3.16":            "MIKROTIK-MIB::mtxrHlBackupPowerSupplyState",
+	"1.3.6.1.4.1.14988.1.1.3.17":            "MIKROTIK-MIB::mtxrHlFanSpeed1",
+	"1.3.6.1.4.1.14988.1.1.3.18":            "MIKROTIK-MIB::mtxrHlFanSpeed2",
+	"1.3.6.1.4.1.14988.1.1.3.2":             "MIKROTIK-MIB::mtxrHlThreeDotThreeVoltage",
+	"1.3.6.1.4.1.14988.1.1.3.3":             "MIKROTIK-MIB::mtxrHlFiveVoltage",
+	"1.3.6.1.4.1.14988.1.1.3.4":             "MIKROTIK-MIB::mtxrHlTwelveVoltage",
+	"1.3.6.1.4.1.14988.1.1.3.5":             "MIKROTIK-MIB::mtxrHlSensorTemperature",
+	"1.3.6.1.4.1.14988.1.1.3.6":             "MIKROTIK-MIB::mtxrHlCpuTemperature",
+	"1.3.6.1.4.1.14988.1.1.3.7":             "MIKROTIK-MIB::mtxrHlBoardTemperature",
+	"1.3.6.1.4.1.14988.1.1.3.8":             "MIKROTIK-MIB::mtxrHlVoltage",
+	"1.3.6.1.4.1.14988.1.1.3.9":             "MIKROTIK-MIB::mtxrHlActiveFan",
+	"1.3.6.1.4.1.14988.1.1.4":               "MIKROTIK-MIB::mtxrLicense",
+	"1.3.6.1.4.1.14988.1.1.4.1":             "MIKROTIK-MIB::mtxrLicSoftwareId",
+	"1.3.6.1.4.1.14988.1.1.4.2":             "MIKROTIK-MIB::mtxrLicUpgrUntil",
+	"1.3.6.1.4.1.14988.1.1.4.3":             "MIKROTIK-MIB::mtxrLicLevel",
+	"1.3.6.1.4.1.14988.1.1.4.4":             "MIKROTIK-MIB::mtxrLicVersion",
+	"1.3.6.1.4.1.14988.1.1.4.5":             "MIKROTIK-MIB::mtxrLicUpgradableTo",
+	"1.3.6.1.4.1.14988.1.1.5":               "MIKROTIK-MIB::mtxrHotspot",
+	"1.3.6.1.4.1.14988.1.1.5.1":             "MIKROTIK-MIB::mtxrHotspotActiveUsersTable",
+	"1.3.6.1.4.1.14988.1.1.5.1.1":           "MIKROTIK-MIB::mtxrHotspotActiveUsersTableEntry",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.1":         "MIKROTIK-MIB::mtxrHotspotActiveUserIndex",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.10":        "MIKROTIK-MIB::mtxrHotspotActiveUserIdleTimeout",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.11":        "MIKROTIK-MIB::mtxrHotspotActiveUserPingTimeout",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.12":        "MIKROTIK-MIB::mtxrHotspotActiveUserBytesIn",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.13":        "MIKROTIK-MIB::mtxrHotspotActiveUserBytesOut",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.14":        "MIKROTIK-MIB::mtxrHotspotActiveUserPacketsIn",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.15":        "MIKROTIK-MIB::mtxrHotspotActiveUserPacketsOut",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.16":        "MIKROTIK-MIB::mtxrHotspotActiveUserLimitBytesIn",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.17":        "MIKROTIK-MIB::mtxrHotspotActiveUserLimitBytesOut",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.18":        "MIKROTIK-MIB::mtxrHotspotActiveUserAdvertStatus",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.19":        "MIKROTIK-MIB::mtxrHotspotActiveUserRadius",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.2":         "MIKROTIK-MIB::mtxrHotspotActiveUserServerID",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.20":        "MIKROTIK-MIB::mtxrHotspotActiveUserBlockedByAdvert",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.3":         "MIKROTIK-MIB::mtxrHotspotActiveUserName",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.4":         "MIKROTIK-MIB::mtxrHotspotActiveUserDomain",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.5":         "MIKROTIK-MIB::mtxrHotspotActiveUserIP",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.6":         "MIKROTIK-MIB::mtxrHotspotActiveUserMAC",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.7":         "MIKROTIK-MIB::mtxrHotspotActiveUserConnectTime",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.8":         "MIKROTIK-MIB::mtxrHotspotActiveUserValidTillTime",
+	"1.3.6.1.4.1.14988.1.1.5.1.1.9":         "MIKROTIK-MIB::mtxrHotspotActiveUserIdleStartTime",
+	"1.3.6.1.4.1.14988.1.1.6":               "MIKROTIK-MIB::mtxrDHCP",
+	"1.3.6.1.4.1.14988.1.1.6.1":             "MIKROTIK-MIB::mtxrDHCPLeaseCount",
+	"1.3.6.1.4.1.14988.1.1.7":               "MIKROTIK-MIB::mtxrSystem",
+	"1.3.6.1.4.1.14988.1.1.7.1":             "MIKROTIK-MIB::mtxrSystemReboot",
+	"1.3.6.1.4.1.14988.1.1.7.2":             "MIKROTIK-MIB::mtxrUSBPowerReset",
+	"1.3.6.1.4.1.14988.1.1.7.3":             "MIKROTIK-MIB::mtxrSerialNumber",
+	"1.3.6.1.4.1.14988.1.1.7.4":             "MIKROTIK-MIB::mtxrFirmwareVersion",
+	"1.3.6.1.4.1.14988.1.1.7.5":             "MIKROTIK-MIB::mtxrNote",
+	"1.3.6.1.4.1.14988.1.1.7.6":             "MIKROTIK-MIB::mtxrBuildTime",
+	"1.3.6.1.4.1.14988.1.1.7.7":             "MIKROTIK-MIB::mtxrFirmwareUpgradeVersion",
+	"1.3.6.1.4.1.14988.1.1.7.8":             "MIKROTIK-MIB::mtxrBoardName",
+	"1.3.6.1.4.1.14988.1.1.8":               "MIKROTIK-MIB::mtxrScripts",
+	"1.3.6.1.4.1.14988.1.1.8.1":             "MIKROTIK-MIB::mtxrScriptTable",
+	"1.3.6.1.4.1.14988.1.1.8.1.1":           "MIKROTIK-MIB::mtxrScriptTableEntry",
+	"1.3.6.1.4.1.14988.1.1.8.1.1.1":         "MIKROTIK-MIB::mtxrScriptIndex",
+	"1.3.6.1.4.1.14988.1.1.8.1.1.2":         "MIKROTIK-MIB::mtxrScriptName",
+	"1.3.6.1.4.1.14988.1.1.8.1.1.3":         "MIKROTIK-MIB::mtxrScriptRunCmd",
+	"1.3.6.1.4.1.14988.1.1.9":               "MIKROTIK-MIB::mtxrTraps",
+	"1.3.6.1.4.1.14988.1.1.9.0":             "MIKROTIK-MIB::mtxrNotifications",
+	"1.3.6.1.4.1.14988.1.1.9.0.1":           "MIKROTIK-MIB::mtxrTrap",
+	"1.3.6.1.4.1.14988.1.1.9.0.2":           "MIKROTIK-MIB::mtxrTemperatureException",
+	"1.3.6.1.4.1.14988.1.2":                 "MIKROTIK-MIB::mtXMetaInfo",
+	"1.3.6.1.4.1.14988.1.2.1":               "MIKROTIK-MIB::mtXRouterOsGroups",
+	"1.3.6.1.4.1.2011.5.25.31":              "HUAWEI-ENTITY-EXTENT-MIB::hwEntityExtentMIB",
+	"1.3.6.1.4.1.2011.5.25.31.1":            "HUAWEI-ENTITY-EXTENT-MIB::hwEntityExtentObjects",
+	"1.3.6.1.4.1.2011.5.25.31.1.1":          "HUAWEI-ENTITY-EXTENT-MIB::hwEntityState",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1":        "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStateTable",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1":      "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStateEntry",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.1":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityAdminStatus",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.10":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityUpTime",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.11":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityTemperature",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.12":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityTemperatureThreshold",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.13":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityVoltage",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.2":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOperStatus",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.3":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityStandbyStatus",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.4":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityAlarmLight",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.5":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityCpuUsage",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.6":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityCpuUsageThreshold",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.7":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemUsage",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.8":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemUsageThreshold",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.1.1.9":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityMemSize",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10":       "HUAWEI-ENTITY-EXTENT-MIB::hwFanStatusTable",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1":     "HUAWEI-ENTITY-EXTENT-MIB::hwFanStatusEntry",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.1":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSlot",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.2":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSn",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.3":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanReg",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.4":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSpdAdjMode",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.5":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanSpeed",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.6":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanPresent",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.10.1.7":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityFanState",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18":       "HUAWEI-ENTITY-EXTENT-MIB::hwPwrStatusTable",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1":     "HUAWEI-ENTITY-EXTENT-MIB::hwPwrStatusEntry",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.1":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrSlot",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.2":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrSn",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.3":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrReg",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.4":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrMode",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.5":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrPresent",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.18.1.6":   "HUAWEI-ENTITY-EXTENT-MIB::hwEntityPwrState",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3":        "HUAWEI-ENTITY-EXTENT-MIB::hwOpticalModuleInfoTable",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1":      "HUAWEI-ENTITY-EXTENT-MIB::hwOpticalModuleInfoEntry",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.1":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalMode",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.2":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalWaveLength",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.3":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTransferDistance",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.4":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalVendorSn",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.5":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTemperature",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.6":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalVoltage",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.7":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalBiasCurrent",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.8":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalRxPower",
+	"1.3.6.1.4.1.2011.5.25.31.1.1.3.1.9":    "HUAWEI-ENTITY-EXTENT-MIB::hwEntityOpticalTxPower",
+	"1.3.6.1.4.1.2021":                      "UCD-SNMP-MIB::ucdavis",
+	"1.3.6.1.4.1.2021.10":                   "UCD-SNMP-MIB::laTable",
+	"1.3.6.1.4.1.2021.10.1":                 "UCD-SNMP-MIB::laEntry",
+	"1.3.6.1.4.1.2021.10.1.1":               "UCD-SNMP-MIB::laIndex",
+	"1.3.6.1.4.1.2021.10.1.100":             "UCD-SNMP-MIB::laErrorFlag",
+	"1.3.6.1.4.1.2021.10.1.101":             "UCD-SNMP-MIB::laErrMessage",
+	"1.3.6.1.4.1.2021.10.1.2":               "UCD-SNMP-MIB::laNames",
+	"1.3.6.1.4.1.2021.10.1.3":               "UCD-SNMP-MIB::laLoad",
+	"1.3.6.1.4.1.2021.10.1.4":               "UCD-SNMP-MIB::laConfig",
+	"1.3.6.1.4.1.2021.10.1.5":               "UCD-SNMP-MIB::laLoadInt",
+	"1.3.6.1.4.1.2021.10.1.6":               "UCD-SNMP-MIB::laLoadFloat",
+	"1.3.6.1.4.1.2021.100":                  "UCD-SNMP-MIB::version",
+	"1.3.6.1.4.1.2021.100.1":                "UCD-SNMP-MIB::versionIndex",
+	"1.3.6.1.4.1.2021.100.10":               "UCD-SNMP-MIB::versionClearCache",
+	"1.3.6.1.4.1.2021.100.11":               "UCD-SNMP-MIB::versionUpdateConfig",
+	"1.3.6.1.4.1.2021.100.12":               "UCD-SNMP-MIB::versionRestartAgent",
+	"1.3.6.1.4.1.2021.100.13":               "UCD-SNMP-MIB::versionSavePersistentData",
+	"1.3.6.1.4.1.2021.100.2":                "UCD-SNMP-MIB::versionTag",
+	"1.3.6.1.4.1.2021.100.20":               "UCD-SNMP-MIB::versionDoDebugging",
+	"1.3.6.1.4.1.2021.100.3":                "UCD-SNMP-MIB::versionDate",
+	"1.3.6.1.4.1.2021.100.4":                "UCD-SNMP-MIB::versionCDate",
+	"1.3.6.1.4.1.2021.100.5":                "UCD-SNMP-MIB::versionIdent",
+	"1.3.6.1.4.1.2021.100.6":                "UCD-SNMP-MIB::versionConfigureOptions",
+	"1.3.6.1.4.1.2021.101":                  "UCD-SNMP-MIB::snmperrs",
+	"1.3.6.1.4.1.2021.101.1":                "UCD-SNMP-MIB::snmperrIndex",
+	"1.3.6.1.4.1.2021.101.100":              "UCD-SNMP-MIB::snmperrErrorFlag",
+	"1.3.6.1.4.1.2021.101.101":              "UCD-SNMP-MIB::snmperrErrMessage",
+	"1.3.6.1.4.1.2021.101.2":                "UCD-SNMP-MIB::snmperrNames",
+	"1.3.6.1.4.1.2021.102":                  "UCD-SNMP-MIB::mrTable",
+	"1.3.6.1.4.1.2021.102.1":                "UCD-SNMP-MIB::mrEntry",
+	"1.3.6.1.4.1.2021.102.1.1":              "UCD-SNMP-MIB::mrIndex",
+	"1.3.6.1.4.1.2021.102.1.2":              "UCD-SNMP-MIB::mrModuleName",
+	"1.3.6.1.4.1.2021.11":                   "UCD-SNMP-MIB::systemStats",
+	"1.3.6.1.4.1.2021.11.1":                 "UCD-SNMP-MIB::ssIndex",
+	"1.3.6.1.4.1.2021.11.10":                "UCD-SNMP-MIB::ssCpuSystem",
+	"1.3.6.1.4.1.2021.11.11":                "UCD-SNMP-MIB::ssCpuIdle",
+	"1.3.6.1.4.1.2021.11.2":                 "UCD-SNMP-MIB::ssErrorName",
+	"1.3.6.1.4.1.2021.11.3":                 "UCD-SNMP-MIB::ssSwapIn",
+	"1.3.6.1.4.1.2021.11.4":                 "UCD-SNMP-MIB::ssSwapOut",
+	"1.3.6.1.4.1.2021.11.5":                 "UCD-SNMP-MIB::ssIOSent",
+	"1.3.6.1.4.1.2021.11.50":                "UCD-SNMP-MIB::ssCpuRawUser",
+	"1.3.6.1.4.1.2021.11.51":                "UCD-SNMP-MIB::ssCpuRawNice",
+	"1.3.6.1.4.1.2021.11.52":                "UCD-SNMP-MIB::ssCpuRawSystem",
+	"1.3.6.1.4.1.2021.11.53":                "UCD-SNMP-MIB::ssCpuRawIdle",
+	"1.3.6.1.4.1.2021.11.54":                "UCD-SNMP-MIB::ssCpuRawWait",
+	"1.3.6.1.4.1.2021.11.55":                "UCD-SNMP-MIB::ssCpuRawKernel",
+	"1.3.6.1.4.1.2021.11.56":                "UCD-SNMP-MIB::ssCpuRawInterrupt",
+	"1.3.6.1.4.1.2021.11.57":                "UCD-SNMP-MIB::ssIORawSent",
+	"1.3.6.1.4.1.2021.11.58":                "UCD-SNMP-MIB::ssIORawReceived",
+	"1.3.6.1.4.1.2021.11.59":                "UCD-SNMP-MIB::ssRawInterrupts",
+	"1.3.6.1.4.1.2021.11.6":                 "UCD-SNMP-MIB::ssIOReceive",
+	"1.3.6.1.4.1.2021.11.60":                "UCD-SNMP-MIB::ssRawContexts",
+	"1.3.6.1.4.1.2021.11.61":                "UCD-SNMP-MIB::ssCpuRawSoftIRQ",
+	"1.3.6.1.4.1.2021.11.62":                "UCD-SNMP-MIB::ssRawSwapIn",
+	"1.3.6.1.4.1.2021.11.63":                "UCD-SNMP-MIB::ssRawSwapOut",
+	"1.3.6.1.4.1.2021.11.7":                 "UCD-SNMP-MIB::ssSysInterrupts",
+	"1.3.6.1.4.1.2021.11.8":                 "UCD-SNMP-MIB::ssSysContext",
+	"1.3.6.1.4.1.2021.11.9":                 "UCD-SNMP-MIB::ssCpuUser",
+	"1.3.6.1.4.1.2021.12":                   "UCD-SNMP-MIB::ucdInternal",
+	"1.3.6.1.4.1.2021.13":                   "UCD-SNMP-MIB::ucdExperimental",
+	"1.3.6.1.4.1.2021.15":                   "UCD-SNMP-MIB::fileTable",
+	"1.3.6.1.4.1.2021.15.1":                 "UCD-SNMP-MIB::fileEntry",
+	"1.3.6.1.4.1.2021.15.1.1":               "UCD-SNMP-MIB::fileIndex",
+	"1.3.6.1.4.1.2021.15.1.100":             "UCD-SNMP-MIB::fileErrorFlag",
+	"1.3.6.1.4.1.2021.15.1.101":             "UCD-SNMP-MIB::fileErrorMsg",
+	"1.3.6.1.4.1.2021.15.1.2":               "UCD-SNMP-MIB::fileName",
+	"1.3.6.1.4.1.2021.15.1.3":               "UCD-SNMP-MIB::fileSize",
+	"1.3.6.1.4.1.2021.15.1.4":               "UCD-SNMP-MIB::fileMax",
+	"1.3.6.1.4.1.2021.16":                   "UCD-SNMP-MIB::logMatch",
+	"1.3.6.1.4.1.2021.16.1":                 "UCD-SNMP-MIB::logMatchMaxEntries",
+	"1.3.6.1.4.1.2021.16.2":                 "UCD-SNMP-MIB::logMatchTable",
+	"1.3.6.1.4.1.2021.16.2.1":               "UCD-SNMP-MIB::logMatchEntry",
+	"1.3.6.1.4.1.2021.16.2.1.1":             "UCD-SNMP-MIB::logMatchIndex",
+	"1.3.6.1.4.1.2021.16.2.1.10":            "UCD-SNMP-MIB::logMatchCount",
+	"1.3.6.1.4.1.2021.16.2.1.100":           "UCD-SNMP-MIB::logMatchErrorFlag",
+	"1.3.6.1.4.1.2021.16.2.1.101":           "UCD-SNMP-MIB::logMatchRegExCompilation",
+	"1.3.6.1.4.1.2021.16.2.1.11":            "UCD-SNMP-MIB::logMatchCycle",
+	"1.3.6.1.4.1.2021.16.2.1.2":             "UCD-SNMP-MIB::logMatchName",
+	"1.3.6.1.4.1.2021.16.2.1.3":             "UCD-SNMP-MIB::logMatchFilename",
+	"1.3.6.1.4.1.2021.16.2.1.4":             "UCD-SNMP-MIB::logMatchRegEx",
+	"1.3.6.1.4.1.2021.16.2.1.5":             "UCD-SNMP-MIB::logMatchGlobalCounter",
+	"1.3.6.1.4.1.2021.16.2.1.6":             "UCD-SNMP-MIB::logMatchGlobalCount",
+	"1.3.6.1.4.1.2021.16.2.1.7":             "UCD-SNMP-MIB::logMatchCurrentCounter",
+	"1.3.6.1.4.1.2021.16.2.1.8":             "UCD-SNMP-MIB::logMatchCurrentCount",
+	"1.3.6.1.4.1.2021.16.2.1.9":             "UCD-SNMP-MIB::logMatchCounter",
+	"1.3.6.1.4.1.2021.2":                    "UCD-SNMP-MIB::prTable",
+	"1.3.6.1.4.1.2021.2.1":                  "UCD-SNMP-MIB::prEntry",
+	"1.3.6.1.4.1.2021.2.1.1":                "UCD-SNMP-MIB::prIndex",
+	"1.3.6.1.4.1.2021.2.1.100":              "UCD-SNMP-MIB::prErrorFlag",
+	"1.3.6.1.4.1.2021.2.1.101":              "UCD-SNMP-MIB::prErrMessage",
+	"1.3.6.1.4.1.2021.2.1.102":              "UCD-SNMP-MIB::prErrFix",
+	"1.3.6.1.4.1.2021.2.1.103":              "UCD-SNMP-MIB::prErrFixCmd",
+	"1.3.6.1.4.1.2021.2.1.2":                "UCD-SNMP-MIB::prNames",
+	"1.3.6.1.4.1.2021.2.1.3":                "UCD-SNMP-MIB::prMin",
+	"1.3.6.1.4.1.2021.2.1.4":                "UCD-SNMP-MIB::prMax",
+	"1.3.6.1.4.1.2021.2.1.5":                "UCD-SNMP-MIB::prCount",
+	"1.3.6.1.4.1.2021.250":                  "UCD-SNMP-MIB::ucdSnmpAgent",
+	"1.3.6.1.4.1.2021.250.1":                "UCD-SNMP-MIB::hpux9",
+	"1.3.6.1.4.1.2021.250.10":               "UCD-SNMP-MIB::linux",
+	"1.3.6.1.4.1.2021.250.11":               "UCD-SNMP-MIB::bsdi",
+	"1.3.6.1.4.1.2021.250.12":               "UCD-SNMP-MIB::openbsd",
+	"1.3.6.1.4.1.2021.250.13":               "UCD-SNMP-MIB::win32",
+	"1.3.6.1.4.1.2021.250.14":               "UCD-SNMP-MIB::hpux11",
+	"1.3.6.1.4.1.2021.250.15":               "UCD-SNMP-MIB::aix",
+	"1.3.6.1.4.1.2021.250.16":               "UCD-SNMP-MIB::macosx",
+	"1.3.6.1.4.1.2021.250.17":               "UCD-SNMP-MIB::dragonfly",
+	"1.3.6.1.4.1.2021.250.2":                "UCD-SNMP-MIB::sunos4",
+	"1.3.6.1.4.1.2021.250.255":              "UCD-SNMP-MIB::unknown",
+	"1.3.6.1.4.1.2021.250.3":                "UCD-SNMP-MIB::solaris",
+	"1.3.6.1.4.1.2021.250.4":                "UCD-SNMP-MIB::osf",
+	"1.3.6.1.4.1.2021.250.5":                "UCD-SNMP-MIB::ultrix",
+	"1.3.6.1.4.1.2021.250.6":                "UCD-SNMP-MIB::hpux10",
+	"1.3.6.1.4.1.2021.250.7":                "UCD-SNMP-MIB::netbsd1",
+	"1.3.6.1.4.1.2021.250.8":                "UCD-SNMP-MIB::freebsd",
+	"1.3.6.1.4.1.2021.250.9":                "UCD-SNMP-MIB::irix",
+	"1.3.6.1.4.1.2021.251":                  "UCD-SNMP-MIB::ucdTraps",
+	"1.3.6.1.4.1.2021.251.1":                "UCD-SNMP-MIB::ucdStart",
+	"1.3.6.1.4.1.2021.251.2":                "UCD-SNMP-MIB::ucdShutdown",
+	"1.3.6.1.4.1.2021.4":                    "UCD-SNMP-MIB::memory",
+	"1.3.6.1.4.1.2021.4.1":                  "UCD-SNMP-MIB::memIndex",
+	"1.3.6.1.4.1.2021.4.10":                 "UCD-SNMP-MIB::memAvailRealTXT",
+	"1.3.6.1.4.1.2021.4.100":                "UCD-SNMP-MIB::memSwapError",
+	"1.3.6.1.4.1.2021.4.101":                "UCD-SNMP-MIB::memSwapErrorMsg",
+	"1.3.6.1.4.1.2021.4.11":                 "UCD-SNMP-MIB::memTotalFree",
+	"1.3.6.1.4.1.2021.4.12":                 "UCD-SNMP-MIB::memMinimumSwap",
+	"1.3.6.1.4.1.2021.4.13":                 "UCD-SNMP-MIB::memShared",
+	"1.3.6.1.4.1.2021.4.14":                 "UCD-SNMP-MIB::memBuffer",
+	"1.3.6.1.4.1.2021.4.15":                 "UCD-SNMP-MIB::memCached",
+	"1.3.6.1.4.1.2021.4.16":                 "UCD-SNMP-MIB::memUsedSwapTXT",
+	"1.3.6.1.4.1.2021.4.17":                 "UCD-SNMP-MIB::memUsedRealTXT",
+	"1.3.6.1.4.1.2021.4.2":                  "UCD-SNMP-MIB::memErrorName",
+	"1.3.6.1.4.1.2021.4.3":                  "UCD-SNMP-MIB::memTotalSwap",
+	"1.3.6.1.4.1.2021.4.4":                  "UCD-SNMP-MIB::memAvailSwap",
+	"1.3.6.1.4.1.2021.4.5":                  "UCD-SNMP-MIB::memTotalReal",
+	"1.3.6.1.4.1.2021.4.6":                  "UCD-SNMP-MIB::memAvailReal",
+	"1.3.6.1.4.1.2021.4.7":                  "UCD-SNMP-MIB::memTotalSwapTXT",
+	"1.3.6.1.4.1.2021.4.8":                  "UCD-SNMP-MIB::memAvailSwapTXT",
+	"1.3.6.1.4.1.2021.4.9":                  "UCD-SNMP-MIB::memTotalRealTXT",
+	"1.3.6.1.4.1.2021.8":                    "UCD-SNMP-MIB::extTable",
+	"1.3.6.1.4.1.2021.8.1":                  "UCD-SNMP-MIB::extEntry",
+	"1.3.6.1.4.1.2021.8.1.1":                "UCD-SNMP-MIB::extIndex",
+	"1.3.6.1.4.1.2021.8.1.100":              "UCD-SNMP-MIB::extResult",
+	"1.3.6.1.4.1.2021.8.1.101":              "UCD-SNMP-MIB::extOutput",
+	"1.3.6.1.4.1.2021.8.1.102":              "UCD-SNMP-MIB::extErrFix",
+	"1.3.6.1.4.1.2021.8.1.103":              "UCD-SNMP-MIB::extErrFixCmd",
+	"1.3.6.1.4.1.2021.8.1.2":                "UCD-SNMP-MIB::extNames",
+	"1.3.6.1.4.1.2021.8.1.3":                "UCD-SNMP-MIB::extCommand",
+	"1.3.6.1.4.1.2021.9":                    "UCD-SNMP-MIB::dskTable",
+	"1.3.6.1.4.1.2021.9.1":                  "UCD-SNMP-MIB::dskEntry",
+	"1.3.6.1.4.1.2021.9.1.1":                "UCD-SNMP-MIB::dskIndex",
+	"1.3.6.1.4.1.2021.9.1.10":               "UCD-SNMP-MIB::dskPercentNode",
+	"1.3.6.1.4.1.2021.9.1.100":              "UCD-SNMP-MIB::dskErrorFlag",
+	"1.3.6.1.4.1.2021.9.1.101":              "UCD-SNMP-MIB::dskErrorMsg",
+	"1.3.6.1.4.1.2021.9.1.11":               "UCD-SNMP-MIB::dskTotalLow",
+	"1.3.6.1.4.1.2021.9.1.12":               "UCD-SNMP-MIB::dskTotalHigh",
+	"1.3.6.1.4.1.2021.9.1.13":               "UCD-SNMP-MIB::dskAvailLow",
+	"1.3.6.1.4.1.2021.9.1.14":               "UCD-SNMP-MIB::dskAvailHigh",
+	"1.3.6.1.4.1.2021.9.1.15":               "UCD-SNMP-MIB::dskUsedLow",
+	"1.3.6.1.4.1.2021.9.1.16":               "UCD-SNMP-MIB::dskUsedHigh",
+	"1.3.6.1.4.1.2021.9.1.2":                "UCD-SNMP-MIB::dskPath",
+	"1.3.6.1.4.1.2021.9.1.3":                "UCD-SNMP-MIB::dskDevice",
+	"1.3.6.1.4.1.2021.9.1.4":                "UCD-SNMP-MIB::dskMinimum",
+	"1.3.6.1.4.1.2021.9.1.5":                "UCD-SNMP-MIB::dskMinPercent",
+	"1.3.6.1.4.1.2021.9.1.6":                "UCD-SNMP-MIB::dskTotal",
+	"1.3.6.1.4.1.2021.9.1.7":                "UCD-SNMP-MIB::dskAvail",
+	"1.3.6.1.4.1.2021.9.1.8":                "UCD-SNMP-MIB::dskUsed",
+	"1.3.6.1.4.1.2021.9.1.9":                "UCD-SNMP-MIB::dskPercent",
+	"1.3.6.1.4.1.25506.2.6":                 "HH3C-ENTITY-EXT-MIB::hh3cEntityExtend",
+	"1.3.6.1.4.1.25506.2.6.1":               "HH3C-ENTITY-EXT-MIB::hh3cEntityExtObjects",
+	"1.3.6.1.4.1.25506.2.6.1.1":             "HH3C-ENTITY-EXT-MIB::hh3cEntityExtState",
+	"1.3.6.1.4.1.25506.2.6.1.1.1":           "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStateTable",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1":         "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStateEntry",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.1":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtPhysicalIndex",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.10":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemSize",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.11":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtUpTime",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.12":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtTemperature",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.13":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtTemperatureThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.14":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltage",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.15":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltageLowThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.16":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtVoltageHighThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.17":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCriticalTemperatureThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.18":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMacAddress",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.19":      "HH3C-ENTITY-EXT-MIB::hh3cEntityExtErrorStatus",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.2":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtAdminStatus",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.3":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtOperStatus",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.4":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtStandbyStatus",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.5":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtAlarmLight",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.6":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCpuUsage",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.7":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtCpuUsageThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.8":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemUsage",
+	"1.3.6.1.4.1.25506.2.6.1.1.1.1.9":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtMemUsageThreshold",
+	"1.3.6.1.4.1.25506.2.6.1.2":             "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManu",
+	"1.3.6.1.4.1.25506.2.6.1.2.1":           "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuTable",
+	"1.3.6.1.4.1.25506.2.6.1.2.1.1":         "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuEntry",
+	"1.3.6.1.4.1.25506.2.6.1.2.1.1.1":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtManuPhysicalIndex",
+	"1.3.6.1.4.1.25506.2.6.1.2.1.1.2":       "HH3C-ENTITY-EXT-MIB::hh3cEntityExtDeviceSerialNumber",
+	"1.3.6.1.4.1.25506.2.70":                "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiver",
+	"1.3.6.1.4.1.25506.2.70.1":              "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoObjects",
+	"1.3.6.1.4.1.25506.2.70.1.1":            "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoTable",
+	"1.3.6.1.4.1.25506.2.70.1.1.1":          "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverInfoEntry",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.1":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverHardwareType",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.10":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMaxTXPower",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.11":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMinTXPower",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.12":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverCurRXPower",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.13":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMaxRXPower",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.14":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverMinRXPower",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.15":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverTemperature",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.16":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverVoltage",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.17":       "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverBiasCurrent",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.2":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverType",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.3":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverWaveLength",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.4":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverVendorName",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.5":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverSerialNumber",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.6":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverFiberDiameterType",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.7":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverTransferDistance",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.8":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverDiagnostic",
+	"1.3.6.1.4.1.25506.2.70.1.1.1.9":        "HH3C-TRANSCEIVER-INFO-MIB::hh3cTransceiverCurTXPower",
+	"1.3.6.1.4.1.25506.8.35.9.1":            "HH3C-LSW-DEV-ADM-MIB::hh3cLswdevMMibObject",
+	"1.3.6.1.4.1.25506.8.35.9.1.1":          "HH3C-LSW-DEV-ADM-MIB::hh3cdevMFanStatusTable",
+	"1.3.6.1.4.1.25506.8.35.9.1.1.1":        "HH3C-LSW-DEV-ADM-MIB::hh3cdevMFanStatusEntry",
+	"1.3.6.1.4.1.25506.8.35.9.1.1.1.1":      "HH3C-LSW-DEV-ADM-MIB::hh3cDevMFanNum",
+	"1.3.6.1.4.1.25506.8.35.9.1.1.1.2":      "HH3C-LSW-DEV-ADM-MIB::hh3cDevMFanStatus",
+	"1.3.6.1.4.1.25506.8.35.9.1.2":          "HH3C-LSW-DEV-ADM-MIB::hh3cdevMPowerStatusTable",
+	"1.3.6.1.4.1.25506.8.35.9.1.2.1":        "HH3C-LSW-DEV-ADM-MIB::hh3cdevMPowerStatusEntry",
+	"1.3.6.1.4.1.25506.8.35.9.1.2.1.1":      "HH3C-LSW-DEV-ADM-MIB::hh3cDevMPowerNum",
+	"1.3.6.1.4.1.25506.8.35.9.1.2.1.2":      "HH3C-LSW-DEV-ADM-MIB::hh3cDevMPowerStatus",
+	"1.3.6.1.4.1.4881":                      "MY-SMI::my",
+	"1.3.6.1.4.1.4881.1":                    "MY-SMI::products",
+	"1.3.6.1.4.1.4881.1.1":                  "MY-SMI::switch",
+	"1.3.6.1.4.1.4881.1.1.10":               "MY-SMI::switchMib",
+	"1.3.6.1.4.1.4881.1.1.10.1":             "MY-SMI::mySwitchProducts",
+	"1.3.6.1.4.1.4881.1.1.10.1.1":           "MY-PRODUCTS-MIB::s2126G",
+	"1.3.6.1.4.1.4881.1.1.10.1.11":          "MY-PRODUCTS-MIB::s21-STACKING",
+	"1.3.6.1.4.1.4881.1.1.10.1.12":          "MY-PRODUCTS-MIB::s3550-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.13":          "MY-PRODUCTS-MIB::s3550-48",
+	"1.3.6.1.4.1.4881.1.1.10.1.15":          "MY-PRODUCTS-MIB::s3550-12SFP-GT",
+	"1.3.6.1.4.1.4881.1.1.10.1.16":          "MY-PRODUCTS-MIB::s6806",
+	"1.3.6.1.4.1.4881.1.1.10.1.17":          "MY-PRODUCTS-MIB::s6810",
+	"1.3.6.1.4.1.4881.1.1.10.1.18":          "MY-PRODUCTS-MIB::s2126S",
+	"1.3.6.1.4.1.4881.1.1.10.1.19":          "MY-PRODUCTS-MIB::s2126S-STACKING",
+	"1.3.6.1.4.1.4881.1.1.10.1.2":           "MY-PRODUCTS-MIB::s2126GL3",
+	"1.3.6.1.4.1.4881.1.1.10.1.20":          "MY-PRODUCTS-MIB::s1908PLUS",
+	"1.3.6.1.4.1.4881.1.1.10.1.21":          "MY-PRODUCTS-MIB::s1916PLUS",
+	"1.3.6.1.4.1.4881.1.1.10.1.22":          "MY-PRODUCTS-MIB::s6506",
+	"1.3.6.1.4.1.4881.1.1.10.1.23":          "MY-PRODUCTS-MIB::s2126S-08",
+	"1.3.6.1.4.1.4881.1.1.10.1.24":          "MY-PRODUCTS-MIB::s2126S-16",
+	"1.3.6.1.4.1.4881.1.1.10.1.25":          "MY-PRODUCTS-MIB::s6806E",
+	"1.3.6.1.4.1.4881.1.1.10.1.26":          "MY-PRODUCTS-MIB::s6810E",
+	"1.3.6.1.4.1.4881.1.1.10.1.27":          "MY-PRODUCTS-MIB::s2026G",
+	"1.3.6.1.4.1.4881.1.1.10.1.28":          "MY-PRODUCTS-MIB::s3750-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.29":          "MY-PRODUCTS-MIB::s3750-48",
+	"1.3.6.1.4.1.4881.1.1.10.1.3":           "MY-PRODUCTS-MIB::s2150G",
+	"1.3.6.1.4.1.4881.1.1.10.1.30":          "MY-PRODUCTS-MIB::s2126",
+	"1.3.6.1.4.1.4881.1.1.10.1.31":          "MY-PRODUCTS-MIB::s2126-STACKING",
+	"1.3.6.1.4.1.4881.1.1.10.1.32":          "MY-PRODUCTS-MIB::s2026F",
+	"1.3.6.1.4.1.4881.1.1.10.1.33":          "MY-PRODUCTS-MIB::s3760-48",
+	"1.3.6.1.4.1.4881.1.1.10.1.34":          "MY-PRODUCTS-MIB::s3760-12SFP-GT",
+	"1.3.6.1.4.1.4881.1.1.10.1.35":          "MY-PRODUCTS-MIB::s4009",
+	"1.3.6.1.4.1.4881.1.1.10.1.36":          "MY-PRODUCTS-MIB::s3526",
+	"1.3.6.1.4.1.4881.1.1.10.1.37":          "MY-PRODUCTS-MIB::s3512G",
+	"1.3.6.1.4.1.4881.1.1.10.1.38":          "MY-PRODUCTS-MIB::hcl-12GCS-L3",
+	"1.3.6.1.4.1.4881.1.1.10.1.39":          "MY-PRODUCTS-MIB::hcl-24GS-L3",
+	"1.3.6.1.4.1.4881.1.1.10.1.4":           "MY-PRODUCTS-MIB::s2150GL3",
+	"1.3.6.1.4.1.4881.1.1.10.1.40":          "MY-PRODUCTS-MIB::hcl-48TMS-2S-S",
+	"1.3.6.1.4.1.4881.1.1.10.1.41":          "MY-PRODUCTS-MIB::s5750-24GT-12SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.42":          "MY-PRODUCTS-MIB::s5750P-24GT-12SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.43":          "MY-PRODUCTS-MIB::s8606",
+	"1.3.6.1.4.1.4881.1.1.10.1.44":          "MY-PRODUCTS-MIB::s8610",
+	"1.3.6.1.4.1.4881.1.1.10.1.45":          "MY-PRODUCTS-MIB::s9610",
+	"1.3.6.1.4.1.4881.1.1.10.1.46":          "MY-PRODUCTS-MIB::s9620",
+	"1.3.6.1.4.1.4881.1.1.10.1.47":          "MY-PRODUCTS-MIB::s2924",
+	"1.3.6.1.4.1.4881.1.1.10.1.48":          "MY-PRODUCTS-MIB::s3760-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.49":          "MY-PRODUCTS-MIB::s3760-48V2",
+	"1.3.6.1.4.1.4881.1.1.10.1.5":           "MY-PRODUCTS-MIB::s4909",
+	"1.3.6.1.4.1.4881.1.1.10.1.50":          "MY-PRODUCTS-MIB::s3750E-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.51":          "MY-PRODUCTS-MIB::s3750E-48",
+	"1.3.6.1.4.1.4881.1.1.10.1.52":          "MY-PRODUCTS-MIB::s3750E-12SFP-GT",
+	"1.3.6.1.4.1.4881.1.1.10.1.53":          "MY-PRODUCTS-MIB::s5750S-24GT-12SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.54":          "MY-PRODUCTS-MIB::s2128G",
+	"1.3.6.1.4.1.4881.1.1.10.1.55":          "MY-PRODUCTS-MIB::s2927XG",
+	"1.3.6.1.4.1.4881.1.1.10.1.56":          "MY-PRODUCTS-MIB::s3512GPLUS",
+	"1.3.6.1.4.1.4881.1.1.10.1.57":          "MY-PRODUCTS-MIB::s6604",
+	"1.3.6.1.4.1.4881.1.1.10.1.58":          "MY-PRODUCTS-MIB::s6606",
+	"1.3.6.1.4.1.4881.1.1.10.1.59":          "MY-PRODUCTS-MIB::s6610",
+	"1.3.6.1.4.1.4881.1.1.10.1.6":           "MY-PRODUCTS-MIB::s3550-12G",
+	"1.3.6.1.4.1.4881.1.1.10.1.60":          "MY-PRODUCTS-MIB::s5750-24SFP-12GT",
+	"1.3.6.1.4.1.4881.1.1.10.1.61":          "MY-PRODUCTS-MIB::s5750-48GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.62":          "MY-PRODUCTS-MIB::s5750S-48GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.63":          "MY-PRODUCTS-MIB::s2328G",
+	"1.3.6.1.4.1.4881.1.1.10.1.64":          "MY-PRODUCTS-MIB::s3250-48",
+	"1.3.6.1.4.1.4881.1.1.10.1.66":          "MY-PRODUCTS-MIB::s2951XG",
+	"1.3.6.1.4.1.4881.1.1.10.1.67":          "MY-PRODUCTS-MIB::s3750-24-UB",
+	"1.3.6.1.4.1.4881.1.1.10.1.68":          "MY-PRODUCTS-MIB::s3750-48-UB",
+	"1.3.6.1.4.1.4881.1.1.10.1.69":          "MY-PRODUCTS-MIB::scg5510",
+	"1.3.6.1.4.1.4881.1.1.10.1.70":          "MY-PRODUCTS-MIB::s2052G",
+	"1.3.6.1.4.1.4881.1.1.10.1.71":          "MY-PRODUCTS-MIB::s2352G",
+	"1.3.6.1.4.1.4881.1.1.10.1.72":          "MY-PRODUCTS-MIB::s8614",
+	"1.3.6.1.4.1.4881.1.1.10.1.73":          "MY-PRODUCTS-MIB::s5650-24GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.74":          "MY-PRODUCTS-MIB::s5650-27XG",
+	"1.3.6.1.4.1.4881.1.1.10.1.75":          "MY-PRODUCTS-MIB::s5650-51XG",
+	"1.3.6.1.4.1.4881.1.1.10.1.76":          "MY-PRODUCTS-MIB::s5450-28GT",
+	"1.3.6.1.4.1.4881.1.1.10.1.77":          "MY-PRODUCTS-MIB::s3760E-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.78":          "MY-PRODUCTS-MIB::s3250P-24",
+	"1.3.6.1.4.1.4881.1.1.10.1.79":          "MY-PRODUCTS-MIB::s2928G",
+	"1.3.6.1.4.1.4881.1.1.10.1.8":           "MY-PRODUCTS-MIB::s3550-24G",
+	"1.3.6.1.4.1.4881.1.1.10.1.80":          "MY-PRODUCTS-MIB::s2952G",
+	"1.3.6.1.4.1.4881.1.1.10.1.81":          "MY-PRODUCTS-MIB::s2028G",
+	"1.3.6.1.4.1.4881.1.1.10.1.82":          "MY-PRODUCTS-MIB::s2528G",
+	"1.3.6.1.4.1.4881.1.1.10.1.83":          "MY-PRODUCTS-MIB::s2552G",
+	"1.3.6.1.4.1.4881.1.1.10.1.84":          "MY-PRODUCTS-MIB::s5750R-48GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.85":          "MY-PRODUCTS-MIB::s5750P-48GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.86":          "MY-PRODUCTS-MIB::s5750R-24GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.87":          "MY-PRODUCTS-MIB::s5750P-24GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.88":          "MY-PRODUCTS-MIB::s5750-24GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.89":          "MY-PRODUCTS-MIB::s5750S-24GT-4SFP",
+	"1.3.6.1.4.1.4881.1.1.10.1.92":          "MY-PRODUCTS-MIB::s5750-48GT-4SFP-A",
+	"1.3.6.1.4.1.4881.1.1.10.1.93":          "MY-PRODUCTS-MIB::s5750-48GT-4SFP-AP",
+	"1.3.6.1.4.1.4881.1.1.10.1.95":          "MY-PRODUCTS-MIB::nm2x-24esw",
+	"1.3.6.1.4.1.4881.1.1.10.1.96":          "MY-PRODUCTS-MIB::nm2x-16esw",
+	"1.3.6.1.4.1.4881.1.1.10.2":             "MY-SMI::myMgmt",
+	"1.3.6.1.4.1.4881.1.1.10.2.1":           "MY-SYSTEM-MIB::mySystemMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1":         "MY-SYSTEM-MIB::mySystemMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.1":       "MY-SYSTEM-MIB::mySystemHwVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.10":      "MY-SYSTEM-MIB::mySystemHwFan",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.11":      "MY-SYSTEM-MIB::mySystemOutBandTimeout",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.12":      "MY-SYSTEM-MIB::mySystemTelnetTimeout",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.2":       "MY-SYSTEM-MIB::mySystemSwVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.3":       "MY-SYSTEM-MIB::mySystemBootVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.4":       "MY-SYSTEM-MIB::mySystemSysCtrlVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.5":       "MY-SYSTEM-MIB::mySystemParametersSave",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.6":       "MY-SYSTEM-MIB::mySystemOutBandRate",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.7":       "MY-SYSTEM-MIB::mySystemReset",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.8":       "MY-SYSTEM-MIB::mySwitchLayer",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.1.9":       "MY-SYSTEM-MIB::mySystemHwPower",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.2":         "MY-SYSTEM-MIB::mySystemMIBTraps",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.2.1":       "MY-SYSTEM-MIB::mySystemHardChangeDesc",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.2.2":       "MY-SYSTEM-MIB::mySystemHardChangeDetected",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.2.3":       "MY-SYSTEM-MIB::mySystemPowerStateChange",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.2.4":       "MY-SYSTEM-MIB::mySystemFanStateChange",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.3":         "MY-SYSTEM-MIB::mySystemMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.3.1":       "MY-SYSTEM-MIB::mySystemMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.1.3.2":       "MY-SYSTEM-MIB::mySystemMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.2.10":          "MY-INTERFACE-MIB::myInterfaceMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1":        "MY-INTERFACE-MIB::myIfConfigMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1":      "MY-INTERFACE-MIB::myIfTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1":    "MY-INTERFACE-MIB::myIfEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.1":  "MY-INTERFACE-MIB::myIfIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.10": "MY-INTERFACE-MIB::myIfIpBroadcast",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.11": "MY-INTERFACE-MIB::myIfLayer",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.12": "MY-INTERFACE-MIB::myIfMode",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.13": "MY-INTERFACE-MIB::myIfCounterClear",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.14": "MY-INTERFACE-MIB::myIfEntryStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.2":  "MY-INTERFACE-MIB::myIfPortType",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.3":  "MY-INTERFACE-MIB::myIfFlowControlAdminStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.4":  "MY-INTERFACE-MIB::myIfFlowControlOperStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.5":  "MY-INTERFACE-MIB::myIfAdminSpeed",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.6":  "MY-INTERFACE-MIB::myIfAdminDuplex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.7":  "MY-INTERFACE-MIB::myIfOperSpeed",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.8":  "MY-INTERFACE-MIB::myIfOperDuplex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.1.1.9":  "MY-INTERFACE-MIB::myIfManageStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2":      "MY-INTERFACE-MIB::myIfIpTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1":    "MY-INTERFACE-MIB::myIfIpEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1.1":  "MY-INTERFACE-MIB::myIfIpIfIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1.2":  "MY-INTERFACE-MIB::myIfIpId",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1.3":  "MY-INTERFACE-MIB::myIfIp",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1.4":  "MY-INTERFACE-MIB::myIfIpMask",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.2.1.5":  "MY-INTERFACE-MIB::myIfIpEntryStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3":      "MY-INTERFACE-MIB::myIfStatusTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3.1":    "MY-INTERFACE-MIB::myIfStatusEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3.1.1":  "MY-INTERFACE-MIB::myIfStatusIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3.1.2":  "MY-INTERFACE-MIB::myIfStatusLoopBackExamine",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3.1.3":  "MY-INTERFACE-MIB::myIfErrorStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.3.1.4":  "MY-INTERFACE-MIB::myIfLineDetect",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.4":      "MY-INTERFACE-MIB::myGlobalIfDisableRecovery",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.5":      "MY-INTERFACE-MIB::myPortTypeChooseTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.5.1":    "MY-INTERFACE-MIB::myPortTypeChooseEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.5.1.1":  "MY-INTERFACE-MIB::myPortTypeChooseIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.5.1.2":  "MY-INTERFACE-MIB::myPortTypeChooseType",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.6":      "MY-INTERFACE-MIB::myIfMTUTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.6.1":    "MY-INTERFACE-MIB::myIfMTUEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.6.1.1":  "MY-INTERFACE-MIB::myIfMTUIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.1.6.1.2":  "MY-INTERFACE-MIB::myIfMTU",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.2":        "MY-INTERFACE-MIB::myInterfaceTraps",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.2.1":      "MY-INTERFACE-MIB::lineDetectStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.2.2":      "MY-INTERFACE-MIB::lineDetectPosition",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.2.3":      "MY-INTERFACE-MIB::lineQualityDetect",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.3":        "MY-INTERFACE-MIB::myInterfaceMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.3.1":      "MY-INTERFACE-MIB::myInterfaceMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.10.3.2":      "MY-INTERFACE-MIB::myInterfaceMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.2.11":          "MY-FILE-MIB::myFileMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1":        "MY-FILE-MIB::myFileMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1":      "MY-FILE-MIB::myFileTransTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1":    "MY-FILE-MIB::myFileTransEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.1":  "MY-FILE-MIB::myFileTransIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.10": "MY-FILE-MIB::myFileTransEntryStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.2":  "MY-FILE-MIB::myFileTransMeans",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.3":  "MY-FILE-MIB::myFileTransOperType",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.4":  "MY-FILE-MIB::myFileTransSrcFileName",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.5":  "MY-FILE-MIB::myFileTransDescFileName",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.6":  "MY-FILE-MIB::myFileTransServerAddr",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.7":  "MY-FILE-MIB::myFileTransResult",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.8":  "MY-FILE-MIB::myFileTransComplete",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.1.1.9":  "MY-FILE-MIB::myFileTransDataLength",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.2":      "MY-FILE-MIB::myFileSystemMaxRoom",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.1.3":      "MY-FILE-MIB::myFileSystemAvailableRoom",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.2":        "MY-FILE-MIB::myFileMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.2.1":      "MY-FILE-MIB::myFileMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.11.2.2":      "MY-FILE-MIB::myFileMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.2.21":          "MY-ENTITY-MIB::myEntityMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1":        "MY-ENTITY-MIB::myDeviceMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.1":      "MY-ENTITY-MIB::myDeviceMaxNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2":      "MY-ENTITY-MIB::myDeviceInfoTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1":    "MY-ENTITY-MIB::myDeviceInfoEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.1":  "MY-ENTITY-MIB::myDeviceInfoIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.2":  "MY-ENTITY-MIB::myDeviceInfoDescr",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.3":  "MY-ENTITY-MIB::myDeviceInfoSlotNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.4":  "MY-ENTITY-MIB::myDevicePowerStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.5":  "MY-ENTITY-MIB::myDeviceMacAddress",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.6":  "MY-ENTITY-MIB::myDevicePriority",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.7":  "MY-ENTITY-MIB::myDeviceAlias",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.8":  "MY-ENTITY-MIB::myDeviceSWVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.2.1.9":  "MY-ENTITY-MIB::myDeviceHWVersion",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3":      "MY-ENTITY-MIB::mySlotInfoTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1":    "MY-ENTITY-MIB::mySlotInfoEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.1":  "MY-ENTITY-MIB::mySlotInfoDeviceIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.2":  "MY-ENTITY-MIB::mySlotInfoIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.3":  "MY-ENTITY-MIB::mySlotModuleInfoDescr",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.4":  "MY-ENTITY-MIB::mySlotInfoPortNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.5":  "MY-ENTITY-MIB::mySlotInfoPortMaxNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.3.1.6":  "MY-ENTITY-MIB::mySlotInfoDesc",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.4":      "MY-ENTITY-MIB::myModuleTempStateTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.4.1":    "MY-ENTITY-MIB::myModuleTempStateEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.4.1.1":  "MY-ENTITY-MIB::myModuleTempStateDeviceIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.4.1.2":  "MY-ENTITY-MIB::myModuleTempStateIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.4.1.3":  "MY-ENTITY-MIB::myModuleTempState",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.5":      "MY-ENTITY-MIB::myPowerStateTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.5.1":    "MY-ENTITY-MIB::myPowerStateEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.5.1.1":  "MY-ENTITY-MIB::myPowerStateDeviceIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.5.1.2":  "MY-ENTITY-MIB::myPowerStateIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.5.1.3":  "MY-ENTITY-MIB::myPowerState",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.6":      "MY-ENTITY-MIB::myFanStateTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.6.1":    "MY-ENTITY-MIB::myFanStateEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.6.1.1":  "MY-ENTITY-MIB::myFanStateDeviceIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.6.1.2":  "MY-ENTITY-MIB::myFanStateIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.1.6.1.3":  "MY-ENTITY-MIB::myFanState",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.2":        "MY-ENTITY-MIB::myEntityMIBTraps",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.2.1":      "MY-ENTITY-MIB::myEntityStateChgDesc",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.2.2":      "MY-ENTITY-MIB::myEntityStatusChange",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.2.3":      "MY-ENTITY-MIB::myTemperatureWarningDesc",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.2.4":      "MY-ENTITY-MIB::myTemperatureWarning",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.3":        "MY-ENTITY-MIB::myDeviceMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.3.1":      "MY-ENTITY-MIB::myDeviceMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.21.3.2":      "MY-ENTITY-MIB::myDeviceMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.2.35":          "MY-MEMORY-POOL-MIB::myMemoryPoolMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1":        "MY-MEMORY-POOL-MIB::myMemoryPoolMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1":      "MY-MEMORY-POOL-MIB::myMemoryPoolUtilizationTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1":    "MY-MEMORY-POOL-MIB::myMemoryPoolUtilizationEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.1":  "MY-MEMORY-POOL-MIB::myMemoryPoolIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.2":  "MY-MEMORY-POOL-MIB::myMemoryPoolName",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.3":  "MY-MEMORY-POOL-MIB::myMemoryPoolCurrentUtilization",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.4":  "MY-MEMORY-POOL-MIB::myMemoryPoolLowestUtilization",
+	"1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.5":  "MY-MEMORY-POOL-MIB::myMemoryPoolLargestUtilization",
+	"1.3.6.1.4.1.4881.1.1.10.2.36":          "MY-PROCESS-MIB::myProcessMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.36.1":        "MY-PROCESS-MIB::myCPUUtilizationMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.36.1.1":      "MY-PROCESS-MIB::myCPUUtilization",
+	"1.3.6.1.4.1.4881.1.1.10.2.36.1.1.1":    "MY-PROCESS-MIB::myCPUUtilization5Sec",
+	"1.3.6.1.4.1.4881.1.1.10.2.36.1.1.2":    "MY-PROCESS-MIB::myCPUUtilization1Min",
+	"1.3.6.1.4.1.4881.1.1.10.2.36.1.1.3":    "MY-PROCESS-MIB::myCPUUtilization5Min",
+	"1.3.6.1.4.1.4881.1.1.10.2.39":          "MY-SMP-MIB::mySMPMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1":        "MY-SMP-MIB::mySMPMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.1":      "MY-SMP-MIB::mySMPServer",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.2":      "MY-SMP-MIB::mySMPServerKey",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.3":      "MY-SMP-MIB::mySMPEventSendSlice",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.4":      "MY-SMP-MIB::mySMPPolicyDelete",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.5":      "MY-SMP-MIB::mySMPPolicyChecksum",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.6":      "MY-SMP-MIB::mySMPPolicyTimeout",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7":      "MY-SMP-MIB::mySMPFrameRelayTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1":    "MY-SMP-MIB::mySMPFrameRelayEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1.1":  "MY-SMP-MIB::mySMPFrameRelayIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1.2":  "MY-SMP-MIB::mySMPFrameRelayContent",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1.3":  "MY-SMP-MIB::mySMPFrameRelayLength",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1.4":  "MY-SMP-MIB::mySMPFrameRelayDestPort",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.7.1.5":  "MY-SMP-MIB::mySMPFrameRelayDestVlan",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8":      "MY-SMP-MIB::mySMPPolicyTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1":    "MY-SMP-MIB::mySMPPolicyEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.1":  "MY-SMP-MIB::mySMPGroupIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.2":  "MY-SMP-MIB::mySMPPolicyIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.3":  "MY-SMP-MIB::mySMPPolicyStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.4":  "MY-SMP-MIB::mySMPPolicyNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.5":  "MY-SMP-MIB::mySMPPolicyInstallPort",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.6":  "MY-SMP-MIB::mySMPPolicyType",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.7":  "MY-SMP-MIB::mySMPPolicyContent",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.8":  "MY-SMP-MIB::mySMPPolicyMask",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.8.1.9":  "MY-SMP-MIB::mySMPPolicyName",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9":      "MY-SMP-MIB::mySMPPolicyGroupTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9.1":    "MY-SMP-MIB::mySMPPolicyGroupEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9.1.1":  "MY-SMP-MIB::mySMPPolicyGroupIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9.1.2":  "MY-SMP-MIB::mySMPPolicyGroupCount",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9.1.3":  "MY-SMP-MIB::mySMPPolicyGroupChecksum",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.1.9.1.4":  "MY-SMP-MIB::mySMPPolicyGroupStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.3":        "MY-SMP-MIB::mySMPMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.3.1":      "MY-SMP-MIB::mySMPMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.3.2":      "MY-SMP-MIB::mySMPMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535":    "MY-SMP-MIB::mySMPTraps",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.1":  "MY-SMP-MIB::mySMPSwitchIP",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.10": "MY-SMP-MIB::mySMPArpAttackInterfacePort",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.11": "MY-SMP-MIB::mySMPArpAttackInterfaceVlanID",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.12": "MY-SMP-MIB::mySMPArpAttackFrameContent",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.13": "MY-SMP-MIB::mySMPArpAttackStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.14": "MY-SMP-MIB::mySMPArpAttackCriticalStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.15": "MY-SMP-MIB::mySMPArpAttackMac",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.16": "MY-SMP-MIB::mySMPArpAttackInterfaceIndex",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.17": "MY-SMP-MIB::mySMPArpAttackTrap",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.2":  "MY-SMP-MIB::mySMPSwitchInterfaceID",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.3":  "MY-SMP-MIB::mySMPSwitchInterfaceVLANID",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.4":  "MY-SMP-MIB::mySMPFrameContentLength",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.5":  "MY-SMP-MIB::mySMPFrameContent",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.6":  "MY-SMP-MIB::mySMPFrameRelayTrap",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.7":  "MY-SMP-MIB::mySMPArpAttackSubnetIP",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.8":  "MY-SMP-MIB::mySMPArpAttackSubnetIPNum",
+	"1.3.6.1.4.1.4881.1.1.10.2.39.65535.9":  "MY-SMP-MIB::mySMPArpAttackInterfaceSlot",
+	"1.3.6.1.4.1.4881.1.1.10.2.5":           "MY-SNMP-AGENT-MIB::mySnmpAgentMIB",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1":         "MY-SNMP-AGENT-MIB::mySnmpAgentMIBObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1":       "MY-SNMP-AGENT-MIB::mySnmpCommunityObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.1":     "MY-SNMP-AGENT-MIB::myCommunityMaxNum",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2":     "MY-SNMP-AGENT-MIB::myCommunityTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1":   "MY-SNMP-AGENT-MIB::myCommunityEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1.1": "MY-SNMP-AGENT-MIB::myCommunityName",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1.2": "MY-SNMP-AGENT-MIB::myCommunityWritable",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1.3": "MY-SNMP-AGENT-MIB::myCommunityUserIpAddr",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1.4": "MY-SNMP-AGENT-MIB::myCommunityEnableIpAddrAuthen",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.1.2.1.5": "MY-SNMP-AGENT-MIB::myCommunityStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2":       "MY-SNMP-AGENT-MIB::mySnmpTrapObjects",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.1":     "MY-SNMP-AGENT-MIB::myTrapDstMaxNumber",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2":     "MY-SNMP-AGENT-MIB::myTrapDstTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2.1":   "MY-SNMP-AGENT-MIB::myTrapDstEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2.1.1": "MY-SNMP-AGENT-MIB::myTrapDstAddr",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2.1.2": "MY-SNMP-AGENT-MIB::myTrapDstCommunity",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2.1.3": "MY-SNMP-AGENT-MIB::myTrapDstSendTrapClass",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.2.1.4": "MY-SNMP-AGENT-MIB::myTrapDstEntryStatus",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.3":     "MY-SNMP-AGENT-MIB::myTrapActionTable",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.3.1":   "MY-SNMP-AGENT-MIB::myTrapActionEntry",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.3.1.1": "MY-SNMP-AGENT-MIB::myTrapType",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.1.2.3.1.2": "MY-SNMP-AGENT-MIB::myTrapAction",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.2":         "MY-SNMP-AGENT-MIB::mySnmpAgentMIBConformance",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.2.1":       "MY-SNMP-AGENT-MIB::mySnmpAgentMIBCompliances",
+	"1.3.6.1.4.1.4881.1.1.10.2.5.2.2":       "MY-SNMP-AGENT-MIB::mySnmpAgentMIBGroups",
+	"1.3.6.1.4.1.4881.1.1.10.3":             "MY-SMI::myAgentCapability",
+	"1.3.6.1.4.1.4881.1.1.10.4":             "MY-SMI::myModules",
+	"1.3.6.1.4.1.4881.1.1.10.4.1":           "MY-PRODUCTS-MIB::myProductsMIB",
+	"1.3.6.1.4.1.4881.1.1.10.5":             "MY-SMI::myExperiment",
+	"1.3.6.1.4.1.4881.1.2":                  "MY-SMI::router",
+	"1.3.6.1.4.1.4881.1.2.1":                "MY-SMI::routerMib",
+	"1.3.6.1.4.1.4881.1.2.1.1":              "MY-SMI::myRouterProducts",
+	"1.3.6.1.4.1.4881.1.2.1.1.1":            "MY-PRODUCTS-MIB::r2620",
+	"1.3.6.1.4.1.4881.1.2.1.1.10":           "MY-PRODUCTS-MIB::r2632",
+	"1.3.6.1.4.1.4881.1.2.1.1.11":           "MY-PRODUCTS-MIB::r1762",
+	"1.3.6.1.4.1.4881.1.2.1.1.12":           "MY-PRODUCTS-MIB::rcms",
+	"1.3.6.1.4.1.4881.1.2.1.1.13":           "MY-PRODUCTS-MIB::hcl-r1762",
+	"1.3.6.1.4.1.4881.1.2.1.1.14":           "MY-PRODUCTS-MIB::hcl-r2632",
+	"1.3.6.1.4.1.4881.1.2.1.1.15":           "MY-PRODUCTS-MIB::hcl-r2692",
+	"1.3.6.1.4.1.4881.1.2.1.1.16":           "MY-PRODUCTS-MIB::hcl-r3642",
+	"1.3.6.1.4.1.4881.1.2.1.1.17":           "MY-PRODUCTS-MIB::hcl-r3662",
+	"1.3.6.1.4.1.4881.1.2.1.1.18":           "MY-PRODUCTS-MIB::r3740",
+	"1.3.6.1.4.1.4881.1.2.1.1.19":           "MY-PRODUCTS-MIB::nbr2000",
+	"1.3.6.1.4.1.4881.1.2.1.1.2":            "MY-PRODUCTS-MIB::r2624",
+	"1.3.6.1.4.1.4881.1.2.1.1.20":           "MY-PRODUCTS-MIB::nbr300",
+	"1.3.6.1.4.1.4881.1.2.1.1.21":           "MY-PRODUCTS-MIB::nbr1200",
+	"1.3.6.1.4.1.4881.1.2.1.1.22":           "MY-PRODUCTS-MIB::nbr1500",
+	"1.3.6.1.4.1.4881.1.2.1.1.23":           "MY-PRODUCTS-MIB::r2716",
+	"1.3.6.1.4.1.4881.1.2.1.1.24":           "MY-PRODUCTS-MIB::r2724",
+	"1.3.6.1.4.1.4881.1.2.1.1.25":           "MY-PRODUCTS-MIB::r3802",
+	"1.3.6.1.4.1.4881.1.2.1.1.26":           "MY-PRODUCTS-MIB::r3804",
+	"1.3.6.1.4.1.4881.1.2.1.1.27":           "MY-PRODUCTS-MIB::rsr50-20",
+	"1.3.6.1.4.1.4881.1.2.1.1.28":           "MY-PRODUCTS-MIB::rsr50-40",
+	"1.3.6.1.4.1.4881.1.2.1.1.29":           "MY-PRODUCTS-MIB::rsr50-80",
+	"1.3.6.1.4.1.4881.1.2.1.1.3":            "MY-PRODUCTS-MIB::r2690",
+	"1.3.6.1.4.1.4881.1.2.1.1.30":           "MY-PRODUCTS-MIB::npe50-20",
+	"1.3.6.1.4.1.4881.1.2.1.1.31":           "MY-PRODUCTS-MIB::rsr10-02",
+	"1.3.6.1.4.1.4881.1.2.1.1.32":           "MY-PRODUCTS-MIB::rsr20-04",
+	"1.3.6.1.4.1.4881.1.2.1.1.33":           "MY-PRODUCTS-MIB::vpn120",
+	"1.3.6.1.4.1.4881.1.2.1.1.34":           "MY-PRODUCTS-MIB::npe80",
+	"1.3.6.1.4.1.4881.1.2.1.1.35":           "MY-PRODUCTS-MIB::rsr20-24",
+	"1.3.6.1.4.1.4881.1.2.1.1.36":           "MY-PRODUCTS-MIB::nm2-16esw",
+	"1.3.6.1.4.1.4881.1.2.1.1.37":           "MY-PRODUCTS-MIB::nm2-24esw",
+	"1.3.6.1.4.1.4881.1.2.1.1.38":           "MY-PRODUCTS-MIB::nmx-24esw",
+	"1.3.6.1.4.1.4881.1.2.1.1.39":           "MY-PRODUCTS-MIB::nmx-24esw-l2",
+	"1.3.6.1.4.1.4881.1.2.1.1.4":            "MY-PRODUCTS-MIB::r2692",
+	"1.3.6.1.4.1.4881.1.2.1.1.40":           "MY-PRODUCTS-MIB::nmx-24esw-3gel3",
+	"1.3.6.1.4.1.4881.1.2.1.1.41":           "MY-PRODUCTS-MIB::rsr20-14",
+	"1.3.6.1.4.1.4881.1.2.1.1.42":           "MY-PRODUCTS-MIB::rsr30-44",
+	"1.3.6.1.4.1.4881.1.2.1.1.43":           "MY-PRODUCTS-MIB::r2700v2v3",
+	"1.3.6.1.4.1.4881.1.2.1.1.44":           "MY-PRODUCTS-MIB::r2700v5",
+	"1.3.6.1.4.1.4881.1.2.1.1.45":           "MY-PRODUCTS-MIB::npe50-40",
+	"1.3.6.1.4.1.4881.1.2.1.1.46":           "MY-PRODUCTS-MIB::rsr20-18",
+	"1.3.6.1.4.1.4881.1.2.1.1.5":            "MY-PRODUCTS-MIB::r3642",
+	"1.3.6.1.4.1.4881.1.2.1.1.6":            "MY-PRODUCTS-MIB::r3662",
+	"1.3.6.1.4.1.4881.1.2.1.1.7":            "MY-PRODUCTS-MIB::nbr1000",
+	"1.3.6.1.4.1.4881.1.2.1.1.8":            "MY-PRODUCTS-MIB::nbr200",
+	"1.3.6.1.4.1.4881.1.2.1.1.9":            "MY-PRODUCTS-MIB::secvpn100",
+	"1.3.6.1.4.1.4881.1.3":                  "MY-SMI::wireless",
+	"1.3.6.1.4.1.4881.1.3.1":                "MY-SMI::wirelessMib",
+	"1.3.6.1.4.1.4881.1.3.1.1":              "MY-SMI::myWirelessProducts",
+	"1.3.6.1.4.1.4881.1.3.1.1.1":            "MY-PRODUCTS-MIB::wgp500",
+	"1.3.6.1.4.1.4881.1.3.1.2":              "MY-SMI::myWirelessMgmt",
+	"1.3.6.1.5":                             "SNMPv2-SMI::security",
+	"1.3.6.1.6":                             "SNMPv2-SMI::snmpV2",
+	"1.3.6.1.6.1":                           "SNMPv2-SMI::snmpDomains",
+	"1.3.6.1.6.2":                           "SNMPv2-SMI::snmpProxys",
+	"1.3.6.1.6.3":                           "SNMPv2-SMI::snmpModules",
+	"1.3.6.1.6.3.1":                         "SNMPv2-MIB::snmpMIB",
+	"1.3.6.1.6.3.1.1":                       "SNMPv2-MIB::snmpMIBObjects",
+	"1.3.6.1.6.3.1.1.4":                     "SNMPv2-MIB::snmpTrap",
+	"1.3.6.1.6.3.1.1.4.1":                   "SNMPv2-MIB::snmpTrapOID",
+	"1.3.6.1.6.3.1.1.4.3":                   "SNMPv2-MIB::snmpTrapEnterprise",
+	"1.3.6.1.6.3.1.1.5":                     "SNMPv2-MIB::snmpTraps",
+	"1.3.6.1.6.3.1.1.5.1":                   "SNMPv2-MIB::coldStart",
+	"1.3.6.1.6.3.1.1.5.2":                   "SNMPv2-MIB::warmStart",
+	"1.3.6.1.6.3.1.1.5.3":                   "IF-MIB::linkDown",
+	"1.3.6.1.6.3.1.1.5.4":                   "IF-MIB::linkUp",
+	"1.3.6.1.6.3.1.1.5.5":                   "SNMPv2-MIB::authenticationFailure",
+	"1.3.6.1.6.3.1.1.6":                     "SNMPv2-MIB::snmpSet",
+	"1.3.6.1.6.3.1.1.6.1":                   "SNMPv2-MIB::snmpSetSerialNo",
+	"1.3.6.1.6.3.1.2":                       "SNMPv2-MIB::snmpMIBConformance",
+	"1.3.6.1.6.3.1.2.1":                     "SNMPv2-MIB::snmpMIBCompliances",
+	"1.3.6.1.6.3.1.2.2":                     "SNMPv2-MIB::snmpMIBGroups",
+}
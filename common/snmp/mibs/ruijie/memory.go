@@ -0,0 +1,22 @@
+package ruijie
+
+const (
+	MY_MEMORY_POOL_MIB_myMemoryPoolMIB_OID                 = "1.3.6.1.4.1.4881.1.1.10.2.35"
+	MY_MEMORY_POOL_MIB_myMemoryPoolMIB_NAME                = "MY-MEMORY-POOL-MIB::myMemoryPoolMIB"
+	MY_MEMORY_POOL_MIB_myMemoryPoolMIBObjects_OID          = "1.3.6.1.4.1.4881.1.1.10.2.35.1"
+	MY_MEMORY_POOL_MIB_myMemoryPoolMIBObjects_NAME         = "MY-MEMORY-POOL-MIB::myMemoryPoolMIBObjects"
+	MY_MEMORY_POOL_MIB_myMemoryPoolUtilizationTable_OID    = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1"
+	MY_MEMORY_POOL_MIB_myMemoryPoolUtilizationTable_NAME   = "MY-MEMORY-POOL-MIB::myMemoryPoolUtilizationTable"
+	MY_MEMORY_POOL_MIB_myMemoryPoolUtilizationEntry_OID    = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1"
+	MY_MEMORY_POOL_MIB_myMemoryPoolUtilizationEntry_NAME   = "MY-MEMORY-POOL-MIB::myMemoryPoolUtilizationEntry"
+	MY_MEMORY_POOL_MIB_myMemoryPoolIndex_OID               = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.1"
+	MY_MEMORY_POOL_MIB_myMemoryPoolIndex_NAME              = "MY-MEMORY-POOL-MIB::myMemoryPoolIndex"
+	MY_MEMORY_POOL_MIB_myMemoryPoolName_OID                = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.2"
+	MY_MEMORY_POOL_MIB_myMemoryPoolName_NAME               = "MY-MEMORY-POOL-MIB::myMemoryPoolName"
+	MY_MEMORY_POOL_MIB_myMemoryPoolCurrentUtilization_OID  = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.3"
+	MY_MEMORY_POOL_MIB_myMemoryPoolCurrentUtilization_NAME = "MY-MEMORY-POOL-MIB::myMemoryPoolCurrentUtilization"
+	MY_MEMORY_POOL_MIB_myMemoryPoolLowestUtilization_OID   = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.4"
+	MY_MEMORY_POOL_MIB_myMemoryPoolLowestUtilization_NAME  = "MY-MEMORY-POOL-MIB::myMemoryPoolLowestUtilization"
+	MY_MEMORY_POOL_MIB_myMemoryPoolLargestUtilization_OID  = "1.3.6.1.4.1.4881.1.1.10.2.35.1.1.1.5"
+	MY_MEMORY_POOL_MIB_myMemoryPoolLargestUtilization_NAME = "MY-MEMORY-POOL-MIB::myMemoryPoolLargestUtilization"
+)
@@ -0,0 +1,16 @@
+package ruijie
+
+const (
+	MY_PROCESS_MIB_myProcessMIB_OID                = "1.3.6.1.4.1.4881.1.1.10.2.36"
+	MY_PROCESS_MIB_myProcessMIB_NAME               = "MY-PROCESS-MIB::myProcessMIB"
+	MY_PROCESS_MIB_myCPUUtilizationMIBObjects_OID  = "1.3.6.1.4.1.4881.1.1.10.2.36.1"
+	MY_PROCESS_MIB_myCPUUtilizationMIBObjects_NAME = "MY-PROCESS-MIB::myCPUUtilizationMIBObjects"
+	MY_PROCESS_MIB_myCPUUtilization_OID            = "1.3.6.1.4.1.4881.1.1.10.2.36.1.1"
+	MY_PROCESS_MIB_myCPUUtilization_NAME           = "MY-PROCESS-MIB::myCPUUtilization"
+	MY_PROCESS_MIB_myCPUUtilization5Sec_OID        = "1.3.6.1.4.1.4881.1.1.10.2.36.1.1.1"
+	MY_PROCESS_MIB_myCPUUtilization5Sec_NAME       = "MY-PROCESS-MIB::myCPUUtilization5Sec"
+	MY_PROCESS_MIB_myCPUUtilization1Min_OID        = "1.3.6.1.4.1.4881.1.1.10.2.36.1.1.2"
+	MY_PROCESS_MIB_myCPUUtilization1Min_NAME       = "MY-PROCESS-MIB::myCPUUtilization1Min"
+	MY_PROCESS_MIB_myCPUUtilization5Min_OID        = "1.3.6.1.4.1.4881.1.1.10.2.36.1.1.3"
+	MY_PROCESS_MIB_myCPUUtilization5Min_NAME       = "MY-PROCESS-MIB::myCPUUtilization5Min"
+)
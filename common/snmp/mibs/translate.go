@@ -0,0 +1,32 @@
+// Package mibs Symbolic names of the OIDs in the vendor MIB tables
+package mibs
+
+//go:generate go run gen.go
+
+import (
+	"strings"
+)
+
+// Name The symbolic name of an OID in the MIB tables
+func Name(oid string) (string, bool) {
+	name, ok := oidNames[strings.Trim(oid, ".")]
+	return name, ok
+}
+
+// Translate Translates an OID to its symbolic name, the longest known prefix
+// is named and the rest kept as the instance, "1.3.6.1.2.1.2.2.1.8.5" is
+// "IF-MIB::ifOperStatus.5". An OID without any known prefix is returned as is
+func Translate(oid string) string {
+	prefix := strings.Trim(oid, ".")
+	for prefix != "" {
+		if name, ok := oidNames[prefix]; ok {
+			return name + strings.TrimPrefix(strings.TrimLeft(oid, "."), prefix)
+		}
+		i := strings.LastIndexByte(prefix, '.')
+		if i < 0 {
+			break
+		}
+		prefix = prefix[:i]
+	}
+	return oid
+}
@@ -0,0 +1,25 @@
+package mibs
+
+import (
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	cases := map[string]string{
+		".1.3.6.1.6.3.1.1.5.3":          "IF-MIB::linkDown",
+		"1.3.6.1.6.3.1.1.5.1":           "SNMPv2-MIB::coldStart",
+		".1.3.6.1.2.1.2.2.1.8.5":        "IF-MIB::ifOperStatus.5",
+		".1.3.6.1.2.1.1.3.0":            "SNMPv2-MIB::sysUpTime.0",
+		".1.3.6.1.4.1.14988.1.1.3.10.0": "MIKROTIK-MIB::mtxrHlTemperature.0",
+		".2.999.1":                      ".2.999.1",
+		"":                              "",
+	}
+	for oid, want := range cases {
+		if got := Translate(oid); got != want {
+			t.Errorf("Translate(%q) = %q, want %q", oid, got, want)
+		}
+	}
+	if name, ok := Name(".1.3.6.1.6.3.1.1.4.1"); !ok || name != "SNMPv2-MIB::snmpTrapOID" {
+		t.Errorf("snmpTrapOID %q", name)
+	}
+}
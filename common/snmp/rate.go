@@ -0,0 +1,64 @@
+package snmp
+
+import (
+	"math"
+	"time"
+)
+
+// IfCounters The interface counters of a device at a poll
+type IfCounters struct {
+	Time   time.Time
+	Uptime int64 // sysUpTime in hundredths of a second, 0 when unknown
+	Ifaces map[int64]*DeviceIfOctets
+}
+
+// IfRate The traffic of an interface between two polls
+type IfRate struct {
+	InBps  float64
+	OutBps float64
+}
+
+// CounterDelta The increase of a counter between two polls. A 32-bit counter
+// that went down wrapped around, a 64-bit one does not wrap in practice so it
+// was reset and there is no delta
+func CounterDelta(prev, cur uint64, bits int) (uint64, bool) {
+	if cur >= prev {
+		return cur - prev, true
+	}
+	if bits == 32 && prev <= math.MaxUint32 && cur <= math.MaxUint32 {
+		return cur + (1 << 32) - prev, true
+	}
+	return 0, false
+}
+
+// IfRates The bits per second of the interfaces present at both polls. Nothing
+// is computed across a reboot, seen as sysUpTime going backwards, because the
+// counters started again from zero. sysUpTime wraps after 497 days, which
+// only costs one interval
+func IfRates(prev, cur IfCounters) map[int64]IfRate {
+	rates := make(map[int64]IfRate)
+	seconds := cur.Time.Sub(prev.Time).Seconds()
+	if seconds <= 0 || len(prev.Ifaces) == 0 {
+		return rates
+	}
+	if prev.Uptime > 0 && cur.Uptime > 0 && cur.Uptime < prev.Uptime {
+		return rates
+	}
+	for index, iface := range cur.Ifaces {
+		last, ok := prev.Ifaces[index]
+		// a counter of another width is another counter
+		if !ok || last.CounterBits != iface.CounterBits {
+			continue
+		}
+		in, inOk := CounterDelta(last.InOctets, iface.InOctets, iface.CounterBits)
+		out, outOk := CounterDelta(last.OutOctets, iface.OutOctets, iface.CounterBits)
+		if !inOk || !outOk {
+			continue
+		}
+		rates[index] = IfRate{
+			InBps:  float64(in) * 8 / seconds,
+			OutBps: float64(out) * 8 / seconds,
+		}
+	}
+	return rates
+}
@@ -0,0 +1,102 @@
+package snmp
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+)
+
+func TestCounterDelta(t *testing.T) {
+	cases := []struct {
+		prev, cur uint64
+		bits      int
+		delta     uint64
+		ok        bool
+	}{
+		{100, 250, 64, 150, true},
+		{100, 100, 32, 0, true},
+		{math.MaxUint32 - 99, 50, 32, 150, true},
+		{math.MaxUint64 - 10, 5, 64, 0, false},
+		{1 << 40, 5, 32, 0, false},
+	}
+	for _, c := range cases {
+		delta, ok := CounterDelta(c.prev, c.cur, c.bits)
+		if delta != c.delta || ok != c.ok {
+			t.Errorf("CounterDelta(%d, %d, %d) = %d %v", c.prev, c.cur, c.bits, delta, ok)
+		}
+	}
+}
+
+func TestIfRates(t *testing.T) {
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	prev := IfCounters{Time: start, Uptime: 10000, Ifaces: map[int64]*DeviceIfOctets{
+		1: {Index: 1, InOctets: 1000, OutOctets: 2000, CounterBits: 64},
+		2: {Index: 2, InOctets: math.MaxUint32 - 999, OutOctets: 0, CounterBits: 32},
+		3: {Index: 3, InOctets: 5000, OutOctets: 5000, CounterBits: 64},
+		4: {Index: 4, InOctets: 10, OutOctets: 10, CounterBits: 32},
+	}}
+	cur := IfCounters{Time: start.Add(100 * time.Second), Uptime: 20000, Ifaces: map[int64]*DeviceIfOctets{
+		1: {Index: 1, InOctets: 126000, OutOctets: 2000, CounterBits: 64},
+		2: {Index: 2, InOctets: 11500, OutOctets: 1250, CounterBits: 32},
+		// reset counter
+		3: {Index: 3, InOctets: 100, OutOctets: 6000, CounterBits: 64},
+		// now read from ifXTable
+		4: {Index: 4, InOctets: 20, OutOctets: 20, CounterBits: 64},
+		// new interface
+		5: {Index: 5, InOctets: 20, OutOctets: 20, CounterBits: 64},
+	}}
+	rates := IfRates(prev, cur)
+	if len(rates) != 2 {
+		t.Fatalf("unexpected rates %+v", rates)
+	}
+	if r := rates[1]; r.InBps != 10000 || r.OutBps != 0 {
+		t.Errorf("interface 1 %+v", r)
+	}
+	// 1000 octets before the wrap and 11500 after
+	if r := rates[2]; r.InBps != 1000 || r.OutBps != 100 {
+		t.Errorf("interface 2 %+v", r)
+	}
+
+	// the device rebooted in between
+	rebooted := cur
+	rebooted.Uptime = 500
+	if rates = IfRates(prev, rebooted); len(rates) != 0 {
+		t.Errorf("rates across a reboot %+v", rates)
+	}
+	// first poll
+	if rates = IfRates(IfCounters{}, cur); len(rates) != 0 {
+		t.Errorf("rates without a previous poll %+v", rates)
+	}
+	if rates = IfRates(cur, cur); len(rates) != 0 {
+		t.Errorf("rates of a zero interval %+v", rates)
+	}
+}
+
+func TestCollectHighCapacityCounters(t *testing.T) {
+	values := append([]gosnmp.SnmpPDU{
+		{Name: hostresmib.IF_MIB_ifHCInOctets_OID + ".1", Type: gosnmp.Counter64, Value: uint64(1) << 33},
+		{Name: hostresmib.IF_MIB_ifHCOutOctets_OID + ".1", Type: gosnmp.Counter64, Value: uint64(1) << 34},
+	}, testSystemValues...)
+	agent := newTestAgent(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "secret"}, values)
+	client, err := NewSnmpClient(SnmpAuth{Ipaddr: "127.0.0.1", Port: agent.port(), Community: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	shortTimeout(client)
+
+	device := NewGenericDevice()
+	if err = client.CollectIfOctets(device); err != nil {
+		t.Fatal(err)
+	}
+	if iface := device.IfOctetsMap[1]; iface.CounterBits != 64 || iface.InOctets != 1<<33 || iface.OutOctets != 1<<34 {
+		t.Errorf("interface 1 %+v", iface)
+	}
+	// ether2 has no ifXTable entry
+	if iface := device.IfOctetsMap[2]; iface.CounterBits != 32 || iface.InOctets != 2000 || iface.OutOctets != 4000 {
+		t.Errorf("interface 2 %+v", iface)
+	}
+}
@@ -10,7 +10,10 @@ import (
 
 	"github.com/montanaflynn/stats"
 	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/snmp/mibs/h3c"
 	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+	"github.com/talkincode/logsight/common/snmp/mibs/huawei"
+	"github.com/talkincode/logsight/common/snmp/mibs/ruijie"
 
 	"github.com/gosnmp/gosnmp"
 )
@@ -19,6 +22,15 @@ type SnmpAuth struct {
 	Ipaddr    string
 	Port      int
 	Community string
+	// Version v2c or v3, the USM credentials below are used with v3
+	Version       string
+	SecurityLevel string // noAuthNoPriv, authNoPriv or authPriv, authPriv when empty
+	Username      string
+	AuthProtocol  string // MD5, SHA, SHA224, SHA256, SHA384 or SHA512
+	AuthPassword  string
+	PrivProtocol  string // DES, AES, AES192, AES256, AES192C or AES256C
+	PrivPassword  string
+	ContextName   string
 }
 
 type SnmpDevice struct {
@@ -46,6 +58,10 @@ type SnmpDevice struct {
 	DiskTotal     int64
 	DiskPercent   float64
 	IfOctetsMap   map[int64]*DeviceIfOctets
+	// Temperature The highest temperature in degrees Celsius, NaN when the device reports none
+	Temperature float64
+	Sensors     []DeviceSensor
+	Opticals    []DeviceOptical
 }
 
 func (dev *SnmpDevice) ToJson() string {
@@ -58,6 +74,9 @@ func (dev *SnmpDevice) ToJson() string {
 	if math.IsNaN(dev.DiskPercent) {
 		dev.DiskPercent = 0
 	}
+	if math.IsNaN(dev.Temperature) {
+		dev.Temperature = 0
+	}
 	jsons, _ := json.MarshalIndent(dev, "", "\t")
 	return string(jsons)
 }
@@ -76,6 +95,7 @@ func (dev *SnmpDevice) SnmpDocment() (map[string]interface{}, error) {
 		"disk_usage":   dev.DiskUsage,
 		"disk_total":   dev.DiskTotal,
 		"disk_percent": common.ReplaceNaN(dev.DiskPercent, 0),
+		"temperature":  common.ReplaceNaN(dev.Temperature, 0),
 		"uptime":       dev.Uptime,
 	}
 	if dev.Sn == "" && dev.SoftwareId != "" {
@@ -193,15 +213,7 @@ func (c *SnmpV2Client) CollectIfOctets(device *SnmpDevice) (err error) {
 	if err != nil {
 		return err
 	}
-	err = c.collectInterfacesInOctets(device.IfOctetsMap)
-	if err != nil {
-		return err
-	}
-	err = c.collectInterfacesOutOctets(device.IfOctetsMap)
-	if err != nil {
-		return err
-	}
-	return
+	return c.collectInterfacesOctets(device.IfOctetsMap)
 }
 
 // CollectDeviceSystemInfo 采集设备系统信息
@@ -255,90 +267,121 @@ func (c *SnmpV2Client) CollectDevice(device *SnmpDevice) error {
 	if err != nil {
 		return err
 	}
+
+	// after the interfaces, whose names label the transceivers
+	err = c.collectDeviceHardware(device)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
-// collectDeviceCpuUse 采集CPU使用
+// collectDeviceCpuUse 采集CPU使用, 厂商表中没有的回退到 HOST-RESOURCES
 func (c *SnmpV2Client) collectDeviceCpuUse(device *SnmpDevice) (err error) {
+	device.CpuLoad = math.NaN()
 	switch device.VendorCode {
-	default:
-		rs, err := c.Snmpc.BulkWalkAll(hostresmib.HOST_RESOURCES_MIB_hrProcessorLoad_OID)
-		if err != nil {
-			return err
-		}
-		var totalLoad int64 = 0
-		coreCount := int64(len(rs))
-		for _, pdu := range rs {
-			totalLoad = totalLoad + SnmpValue(pdu).Int64Value()
-		}
-		device.CpuLoad, _ = stats.Round(float64(totalLoad)/float64(coreCount), 2)
+	case VendorH3C:
+		device.CpuLoad, err = c.collectEntityUsage(h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtCpuUsage_OID)
+	case VendorHuawei:
+		device.CpuLoad, err = c.collectEntityUsage(huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityCpuUsage_OID)
+	case VendorRuijie:
+		device.CpuLoad, err = c.collectEntityUsage(ruijie.MY_PROCESS_MIB_myCPUUtilization1Min_OID)
+	}
+	if err != nil || !math.IsNaN(device.CpuLoad) {
+		return err
+	}
+	rs, err := c.Snmpc.BulkWalkAll(hostresmib.HOST_RESOURCES_MIB_hrProcessorLoad_OID)
+	if err != nil {
+		return err
+	}
+	var totalLoad int64 = 0
+	coreCount := int64(len(rs))
+	for _, pdu := range rs {
+		totalLoad = totalLoad + SnmpValue(pdu).Int64Value()
 	}
+	device.CpuLoad, _ = stats.Round(float64(totalLoad)/float64(coreCount), 2)
 	return nil
 }
 
-// collectDeviceStorage 采集磁盘存储
+// collectDeviceStorage 采集内存与磁盘存储, 厂商表中没有的回退到 HOST-RESOURCES
 func (c *SnmpV2Client) collectDeviceStorage(device *SnmpDevice) (err error) {
+	var ok bool
 	switch device.VendorCode {
-	default:
-		var collectStorage = func(oidext string) (used int64, size int64) {
-			hrStorageUsed := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.6%s", oidext)
-			hrStorageSize := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.5%s", oidext)
-			unit := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.4%s", oidext)
-			_rs, err := c.CollectOids([]string{
-				hrStorageUsed,
-				hrStorageSize,
-				unit,
-			})
-			if err != nil {
-				log.Printf("collectMemory error %s", err.Error())
-				return
-			}
-			var _unitSize int64 = 1
-			for _, pdu := range _rs {
-				switch pdu.Name {
-				case hrStorageUsed:
-					used = pdu.Int64Value()
-				case hrStorageSize:
-					size = pdu.Int64Value()
-				case unit:
-					_unitSize = pdu.Int64Value()
-				}
-			}
-			if _unitSize != 0 {
-				used = used * _unitSize
-				size = size * _unitSize
-			}
-			return
-		}
+	case VendorH3C:
+		ok, err = c.collectEntityMemory(device, h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemUsage_OID,
+			h3c.HH3C_ENTITY_EXT_MIB_hh3cEntityExtMemSize_OID)
+	case VendorHuawei:
+		ok, err = c.collectEntityMemory(device, huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemUsage_OID,
+			huawei.HUAWEI_ENTITY_EXTENT_MIB_hwEntityMemSize_OID)
+	case VendorRuijie:
+		ok, err = c.collectEntityMemory(device, ruijie.MY_MEMORY_POOL_MIB_myMemoryPoolCurrentUtilization_OID, "")
+	}
+	if err != nil || ok {
+		return err
+	}
+	return c.collectHrStorage(device)
+}
 
-		var memUsed int64 = 0
-		var memTotal int64 = 0
-		var diskUsed int64 = 0
-		var diskTotal int64 = 0
-		rs, err := c.Snmpc.BulkWalkAll(hostresmib.HOST_RESOURCES_MIB_hrStorageDescr_OID)
+// collectHrStorage 采集 HOST-RESOURCES 内存与磁盘存储
+func (c *SnmpV2Client) collectHrStorage(device *SnmpDevice) (err error) {
+	var collectStorage = func(oidext string) (used int64, size int64) {
+		hrStorageUsed := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.6%s", oidext)
+		hrStorageSize := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.5%s", oidext)
+		unit := fmt.Sprintf(".1.3.6.1.2.1.25.2.3.1.4%s", oidext)
+		_rs, err := c.CollectOids([]string{
+			hrStorageUsed,
+			hrStorageSize,
+			unit,
+		})
 		if err != nil {
-			return err
+			log.Printf("collectMemory error %s", err.Error())
+			return
 		}
-
-		for _, _pdu := range rs {
-			val := SnmpValue(_pdu)
-			switch {
-			case strings.Contains(val.StringValue(), "memory"):
-				_used, _size := collectStorage(val.Name[strings.LastIndex(val.Name, "."):])
-				memUsed += _used
-				memTotal += _size
-			case strings.Contains(val.StringValue(), "disk"):
-				_used2, _size2 := collectStorage(val.Name[strings.LastIndex(val.Name, "."):])
-				diskUsed += _used2
-				diskTotal += _size2
+		var _unitSize int64 = 1
+		for _, pdu := range _rs {
+			switch pdu.Name {
+			case hrStorageUsed:
+				used = pdu.Int64Value()
+			case hrStorageSize:
+				size = pdu.Int64Value()
+			case unit:
+				_unitSize = pdu.Int64Value()
 			}
 		}
-		device.MemUsage = memUsed
-		device.MemTotal = memTotal
-		device.MemPercent, _ = stats.Round(float64(memUsed)/float64(memTotal)*100, 2)
-		device.DiskUsage = diskUsed
-		device.DiskTotal = diskTotal
-		device.DiskPercent, _ = stats.Round(float64(diskUsed)/float64(diskTotal)*100, 2)
-		return nil
+		if _unitSize != 0 {
+			used = used * _unitSize
+			size = size * _unitSize
+		}
+		return
+	}
+
+	var memUsed int64 = 0
+	var memTotal int64 = 0
+	var diskUsed int64 = 0
+	var diskTotal int64 = 0
+	rs, err := c.Snmpc.BulkWalkAll(hostresmib.HOST_RESOURCES_MIB_hrStorageDescr_OID)
+	if err != nil {
+		return err
+	}
+
+	for _, _pdu := range rs {
+		val := SnmpValue(_pdu)
+		switch {
+		case strings.Contains(val.StringValue(), "memory"):
+			_used, _size := collectStorage(val.Name[strings.LastIndex(val.Name, "."):])
+			memUsed += _used
+			memTotal += _size
+		case strings.Contains(val.StringValue(), "disk"):
+			_used2, _size2 := collectStorage(val.Name[strings.LastIndex(val.Name, "."):])
+			diskUsed += _used2
+			diskTotal += _size2
+		}
 	}
+	device.MemUsage = memUsed
+	device.MemTotal = memTotal
+	device.MemPercent, _ = stats.Round(float64(memUsed)/float64(memTotal)*100, 2)
+	device.DiskUsage = diskUsed
+	device.DiskTotal = diskTotal
+	device.DiskPercent, _ = stats.Round(float64(diskUsed)/float64(diskTotal)*100, 2)
+	return nil
 }
@@ -0,0 +1,387 @@
+package snmp
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs"
+)
+
+const (
+	// SnmpTrapOID The varbind of a v2c or v3 notification carrying its trap OID
+	SnmpTrapOID = ".1.3.6.1.6.3.1.1.4.1.0"
+	// SysUpTimeOID The varbind of a v2c or v3 notification carrying the agent uptime
+	SysUpTimeOID = ".1.3.6.1.2.1.1.3.0"
+
+	// snmpTrapsOID The generic traps of v1, coldStart is snmpTraps.1 (RFC 3584)
+	snmpTrapsOID = ".1.3.6.1.6.3.1.1.5"
+	// usmStatsUnknownEngineIDs Reported to an inform sender discovering our engine
+	usmStatsUnknownEngineIDs = ".1.3.6.1.6.3.15.1.1.4.0"
+
+	trapMaxDatagramSize = 64 * 1024
+)
+
+// DefaultTrapEngineID The engine id of a trap server without one, an informs
+// sender discovers it or is configured with it
+const DefaultTrapEngineID = "\x80\x00\x1f\x88\x04logsight"
+
+var ErrTrapCommunity = errors.New("snmp trap community mismatch")
+
+// TrapVariable A decoded varbind of a trap
+type TrapVariable struct {
+	Oid   string `json:"oid"`
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Trap A decoded notification, a v1 trap is translated to its v2 trap OID
+type Trap struct {
+	Addr         *net.UDPAddr
+	Version      string // v1, v2c or v3
+	Community    string
+	Username     string
+	Inform       bool
+	TrapOID      string
+	TrapName     string
+	Uptime       uint32 // hundredths of a second
+	Enterprise   string // v1 only
+	AgentAddress string // v1 only
+	Variables    []TrapVariable
+	Received     time.Time
+}
+
+// TrapHandler Records a trap, an inform is only acknowledged when it returns
+// nil so that the sender retransmits what could not be recorded
+type TrapHandler func(trap *Trap) error
+
+// TrapServer A receiver of v1, v2c and v3 traps and informs. A v3
+// notification must come from one of the users set with SetUsers, at the
+// user's security level
+type TrapServer struct {
+	Handler TrapHandler
+	// Community Of the v1 and v2c notifications, empty accepts any
+	Community string
+	// EngineID Our engine, informs are sent to it. DefaultTrapEngineID when empty
+	EngineID string
+	// OnError Is told about the dropped packets, may be nil
+	OnError func(addr net.Addr, err error)
+
+	mu      sync.Mutex
+	conn    net.PacketConn
+	users   []trapUser
+	started time.Time
+}
+
+type trapUser struct {
+	name   string
+	params *gosnmp.GoSNMP
+}
+
+// SetUsers Replace the v3 users, it may be called while serving
+func (s *TrapServer) SetUsers(auths []SnmpAuth) error {
+	users := make([]trapUser, 0, len(auths))
+	for _, auth := range auths {
+		flags, params, err := auth.UsmParameters()
+		if err != nil {
+			return fmt.Errorf("snmp trap user %s: %w", auth.Username, err)
+		}
+		// informs are sent to our engine, the keys of traps are localized to the sender's
+		params.AuthoritativeEngineID = s.engineID()
+		users = append(users, trapUser{name: auth.Username, params: &gosnmp.GoSNMP{
+			Version:            gosnmp.Version3,
+			SecurityModel:      gosnmp.UserSecurityModel,
+			MsgFlags:           flags,
+			SecurityParameters: params,
+		}})
+	}
+	s.mu.Lock()
+	s.users = users
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *TrapServer) engineID() string {
+	if s.EngineID == "" {
+		return DefaultTrapEngineID
+	}
+	return s.EngineID
+}
+
+// ListenAndServe Listen on a UDP address such as 0.0.0.0:162 and serve until Close
+func (s *TrapServer) ListenAndServe(addr string) error {
+	conn, err := net.ListenPacket("udp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(conn)
+}
+
+// Serve Serve the notifications arriving on conn until it is closed,
+// they are handled in arrival order so a linkDown is not reordered with its linkUp
+func (s *TrapServer) Serve(conn net.PacketConn) error {
+	s.mu.Lock()
+	s.conn = conn
+	s.started = time.Now()
+	s.mu.Unlock()
+
+	buf := make([]byte, trapMaxDatagramSize)
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		udpAddr, ok := addr.(*net.UDPAddr)
+		if !ok {
+			continue
+		}
+		s.handle(conn, udpAddr, buf[:n])
+	}
+}
+
+// Close Stop serving
+func (s *TrapServer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	return s.conn.Close()
+}
+
+func (s *TrapServer) fail(addr net.Addr, err error) {
+	if s.OnError != nil {
+		s.OnError(addr, err)
+	}
+}
+
+func (s *TrapServer) handle(conn net.PacketConn, addr *net.UDPAddr, data []byte) {
+	pkt, err := s.decode(data)
+	if err != nil {
+		s.fail(addr, err)
+		return
+	}
+
+	if pkt.Version == gosnmp.Version3 && pkt.MsgFlags&gosnmp.Reportable != 0 {
+		usm := pkt.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+		if usm.AuthoritativeEngineID != s.engineID() {
+			s.report(conn, addr, pkt)
+			return
+		}
+	}
+
+	switch pkt.PDUType {
+	case gosnmp.Trap, gosnmp.SNMPv2Trap, gosnmp.InformRequest:
+	default:
+		s.fail(addr, fmt.Errorf("unsupported snmp pdu %s", pkt.PDUType))
+		return
+	}
+	if err = s.Handler(NewTrap(addr, pkt)); err != nil {
+		s.fail(addr, err)
+		return
+	}
+	if pkt.PDUType != gosnmp.InformRequest {
+		return
+	}
+
+	// acknowledge the inform with its own varbinds
+	pkt.PDUType = gosnmp.GetResponse
+	pkt.MsgFlags &= gosnmp.AuthPriv
+	pkt.Error = gosnmp.NoError
+	pkt.ErrorIndex = 0
+	s.send(conn, addr, pkt)
+}
+
+// decode Decode a notification, a v3 one is authenticated and decrypted
+// with the first user it is valid for
+func (s *TrapServer) decode(data []byte) (*gosnmp.SnmpPacket, error) {
+	// a v3 message without authentication decodes without credentials
+	peek, peekErr := (&gosnmp.GoSNMP{Version: gosnmp.Version3}).UnmarshalTrap(clone(data), true)
+	if peekErr == nil && peek.Version != gosnmp.Version3 {
+		if s.Community != "" && peek.Community != s.Community {
+			return nil, ErrTrapCommunity
+		}
+		return peek, nil
+	}
+
+	s.mu.Lock()
+	users := s.users
+	s.mu.Unlock()
+	for _, user := range users {
+		// decoding blanks the digest in place, each attempt needs its own copy
+		pkt, err := user.params.UnmarshalTrap(clone(data), true)
+		if err != nil || pkt.Version != gosnmp.Version3 {
+			continue
+		}
+		usm := pkt.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+		if usm.UserName == user.name && pkt.MsgFlags&gosnmp.AuthPriv == user.params.MsgFlags {
+			return pkt, nil
+		}
+	}
+
+	// engine discovery before an inform, it is answered with a Report
+	if peekErr == nil && peek.MsgFlags&gosnmp.Reportable != 0 &&
+		peek.SecurityParameters.(*gosnmp.UsmSecurityParameters).AuthoritativeEngineID == "" {
+		return peek, nil
+	}
+	if peekErr != nil && len(users) == 0 {
+		return nil, peekErr
+	}
+	return nil, errors.New("snmpv3 notification of an unknown user or with wrong credentials")
+}
+
+// report Tell an inform sender our engine id, boots and time
+func (s *TrapServer) report(conn net.PacketConn, addr *net.UDPAddr, pkt *gosnmp.SnmpPacket) {
+	usm := pkt.SecurityParameters.(*gosnmp.UsmSecurityParameters)
+	pkt.SecurityParameters = &gosnmp.UsmSecurityParameters{
+		AuthoritativeEngineID:    s.engineID(),
+		AuthoritativeEngineBoots: 1,
+		AuthoritativeEngineTime:  uint32(time.Since(s.started).Seconds()),
+		UserName:                 usm.UserName,
+	}
+	pkt.PDUType = gosnmp.Report
+	pkt.MsgFlags = gosnmp.NoAuthNoPriv
+	pkt.Variables = []gosnmp.SnmpPDU{{Name: usmStatsUnknownEngineIDs, Type: gosnmp.Counter32, Value: uint32(1)}}
+	s.send(conn, addr, pkt)
+}
+
+func (s *TrapServer) send(conn net.PacketConn, addr *net.UDPAddr, pkt *gosnmp.SnmpPacket) {
+	out, err := pkt.MarshalMsg()
+	if err != nil {
+		s.fail(addr, err)
+		return
+	}
+	if _, err = conn.WriteTo(out, addr); err != nil {
+		s.fail(addr, err)
+	}
+}
+
+func clone(data []byte) []byte {
+	b := make([]byte, len(data))
+	copy(b, data)
+	return b
+}
+
+// NewTrap Decode the notification in a packet, the OIDs are translated
+// with the vendor MIB tables
+func NewTrap(addr *net.UDPAddr, pkt *gosnmp.SnmpPacket) *Trap {
+	trap := &Trap{
+		Addr:      addr,
+		Community: pkt.Community,
+		Inform:    pkt.PDUType == gosnmp.InformRequest,
+		Received:  time.Now(),
+	}
+	switch pkt.Version {
+	case gosnmp.Version1:
+		trap.Version = "v1"
+	case gosnmp.Version2c:
+		trap.Version = VersionV2c
+	case gosnmp.Version3:
+		trap.Version = VersionV3
+		trap.Community = ""
+		if usm, ok := pkt.SecurityParameters.(*gosnmp.UsmSecurityParameters); ok {
+			trap.Username = usm.UserName
+		}
+	}
+
+	if pkt.PDUType == gosnmp.Trap {
+		trap.Enterprise = normalizeOid(pkt.Enterprise)
+		trap.AgentAddress = pkt.AgentAddress
+		trap.Uptime = uint32(pkt.Timestamp)
+		if pkt.GenericTrap >= 0 && pkt.GenericTrap < 6 {
+			trap.TrapOID = snmpTrapsOID + "." + strconv.Itoa(pkt.GenericTrap+1)
+		} else {
+			trap.TrapOID = trap.Enterprise + ".0." + strconv.Itoa(pkt.SpecificTrap)
+		}
+	}
+
+	for _, pdu := range pkt.Variables {
+		name := normalizeOid(pdu.Name)
+		switch {
+		case name == SysUpTimeOID && pdu.Type == gosnmp.TimeTicks:
+			trap.Uptime = uint32(gosnmp.ToBigInt(pdu.Value).Uint64())
+			continue
+		case name == SnmpTrapOID && pdu.Type == gosnmp.ObjectIdentifier:
+			trap.TrapOID = normalizeOid(fmt.Sprint(pdu.Value))
+			continue
+		}
+		trap.Variables = append(trap.Variables, TrapVariable{
+			Oid:   name,
+			Name:  mibs.Translate(name),
+			Type:  strings.TrimSpace(pdu.Type.String()),
+			Value: TrapValue(pdu),
+		})
+	}
+	if trap.TrapOID != "" {
+		trap.TrapName = mibs.Translate(trap.TrapOID)
+	}
+	return trap
+}
+
+// TrapValue The text of a varbind value, an OID value is translated and
+// binary octets such as MAC addresses are written as hex
+func TrapValue(pdu gosnmp.SnmpPDU) string {
+	switch pdu.Type {
+	case gosnmp.Null, gosnmp.NoSuchObject, gosnmp.NoSuchInstance, gosnmp.EndOfMibView:
+		return ""
+	case gosnmp.ObjectIdentifier:
+		return mibs.Translate(normalizeOid(fmt.Sprint(pdu.Value)))
+	case gosnmp.OctetString:
+		b, _ := pdu.Value.([]byte)
+		if isPrintable(b) {
+			return strings.TrimRight(string(b), "\x00")
+		}
+		parts := make([]string, len(b))
+		for i := range b {
+			parts[i] = hex.EncodeToString(b[i : i+1])
+		}
+		return strings.Join(parts, ":")
+	case gosnmp.IPAddress:
+		switch v := pdu.Value.(type) {
+		case []byte:
+			return net.IP(v).String()
+		case string:
+			return v
+		}
+	}
+	switch v := pdu.Value.(type) {
+	case []byte:
+		return hex.EncodeToString(v)
+	case string:
+		return v
+	case nil:
+		return ""
+	}
+	return gosnmp.ToBigInt(pdu.Value).String()
+}
+
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range strings.TrimRight(string(b), "\x00") {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func normalizeOid(oid string) string {
+	if oid == "" {
+		return ""
+	}
+	return "." + strings.TrimPrefix(oid, ".")
+}
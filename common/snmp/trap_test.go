@@ -0,0 +1,268 @@
+package snmp
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+)
+
+const testSenderEngineID = "\x80\x00\x1f\x88\x80trap-sender"
+
+type testTrapServer struct {
+	*TrapServer
+	addr   *net.UDPAddr
+	traps  chan *Trap
+	errMu  sync.Mutex
+	errors []error
+}
+
+func newTestTrapServer(t *testing.T, community string, users ...SnmpAuth) *testTrapServer {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := &testTrapServer{addr: conn.LocalAddr().(*net.UDPAddr), traps: make(chan *Trap, 16)}
+	ts.TrapServer = &TrapServer{
+		Community: community,
+		Handler: func(trap *Trap) error {
+			ts.traps <- trap
+			return nil
+		},
+		OnError: func(addr net.Addr, err error) {
+			ts.errMu.Lock()
+			ts.errors = append(ts.errors, err)
+			ts.errMu.Unlock()
+		},
+	}
+	if err = ts.SetUsers(users); err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		if err := ts.Serve(conn); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(func() {
+		_ = ts.Close()
+	})
+	return ts
+}
+
+func (ts *testTrapServer) sender(t *testing.T, params *gosnmp.GoSNMP) *gosnmp.GoSNMP {
+	params.Target = "127.0.0.1"
+	params.Port = uint16(ts.addr.Port)
+	params.Timeout = 500 * time.Millisecond
+	params.Retries = 1
+	if err := params.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = params.Conn.Close()
+	})
+	return params
+}
+
+func (ts *testTrapServer) next(t *testing.T) *Trap {
+	select {
+	case trap := <-ts.traps:
+		return trap
+	case <-time.After(2 * time.Second):
+		t.Fatal("no trap received")
+		return nil
+	}
+}
+
+func (ts *testTrapServer) expectNothing(t *testing.T) {
+	select {
+	case trap := <-ts.traps:
+		t.Fatalf("unexpected trap %+v", trap)
+	case <-time.After(300 * time.Millisecond):
+	}
+	ts.errMu.Lock()
+	defer ts.errMu.Unlock()
+	if len(ts.errors) == 0 {
+		t.Fatal("the dropped notification was not reported")
+	}
+}
+
+var linkDownVariables = []gosnmp.SnmpPDU{
+	{Name: SysUpTimeOID, Type: gosnmp.TimeTicks, Value: uint32(4200)},
+	{Name: SnmpTrapOID, Type: gosnmp.ObjectIdentifier, Value: hostresmib.IF_MIB_linkDown_OID},
+	{Name: hostresmib.IF_MIB_ifIndex_OID + ".3", Type: gosnmp.Integer, Value: 3},
+	{Name: hostresmib.IF_MIB_ifAdminStatus_OID + ".3", Type: gosnmp.Integer, Value: 1},
+	{Name: hostresmib.IF_MIB_ifOperStatus_OID + ".3", Type: gosnmp.Integer, Value: 2},
+	{Name: hostresmib.IF_MIB_ifDescr_OID + ".3", Type: gosnmp.OctetString, Value: []byte("GigabitEthernet0/3")},
+}
+
+func checkLinkDown(t *testing.T, trap *Trap) {
+	if trap.TrapOID != ".1.3.6.1.6.3.1.1.5.3" || trap.TrapName != "IF-MIB::linkDown" || trap.Uptime != 4200 {
+		t.Fatalf("unexpected trap %+v", trap)
+	}
+	if len(trap.Variables) != 4 {
+		t.Fatalf("unexpected variables %+v", trap.Variables)
+	}
+	if v := trap.Variables[2]; v.Name != "IF-MIB::ifOperStatus.3" || v.Value != "2" || v.Type != "Integer" {
+		t.Errorf("ifOperStatus %+v", v)
+	}
+	if v := trap.Variables[3]; v.Name != "IF-MIB::ifDescr.3" || v.Value != "GigabitEthernet0/3" {
+		t.Errorf("ifDescr %+v", v)
+	}
+}
+
+func TestTrapV2c(t *testing.T) {
+	ts := newTestTrapServer(t, "traps")
+	sender := ts.sender(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "traps"})
+	if _, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables}); err != nil {
+		t.Fatal(err)
+	}
+	trap := ts.next(t)
+	checkLinkDown(t, trap)
+	if trap.Version != VersionV2c || trap.Inform || !trap.Addr.IP.IsLoopback() {
+		t.Errorf("unexpected trap %+v", trap)
+	}
+
+	// an inform is acknowledged
+	if _, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables, IsInform: true}); err != nil {
+		t.Fatal(err)
+	}
+	if trap = ts.next(t); !trap.Inform {
+		t.Errorf("not an inform %+v", trap)
+	}
+
+	wrong := ts.sender(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "public"})
+	if _, err := wrong.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables}); err != nil {
+		t.Fatal(err)
+	}
+	ts.expectNothing(t)
+	if !errors.Is(ts.errors[0], ErrTrapCommunity) {
+		t.Errorf("unexpected error %v", ts.errors[0])
+	}
+}
+
+func TestTrapV1(t *testing.T) {
+	ts := newTestTrapServer(t, "")
+	sender := ts.sender(t, &gosnmp.GoSNMP{Version: gosnmp.Version1, Community: "public"})
+	_, err := sender.SendTrap(gosnmp.SnmpTrap{
+		Enterprise:   ".1.3.6.1.4.1.14988.1",
+		AgentAddress: "10.0.0.1",
+		GenericTrap:  0,
+		Timestamp:    300,
+		Variables: []gosnmp.SnmpPDU{
+			{Name: ".1.3.6.1.2.1.1.5.0", Type: gosnmp.OctetString, Value: []byte("core-1")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	trap := ts.next(t)
+	if trap.Version != "v1" || trap.TrapName != "SNMPv2-MIB::coldStart" || trap.Uptime != 300 ||
+		trap.AgentAddress != "10.0.0.1" || trap.Enterprise != ".1.3.6.1.4.1.14988.1" {
+		t.Fatalf("unexpected trap %+v", trap)
+	}
+	if len(trap.Variables) != 1 || trap.Variables[0].Name != "SNMPv2-MIB::sysName.0" || trap.Variables[0].Value != "core-1" {
+		t.Errorf("unexpected variables %+v", trap.Variables)
+	}
+
+	// enterprise specific traps are enterprise.0.specific
+	_, err = sender.SendTrap(gosnmp.SnmpTrap{
+		Enterprise:   ".1.3.6.1.4.1.14988.1",
+		AgentAddress: "10.0.0.1",
+		GenericTrap:  6,
+		SpecificTrap: 7,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trap = ts.next(t); trap.TrapOID != ".1.3.6.1.4.1.14988.1.0.7" || trap.TrapName != "MIKROTIK-MIB::mikrotikExperimentalModule.0.7" {
+		t.Errorf("unexpected trap %s %s", trap.TrapOID, trap.TrapName)
+	}
+}
+
+func v3Sender(auth SnmpAuth, engineID string) *gosnmp.GoSNMP {
+	flags, params, _ := auth.UsmParameters()
+	params.AuthoritativeEngineID = engineID
+	return &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		MsgFlags:           flags,
+		SecurityParameters: params,
+	}
+}
+
+func TestTrapV3(t *testing.T) {
+	users := []SnmpAuth{
+		{SecurityLevel: AuthPriv, Username: "switches", AuthProtocol: "SHA", AuthPassword: "trap-auth-1",
+			PrivProtocol: "AES", PrivPassword: "trap-priv-1"},
+		{SecurityLevel: AuthNoPriv, Username: "routers", AuthProtocol: "MD5", AuthPassword: "trap-auth-2"},
+		{SecurityLevel: NoAuthNoPriv, Username: "lab"},
+	}
+	ts := newTestTrapServer(t, "", users...)
+	for _, auth := range users {
+		t.Run(auth.Username, func(t *testing.T) {
+			// a trap is sent with the keys localized to the sender's engine
+			sender := ts.sender(t, v3Sender(auth, testSenderEngineID))
+			if _, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables}); err != nil {
+				t.Fatal(err)
+			}
+			trap := ts.next(t)
+			checkLinkDown(t, trap)
+			if trap.Version != VersionV3 || trap.Username != auth.Username {
+				t.Errorf("unexpected trap %+v", trap)
+			}
+
+			// an inform is sent to the receiver's engine, discovered first
+			inform := ts.sender(t, v3Sender(auth, ""))
+			if _, err := inform.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables, IsInform: true}); err != nil {
+				t.Fatal(err)
+			}
+			if trap = ts.next(t); !trap.Inform || trap.Username != auth.Username {
+				t.Errorf("unexpected inform %+v", trap)
+			}
+		})
+	}
+}
+
+func TestTrapV3WrongCredentials(t *testing.T) {
+	auth := SnmpAuth{SecurityLevel: AuthPriv, Username: "switches", AuthProtocol: "SHA", AuthPassword: "trap-auth-3",
+		PrivProtocol: "AES", PrivPassword: "trap-priv-3"}
+	wrong := []SnmpAuth{auth, auth, auth, auth}
+	wrong[0].AuthPassword = "wrong-auth"
+	wrong[1].PrivPassword = "wrong-priv"
+	wrong[2].SecurityLevel = AuthNoPriv
+	wrong[3].Username = "unknown"
+	if err := (&TrapServer{}).SetUsers([]SnmpAuth{{SecurityLevel: AuthPriv}}); err == nil {
+		t.Error("a user without credentials accepted")
+	}
+	for _, w := range wrong {
+		ts := newTestTrapServer(t, "", auth)
+		sender := ts.sender(t, v3Sender(w, testSenderEngineID))
+		if _, err := sender.SendTrap(gosnmp.SnmpTrap{Variables: linkDownVariables}); err != nil {
+			t.Fatal(err)
+		}
+		ts.expectNothing(t)
+	}
+}
+
+func TestTrapValue(t *testing.T) {
+	cases := []struct {
+		pdu  gosnmp.SnmpPDU
+		want string
+	}{
+		{gosnmp.SnmpPDU{Type: gosnmp.OctetString, Value: []byte{0x00, 0x0c, 0x29, 0xab, 0xcd, 0xef}}, "00:0c:29:ab:cd:ef"},
+		{gosnmp.SnmpPDU{Type: gosnmp.OctetString, Value: []byte("port down\x00")}, "port down"},
+		{gosnmp.SnmpPDU{Type: gosnmp.ObjectIdentifier, Value: ".1.3.6.1.2.1.2.2.1.8.5"}, "IF-MIB::ifOperStatus.5"},
+		{gosnmp.SnmpPDU{Type: gosnmp.IPAddress, Value: "10.1.2.3"}, "10.1.2.3"},
+		{gosnmp.SnmpPDU{Type: gosnmp.Counter64, Value: uint64(1) << 40}, "1099511627776"},
+		{gosnmp.SnmpPDU{Type: gosnmp.NoSuchInstance}, ""},
+	}
+	for _, c := range cases {
+		if got := TrapValue(c.pdu); got != c.want {
+			t.Errorf("TrapValue(%+v) = %q, want %q", c.pdu, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package snmp
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+)
+
+const (
+	VersionV2c = "v2c"
+	VersionV3  = "v3"
+)
+
+// SNMPv3 security levels
+const (
+	NoAuthNoPriv = "noAuthNoPriv"
+	AuthNoPriv   = "authNoPriv"
+	AuthPriv     = "authPriv"
+)
+
+// SnmpClient A client of any SNMP version, the collectors work the same over v2c and v3
+type SnmpClient = SnmpV2Client
+
+var authProtocols = map[string]gosnmp.SnmpV3AuthProtocol{
+	"MD5":    gosnmp.MD5,
+	"SHA":    gosnmp.SHA,
+	"SHA224": gosnmp.SHA224,
+	"SHA256": gosnmp.SHA256,
+	"SHA384": gosnmp.SHA384,
+	"SHA512": gosnmp.SHA512,
+}
+
+var privProtocols = map[string]gosnmp.SnmpV3PrivProtocol{
+	"DES":     gosnmp.DES,
+	"AES":     gosnmp.AES,
+	"AES192":  gosnmp.AES192,
+	"AES256":  gosnmp.AES256,
+	"AES192C": gosnmp.AES192C,
+	"AES256C": gosnmp.AES256C,
+}
+
+// ParseAuthProtocol The USM authentication protocol of a name such as SHA or SHA256
+func ParseAuthProtocol(name string) (gosnmp.SnmpV3AuthProtocol, error) {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", ""))
+	if name == "SHA1" {
+		name = "SHA"
+	}
+	if p, ok := authProtocols[name]; ok {
+		return p, nil
+	}
+	return gosnmp.NoAuth, fmt.Errorf("unsupported snmp auth protocol %q", name)
+}
+
+// ParsePrivProtocol The USM privacy protocol of a name such as DES or AES
+func ParsePrivProtocol(name string) (gosnmp.SnmpV3PrivProtocol, error) {
+	name = strings.ToUpper(strings.ReplaceAll(name, "-", ""))
+	if name == "AES128" {
+		name = "AES"
+	}
+	if p, ok := privProtocols[name]; ok {
+		return p, nil
+	}
+	return gosnmp.NoPriv, fmt.Errorf("unsupported snmp privacy protocol %q", name)
+}
+
+// UsmParameters The SNMPv3 message flags and USM security parameters of the credentials
+func (auth SnmpAuth) UsmParameters() (gosnmp.SnmpV3MsgFlags, *gosnmp.UsmSecurityParameters, error) {
+	if auth.Username == "" {
+		return 0, nil, fmt.Errorf("snmp v3 username is required")
+	}
+	params := &gosnmp.UsmSecurityParameters{
+		UserName:               auth.Username,
+		AuthenticationProtocol: gosnmp.NoAuth,
+		PrivacyProtocol:        gosnmp.NoPriv,
+	}
+	var flags gosnmp.SnmpV3MsgFlags
+	var err error
+	switch auth.SecurityLevel {
+	case NoAuthNoPriv:
+		return gosnmp.NoAuthNoPriv, params, nil
+	case AuthNoPriv:
+		flags = gosnmp.AuthNoPriv
+	case AuthPriv, "":
+		flags = gosnmp.AuthPriv
+	default:
+		return 0, nil, fmt.Errorf("unsupported snmp security level %q", auth.SecurityLevel)
+	}
+	if params.AuthenticationProtocol, err = ParseAuthProtocol(auth.AuthProtocol); err != nil {
+		return 0, nil, err
+	}
+	if auth.AuthPassword == "" {
+		return 0, nil, fmt.Errorf("snmp v3 auth password is required")
+	}
+	params.AuthenticationPassphrase = auth.AuthPassword
+	if flags == gosnmp.AuthNoPriv {
+		return flags, params, nil
+	}
+	if params.PrivacyProtocol, err = ParsePrivProtocol(auth.PrivProtocol); err != nil {
+		return 0, nil, err
+	}
+	if auth.PrivPassword == "" {
+		return 0, nil, fmt.Errorf("snmp v3 privacy password is required")
+	}
+	params.PrivacyPassphrase = auth.PrivPassword
+	return flags, params, nil
+}
+
+// NewSnmpV3Client A USM client, the authoritative engine is discovered with the first request
+func NewSnmpV3Client(auth SnmpAuth) (*SnmpClient, error) {
+	flags, params, err := auth.UsmParameters()
+	if err != nil {
+		return nil, err
+	}
+	c := &SnmpClient{
+		Snmpc: &gosnmp.GoSNMP{
+			Port:               uint16(auth.Port),
+			Transport:          "udp",
+			Version:            gosnmp.Version3,
+			SecurityModel:      gosnmp.UserSecurityModel,
+			MsgFlags:           flags,
+			SecurityParameters: params,
+			ContextName:        auth.ContextName,
+			Timeout:            time.Duration(3) * time.Second,
+			Retries:            3,
+			ExponentialTimeout: true,
+			MaxOids:            gosnmp.MaxOids,
+			Target:             auth.Ipaddr,
+		},
+	}
+	err = c.Snmpc.Connect()
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// NewSnmpClient A client of the version of the credentials, v2c unless v3 is set
+func NewSnmpClient(auth SnmpAuth) (*SnmpClient, error) {
+	switch auth.Version {
+	case VersionV3:
+		return NewSnmpV3Client(auth)
+	case VersionV2c, "":
+		return NewSnmpV2Client2(auth)
+	}
+	return nil, fmt.Errorf("unsupported snmp version %q", auth.Version)
+}
@@ -0,0 +1,186 @@
+package snmp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/talkincode/logsight/common/snmp/mibs/hostresmib"
+)
+
+var testSystemValues = []gosnmp.SnmpPDU{
+	{Name: ".1.3.6.1.2.1.1.1.0", Type: gosnmp.OctetString, Value: []byte("Test Router 1.0")},
+	{Name: ".1.3.6.1.2.1.1.3.0", Type: gosnmp.TimeTicks, Value: uint32(123456)},
+	{Name: ".1.3.6.1.2.1.1.5.0", Type: gosnmp.OctetString, Value: []byte("core-1")},
+	{Name: hostresmib.HOST_RESOURCES_MIB_hrProcessorLoad_OID + ".1", Type: gosnmp.Integer, Value: 10},
+	{Name: hostresmib.HOST_RESOURCES_MIB_hrProcessorLoad_OID + ".2", Type: gosnmp.Integer, Value: 30},
+	{Name: hostresmib.HOST_RESOURCES_MIB_hrStorageDescr_OID + ".1", Type: gosnmp.OctetString, Value: []byte("main memory")},
+	{Name: ".1.3.6.1.2.1.25.2.3.1.4.1", Type: gosnmp.Integer, Value: 1024},
+	{Name: ".1.3.6.1.2.1.25.2.3.1.5.1", Type: gosnmp.Integer, Value: 1000},
+	{Name: ".1.3.6.1.2.1.25.2.3.1.6.1", Type: gosnmp.Integer, Value: 250},
+	{Name: hostresmib.IF_MIB_ifIndex_OID + ".1", Type: gosnmp.Integer, Value: 1},
+	{Name: hostresmib.IF_MIB_ifIndex_OID + ".2", Type: gosnmp.Integer, Value: 2},
+	{Name: hostresmib.IF_MIB_ifDescr_OID + ".1", Type: gosnmp.OctetString, Value: []byte("ether1")},
+	{Name: hostresmib.IF_MIB_ifDescr_OID + ".2", Type: gosnmp.OctetString, Value: []byte("ether2")},
+	{Name: hostresmib.IF_MIB_ifType_OID + ".1", Type: gosnmp.Integer, Value: 6},
+	{Name: hostresmib.IF_MIB_ifType_OID + ".2", Type: gosnmp.Integer, Value: 6},
+	{Name: hostresmib.IF_MIB_ifInOctets_OID + ".1", Type: gosnmp.Counter32, Value: uint32(1000)},
+	{Name: hostresmib.IF_MIB_ifInOctets_OID + ".2", Type: gosnmp.Counter32, Value: uint32(2000)},
+	{Name: hostresmib.IF_MIB_ifOutOctets_OID + ".1", Type: gosnmp.Counter32, Value: uint32(3000)},
+	{Name: hostresmib.IF_MIB_ifOutOctets_OID + ".2", Type: gosnmp.Counter32, Value: uint32(4000)},
+}
+
+// newUsmAgent An agent with one user at the security level of auth
+func newUsmAgent(t *testing.T, auth SnmpAuth) *testAgent {
+	flags, params, err := auth.UsmParameters()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newTestAgent(t, &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		MsgFlags:           flags,
+		SecurityParameters: params,
+	}, testSystemValues)
+}
+
+// shortTimeout Fail fast on requests the agent drops
+func shortTimeout(c *SnmpClient) {
+	c.Snmpc.Timeout = 300 * time.Millisecond
+	c.Snmpc.Retries = 0
+	c.Snmpc.ExponentialTimeout = false
+}
+
+func TestSnmpV3SecurityLevels(t *testing.T) {
+	cases := []SnmpAuth{
+		{SecurityLevel: NoAuthNoPriv, Username: "public-ro"},
+		{SecurityLevel: AuthNoPriv, Username: "md5", AuthProtocol: "MD5", AuthPassword: "authpass-md5"},
+		{SecurityLevel: AuthNoPriv, Username: "sha", AuthProtocol: "SHA", AuthPassword: "authpass-sha"},
+		{SecurityLevel: AuthPriv, Username: "sha-des", AuthProtocol: "SHA", AuthPassword: "authpass-1",
+			PrivProtocol: "DES", PrivPassword: "privpass-1"},
+		{SecurityLevel: AuthPriv, Username: "sha256-aes", AuthProtocol: "SHA256", AuthPassword: "authpass-2",
+			PrivProtocol: "AES", PrivPassword: "privpass-2"},
+		{SecurityLevel: AuthPriv, Username: "sha512-aes256", AuthProtocol: "SHA-512", AuthPassword: "authpass-3",
+			PrivProtocol: "AES256", PrivPassword: "privpass-3"},
+	}
+	for _, auth := range cases {
+		t.Run(auth.Username, func(t *testing.T) {
+			agent := newUsmAgent(t, auth)
+			auth.Version = VersionV3
+			auth.Ipaddr = "127.0.0.1"
+			auth.Port = agent.port()
+			client, err := NewSnmpClient(auth)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			shortTimeout(client)
+
+			device := NewGenericDevice()
+			if err = client.CollectDeviceSystemInfo(device); err != nil {
+				t.Fatal(err)
+			}
+			if device.SystemName != "core-1" || device.Model != "Test Router 1.0" || device.Uptime != 123456 {
+				t.Fatalf("unexpected system info %+v", device)
+			}
+		})
+	}
+}
+
+func TestSnmpV3CollectDevice(t *testing.T) {
+	auth := SnmpAuth{SecurityLevel: AuthPriv, Username: "collector", AuthProtocol: "SHA", AuthPassword: "authpass-4",
+		PrivProtocol: "AES", PrivPassword: "privpass-4"}
+	agent := newUsmAgent(t, auth)
+	auth.Version = VersionV3
+	auth.Ipaddr = "127.0.0.1"
+	auth.Port = agent.port()
+	client, err := NewSnmpClient(auth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	shortTimeout(client)
+
+	device := NewGenericDevice()
+	if err = client.CollectDevice(device); err != nil {
+		t.Fatal(err)
+	}
+	if device.CpuLoad != 20 {
+		t.Errorf("cpu load %v, want 20", device.CpuLoad)
+	}
+	if device.MemTotal != 1000*1024 || device.MemPercent != 25 {
+		t.Errorf("memory %d %v", device.MemTotal, device.MemPercent)
+	}
+	if len(device.IfOctetsMap) != 2 {
+		t.Fatalf("interfaces %+v", device.IfOctetsMap)
+	}
+	if iface := device.IfOctetsMap[2]; iface.Name != "ether2" || iface.InOctets != 2000 || iface.OutOctets != 4000 {
+		t.Errorf("interface 2 %+v", iface)
+	}
+}
+
+func TestSnmpV3WrongCredentials(t *testing.T) {
+	auth := SnmpAuth{SecurityLevel: AuthPriv, Username: "collector", AuthProtocol: "SHA", AuthPassword: "authpass-5",
+		PrivProtocol: "AES", PrivPassword: "privpass-5"}
+	agent := newUsmAgent(t, auth)
+	wrong := []SnmpAuth{auth, auth, auth}
+	wrong[0].AuthPassword = "wrong-auth"
+	wrong[1].PrivPassword = "wrong-priv"
+	wrong[2].SecurityLevel = AuthNoPriv
+	for _, auth := range wrong {
+		auth.Version = VersionV3
+		auth.Ipaddr = "127.0.0.1"
+		auth.Port = agent.port()
+		client, err := NewSnmpClient(auth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shortTimeout(client)
+		if err = client.CollectDeviceSystemInfo(NewGenericDevice()); err == nil {
+			t.Errorf("%+v collected with wrong credentials", auth)
+		}
+		_ = client.Close()
+	}
+}
+
+func TestSnmpV2cAgent(t *testing.T) {
+	agent := newTestAgent(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "secret"}, testSystemValues)
+	client, err := NewSnmpClient(SnmpAuth{Ipaddr: "127.0.0.1", Port: agent.port(), Community: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	shortTimeout(client)
+	device := NewGenericDevice()
+	if err = client.CollectDeviceSystemInfo(device); err != nil || device.SystemName != "core-1" {
+		t.Fatalf("v2c collect %v %+v", err, device)
+	}
+}
+
+func TestUsmParameters(t *testing.T) {
+	invalid := []SnmpAuth{
+		{SecurityLevel: AuthPriv},
+		{SecurityLevel: "authAndPriv", Username: "u"},
+		{SecurityLevel: AuthNoPriv, Username: "u", AuthProtocol: "SHA"},
+		{SecurityLevel: AuthNoPriv, Username: "u", AuthProtocol: "SHA3", AuthPassword: "p"},
+		{SecurityLevel: AuthPriv, Username: "u", AuthProtocol: "MD5", AuthPassword: "p", PrivProtocol: "3DES", PrivPassword: "p"},
+		{SecurityLevel: AuthPriv, Username: "u", AuthProtocol: "MD5", AuthPassword: "p", PrivProtocol: "AES"},
+	}
+	for _, auth := range invalid {
+		if _, _, err := auth.UsmParameters(); err == nil {
+			t.Errorf("%+v accepted", auth)
+		}
+	}
+	flags, params, err := SnmpAuth{Username: "u", AuthProtocol: "sha256", AuthPassword: "p",
+		PrivProtocol: "aes-128", PrivPassword: "q"}.UsmParameters()
+	if err != nil || flags != gosnmp.AuthPriv || params.AuthenticationProtocol != gosnmp.SHA256 ||
+		params.PrivacyProtocol != gosnmp.AES {
+		t.Errorf("default security level %v %v %+v", err, flags, params)
+	}
+	if _, err = NewSnmpClient(SnmpAuth{Version: "v1"}); err == nil {
+		t.Error("v1 accepted")
+	}
+	if p, err := ParseAuthProtocol("md5"); err != nil || p != gosnmp.MD5 {
+		t.Error(p, err)
+	}
+}
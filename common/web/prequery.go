@@ -1,12 +1,16 @@
 package web
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/fts"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PreQuery struct {
@@ -16,15 +20,19 @@ type PreQuery struct {
 	timeField        string
 	equalFieldds     []string
 	keyfilterFieldds []string
+	jsonPathFields   map[string]string
+	fullTextField    string
+	fullTextIndexed  bool
 	params           map[string]interface{}
 	form             *WebForm
 }
 
 func NewPreQuery(c echo.Context) *PreQuery {
 	return &PreQuery{
-		context: c,
-		form:    NewWebForm(c),
-		params:  make(map[string]interface{}),
+		context:        c,
+		form:           NewWebForm(c),
+		params:         make(map[string]interface{}),
+		jsonPathFields: make(map[string]string),
 	}
 }
 
@@ -67,6 +75,21 @@ func (p *PreQuery) KeyFields(fd ...string) *PreQuery {
 	return p
 }
 
+// JsonPathField Keyword terms like prefix.key.param=value filter on a jsonb column
+func (p *PreQuery) JsonPathField(prefix, column string) *PreQuery {
+	p.jsonPathFields[prefix] = column
+	return p
+}
+
+// FullTextField The keyword is a search query on column (see package fts),
+// matched by the text search index when indexed, otherwise with ILIKE.
+// With rank=1 indexed results are ordered by relevance
+func (p *PreQuery) FullTextField(column string, indexed bool) *PreQuery {
+	p.fullTextField = column
+	p.fullTextIndexed = indexed
+	return p
+}
+
 func (p *PreQuery) QueryField(column, qfield string) *PreQuery {
 	value := p.form.GetVal(qfield)
 	if value != "" {
@@ -81,6 +104,36 @@ func (p *PreQuery) SetParam(key string, value interface{}) *PreQuery {
 }
 
 func (p *PreQuery) Query(query *gorm.DB) *gorm.DB {
+	keyword := p.context.QueryParam("keyword")
+	for prefix, column := range p.jsonPathFields {
+		var terms []JsonPathTerm
+		terms, keyword = ParseJsonPathTerms(keyword, prefix)
+		for _, term := range terms {
+			query = term.Apply(query, column)
+		}
+	}
+
+	if p.fullTextField != "" && keyword != "" {
+		node, err := fts.Parse(keyword)
+		switch {
+		case err != nil:
+			// not a valid search query, look for the literal text
+			query = query.Where(p.fullTextField+" ILIKE ?", "%"+keyword+"%")
+		case node == nil:
+		case p.fullTextIndexed:
+			tsquery := node.TsQuery()
+			query = query.Where(fts.Match(p.fullTextField), tsquery)
+			if p.form.GetVal("rank") == "1" {
+				query = query.Order(clause.OrderBy{Expression: clause.Expr{
+					SQL: fts.Rank(p.fullTextField) + " DESC", Vars: []interface{}{tsquery}}})
+			}
+		default:
+			sql, args := node.Like(p.fullTextField)
+			query = query.Where(sql, args...)
+		}
+		keyword = ""
+	}
+
 	if len(ParseSortMap(p.context)) == 0 {
 		query = query.Order(p.defaultOrderby)
 	} else {
@@ -125,7 +178,6 @@ func (p *PreQuery) Query(query *gorm.DB) *gorm.DB {
 		}
 	}
 
-	keyword := p.context.QueryParam("keyword")
 	if keyword != "" {
 		for i, keyfd := range p.keyfilterFieldds {
 			if i == 0 {
@@ -138,3 +190,74 @@ func (p *PreQuery) Query(query *gorm.DB) *gorm.DB {
 
 	return query
 }
+
+// JsonPathTerm A keyword term filtering on a two level jsonb column,
+// written as prefix.key.param=value, prefix.key.param or prefix.key
+type JsonPathTerm struct {
+	Key      string
+	Param    string
+	Value    string
+	HasValue bool
+}
+
+// Apply Add the term condition on column, values are always passed as query parameters
+func (t JsonPathTerm) Apply(query *gorm.DB, column string) *gorm.DB {
+	switch {
+	case t.HasValue:
+		bs, _ := json.Marshal(map[string]map[string]string{t.Key: {t.Param: t.Value}})
+		return query.Where(column+" @> ?::jsonb", string(bs))
+	case t.Param != "":
+		return query.Where("("+column+" -> ? ->> ?) IS NOT NULL", t.Key, t.Param)
+	default:
+		return query.Where("("+column+" -> ?) IS NOT NULL", t.Key)
+	}
+}
+
+// ParseJsonPathTerms Take the terms starting with prefix out of keyword,
+// values containing spaces may be double quoted
+func ParseJsonPathTerms(keyword, prefix string) (terms []JsonPathTerm, rest string) {
+	var others []string
+	for _, token := range splitKeywordTokens(keyword) {
+		if !strings.HasPrefix(token, prefix+".") {
+			others = append(others, token)
+			continue
+		}
+		path, value, hasValue := strings.Cut(token[len(prefix)+1:], "=")
+		term := JsonPathTerm{Key: path, HasValue: hasValue, Value: strings.Trim(value, `"`)}
+		if i := strings.LastIndex(path, "."); i > 0 {
+			term.Key, term.Param = path[:i], path[i+1:]
+		} else if hasValue {
+			// a value needs both the key and the param
+			continue
+		}
+		if term.Key == "" {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms, strings.Join(others, " ")
+}
+
+func splitKeywordTokens(keyword string) []string {
+	var tokens []string
+	var buf strings.Builder
+	quoted := false
+	for _, r := range keyword {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			buf.WriteRune(r)
+		case r == ' ' && !quoted:
+			if buf.Len() > 0 {
+				tokens = append(tokens, buf.String())
+				buf.Reset()
+			}
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	if buf.Len() > 0 {
+		tokens = append(tokens, buf.String())
+	}
+	return tokens
+}
@@ -0,0 +1,53 @@
+package web
+
+import (
+	"testing"
+)
+
+func TestParseJsonPathTerms(t *testing.T) {
+	terms, rest := ParseJsonPathTerms(`timeout sd.origin.ip=10.0.0.1 sd.meta@32473.tenant="acme corp" sd.request error`, "sd")
+	if rest != "timeout error" {
+		t.Errorf("unexpected rest keyword %q", rest)
+	}
+	expect := []JsonPathTerm{
+		{Key: "origin", Param: "ip", Value: "10.0.0.1", HasValue: true},
+		{Key: "meta@32473", Param: "tenant", Value: "acme corp", HasValue: true},
+		{Key: "request"},
+	}
+	if len(terms) != len(expect) {
+		t.Fatalf("expected %d terms, got %+v", len(expect), terms)
+	}
+	for i := range expect {
+		if terms[i] != expect[i] {
+			t.Errorf("term %d: expected %+v, got %+v", i, expect[i], terms[i])
+		}
+	}
+}
+
+func TestParseJsonPathTermsInvalid(t *testing.T) {
+	terms, rest := ParseJsonPathTerms("sd.origin=1 sd. sdx.a.b=1", "sd")
+	if len(terms) != 0 {
+		t.Errorf("expected no terms, got %+v", terms)
+	}
+	if rest != "sdx.a.b=1" {
+		t.Errorf("unexpected rest keyword %q", rest)
+	}
+}
+
+func TestParamColumn(t *testing.T) {
+	tests := map[string]string{
+		"sort[name]":             "name",
+		"sort[t.created_at]":     "t.created_at",
+		"sort[name desc;drop x]": "",
+		"sort[(select 1)]":       "",
+		"sort[name]x":            "",
+		"filter[name]":           "",
+		"sort[a.b.c]":            "",
+		"sort[1name]":            "",
+	}
+	for key, want := range tests {
+		if got := paramColumn(key, "sort"); got != want {
+			t.Errorf("%s: got %q, want %q", key, got, want)
+		}
+	}
+}
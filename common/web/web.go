@@ -24,6 +24,7 @@ import (
 	"io"
 	"log"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -356,14 +357,40 @@ func ReadImportCsvData(src io.Reader) ([]map[string]interface{}, error) {
 
 }
 
+// columnNamePattern Sort and filter keys are put in SQL as column names,
+// anything but a plain or table qualified identifier is ignored
+var columnNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// paramColumn The column name of a query parameter such as sort[name], empty when invalid
+func paramColumn(key, prefix string) string {
+	if !strings.HasPrefix(key, prefix+"[") || !strings.HasSuffix(key, "]") {
+		return ""
+	}
+	name := key[len(prefix)+1 : len(key)-1]
+	if !columnNamePattern.MatchString(name) {
+		return ""
+	}
+	return name
+}
+
+// ParseFormTime Parse a local time posted by a webix datepicker
+func ParseFormTime(value string) (t time.Time, err error) {
+	for _, layout := range []string{"2006-01-02 15:04:05", "2006-01-02 15:04", time.RFC3339} {
+		if t, err = time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return t, err
+}
+
 func ParseSortMap(c echo.Context) map[string]string {
 	sortmap := make(map[string]string)
 	for k, vs := range c.QueryParams() {
 		switch {
-		case strings.HasPrefix(k, "sort[") && vs[0] != "":
+		case paramColumn(k, "sort") != "" && vs[0] != "":
 			if common.InSlice(vs[0], []string{"asc", "desc"}) {
 				// 排序参数
-				sortmap[k[5:len(k)-1]] = vs[0]
+				sortmap[paramColumn(k, "sort")] = vs[0]
 			}
 		}
 	}
@@ -374,9 +401,9 @@ func ParseFilterMap(c echo.Context) map[string]string {
 	filtermap := make(map[string]string)
 	for k, vs := range c.QueryParams() {
 		switch {
-		case strings.HasPrefix(k, "filter[") && vs[0] != "":
+		case paramColumn(k, "filter") != "" && vs[0] != "":
 			// 查询参数
-			filtermap[k[7:len(k)-1]] = vs[0]
+			filtermap[paramColumn(k, "filter")] = vs[0]
 		}
 	}
 	return filtermap
@@ -386,9 +413,9 @@ func ParseEqualMap(c echo.Context) map[string]string {
 	filtermap := make(map[string]string)
 	for k, vs := range c.QueryParams() {
 		switch {
-		case strings.HasPrefix(k, "equal[") && vs[0] != "":
+		case paramColumn(k, "equal") != "" && vs[0] != "":
 			// 查询参数
-			filtermap[k[6:len(k)-1]] = vs[0]
+			filtermap[paramColumn(k, "equal")] = vs[0]
 		}
 	}
 	return filtermap
@@ -54,9 +54,63 @@ type LogConfig struct {
 }
 
 type SyslogdConfig struct {
-	Host  string `yaml:"host" json:"host"`
-	Port  int    `yaml:"port" json:"port"`
-	Debug bool   `yaml:"debug" json:"debug"`
+	Host          string `yaml:"host" json:"host"`
+	Port          int    `yaml:"port" json:"port"`
+	TcpEnable     bool   `yaml:"tcp_enable" json:"tcp_enable"`
+	TcpPort       int    `yaml:"tcp_port" json:"tcp_port"`
+	TlsEnable     bool   `yaml:"tls_enable" json:"tls_enable"`
+	TlsPort       int    `yaml:"tls_port" json:"tls_port"`
+	Workers       int    `yaml:"workers" json:"workers"`
+	QueueSize     int    `yaml:"queue_size" json:"queue_size"`
+	QueuePolicy   string `yaml:"queue_policy" json:"queue_policy"`
+	BatchSize     int    `yaml:"batch_size" json:"batch_size"`
+	FlushInterval int    `yaml:"flush_interval" json:"flush_interval"`
+	ClockPolicy   string `yaml:"clock_policy" json:"clock_policy"`
+	MaxClockSkew  int    `yaml:"max_clock_skew" json:"max_clock_skew"`
+	// MaxMessageSize Maximum size of a TCP/TLS message in bytes, UDP is always limited to 64 KB
+	MaxMessageSize        int    `yaml:"max_message_size" json:"max_message_size"`
+	MultilineEnable       bool   `yaml:"multiline_enable" json:"multiline_enable"`
+	MultilineStartPattern string `yaml:"multiline_start_pattern" json:"multiline_start_pattern"`
+	MultilineTimeout      int    `yaml:"multiline_timeout" json:"multiline_timeout"` // milliseconds
+	MultilineMaxLines     int    `yaml:"multiline_max_lines" json:"multiline_max_lines"`
+	RetentionDays         int    `yaml:"retention_days" json:"retention_days"` // default retention, 180 when unset, negative keeps records forever
+	RetentionSchedule     string `yaml:"retention_schedule" json:"retention_schedule"`
+	Debug                 bool   `yaml:"debug" json:"debug"`
+}
+
+// RadiusdConfig The built-in RADIUS accounting server, NAS devices and their secrets are managed in the UI
+type RadiusdConfig struct {
+	Enabled  bool   `yaml:"enabled" json:"enabled"`
+	Host     string `yaml:"host" json:"host"`
+	AcctPort int    `yaml:"acct_port" json:"acct_port"`
+	Workers  int    `yaml:"workers" json:"workers"`
+	// InterimInterval Seconds assumed between interim updates when the NAS does not announce it
+	InterimInterval int `yaml:"interim_interval" json:"interim_interval"`
+	// StaleMultiple Sessions missing this many interim updates are closed by the reaper
+	StaleMultiple int  `yaml:"stale_multiple" json:"stale_multiple"`
+	Debug         bool `yaml:"debug" json:"debug"`
+}
+
+// SnmpConfig The SNMP poller and trap receiver, devices and their poll intervals are managed in the UI
+type SnmpConfig struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// Workers Devices polled at the same time
+	Workers     int    `yaml:"workers" json:"workers"`
+	TrapEnabled bool   `yaml:"trap_enabled" json:"trap_enabled"`
+	TrapHost    string `yaml:"trap_host" json:"trap_host"`
+	TrapPort    int    `yaml:"trap_port" json:"trap_port"`
+	// TrapCommunity Of v1 and v2c traps, empty accepts any community
+	TrapCommunity string `yaml:"trap_community" json:"trap_community"`
+	// TrapEngineID Hex engine id v3 informs are sent to, empty uses a built-in one
+	TrapEngineID string `yaml:"trap_engine_id" json:"trap_engine_id"`
+	// v3 trap user, the v3 credentials of the devices are accepted as well
+	TrapUsername      string `yaml:"trap_username" json:"trap_username"`
+	TrapSecurityLevel string `yaml:"trap_security_level" json:"trap_security_level"`
+	TrapAuthProtocol  string `yaml:"trap_auth_protocol" json:"trap_auth_protocol"`
+	TrapAuthPassword  string `yaml:"trap_auth_password" json:"trap_auth_password"`
+	TrapPrivProtocol  string `yaml:"trap_priv_protocol" json:"trap_priv_protocol"`
+	TrapPrivPassword  string `yaml:"trap_priv_password" json:"trap_priv_password"`
+	Debug             bool   `yaml:"debug" json:"debug"`
 }
 
 type AppConfig struct {
@@ -64,6 +118,8 @@ type AppConfig struct {
 	Web      WebConfig     `yaml:"web" json:"web"`
 	Database DBConfig      `yaml:"database" json:"database"`
 	Syslogd  SyslogdConfig `yaml:"syslogd" json:"syslogd"`
+	Radiusd  RadiusdConfig `yaml:"radiusd" json:"radiusd"`
+	Snmp     SnmpConfig    `yaml:"snmp" json:"snmp"`
 	Logger   LogConfig     `yaml:"logger" json:"logger"`
 }
 
@@ -86,6 +142,10 @@ func (c *AppConfig) GetBackupDir() string {
 	return path.Join(c.System.Workdir, "backup")
 }
 
+func (c *AppConfig) GetReportDir() string {
+	return path.Join(c.System.Workdir, "reports")
+}
+
 func (c *AppConfig) initDirs() {
 	_ = os.MkdirAll(path.Join(c.System.Workdir, "logs"), 0755)
 	_ = os.MkdirAll(path.Join(c.System.Workdir, "public"), 0755)
@@ -93,6 +153,7 @@ func (c *AppConfig) initDirs() {
 	_ = os.MkdirAll(path.Join(c.System.Workdir, "data/metrics"), 0755)
 	_ = os.MkdirAll(path.Join(c.System.Workdir, "private"), 0644)
 	_ = os.MkdirAll(path.Join(c.System.Workdir, "backup"), 0644)
+	_ = os.MkdirAll(path.Join(c.System.Workdir, "reports"), 0755)
 }
 
 func setEnvValue(name string, val *string) {
@@ -157,9 +218,52 @@ var DefaultAppConfig = &AppConfig{
 		Debug:    false,
 	},
 	Syslogd: SyslogdConfig{
-		Host:  "0.0.0.0",
-		Port:  1814,
-		Debug: true,
+		Host:                  "0.0.0.0",
+		Port:                  1814,
+		TcpEnable:             false,
+		TcpPort:               1814,
+		TlsEnable:             false,
+		TlsPort:               6514,
+		Workers:               8,
+		QueueSize:             100000,
+		QueuePolicy:           "drop_oldest",
+		BatchSize:             1000,
+		FlushInterval:         1000,
+		ClockPolicy:           "auto",
+		MaxClockSkew:          86400,
+		MaxMessageSize:        256 * 1024,
+		MultilineEnable:       false,
+		MultilineStartPattern: `^\S`,
+		MultilineTimeout:      1000,
+		MultilineMaxLines:     500,
+		RetentionDays:         180,
+		RetentionSchedule:     "0 30 3 * * *",
+		Debug:                 true,
+	},
+	Radiusd: RadiusdConfig{
+		Enabled:         false,
+		Host:            "0.0.0.0",
+		AcctPort:        1813,
+		Workers:         4,
+		InterimInterval: 300,
+		StaleMultiple:   3,
+		Debug:           false,
+	},
+	Snmp: SnmpConfig{
+		Enabled:           true,
+		Workers:           16,
+		TrapEnabled:       false,
+		TrapHost:          "0.0.0.0",
+		TrapPort:          162,
+		TrapCommunity:     "",
+		TrapEngineID:      "",
+		TrapUsername:      "",
+		TrapSecurityLevel: "authPriv",
+		TrapAuthProtocol:  "SHA",
+		TrapAuthPassword:  "",
+		TrapPrivProtocol:  "AES",
+		TrapPrivPassword:  "",
+		Debug:             false,
 	},
 	Logger: LogConfig{
 		Mode:           "development",
@@ -200,8 +304,50 @@ func LoadConfig(cfile string) *AppConfig {
 
 	setEnvValue("LOGSIGHT_SYSLOG_HOST", &cfg.Syslogd.Host)
 	setEnvIntValue("LOGSIGHT_SYSLOG_PORT", &cfg.Syslogd.Port)
+	setEnvBoolValue("LOGSIGHT_SYSLOG_TCP_ENABLE", &cfg.Syslogd.TcpEnable)
+	setEnvIntValue("LOGSIGHT_SYSLOG_TCP_PORT", &cfg.Syslogd.TcpPort)
+	setEnvBoolValue("LOGSIGHT_SYSLOG_TLS_ENABLE", &cfg.Syslogd.TlsEnable)
+	setEnvIntValue("LOGSIGHT_SYSLOG_TLS_PORT", &cfg.Syslogd.TlsPort)
+	setEnvIntValue("LOGSIGHT_SYSLOG_WORKERS", &cfg.Syslogd.Workers)
+	setEnvIntValue("LOGSIGHT_SYSLOG_QUEUE_SIZE", &cfg.Syslogd.QueueSize)
+	setEnvValue("LOGSIGHT_SYSLOG_QUEUE_POLICY", &cfg.Syslogd.QueuePolicy)
+	setEnvIntValue("LOGSIGHT_SYSLOG_BATCH_SIZE", &cfg.Syslogd.BatchSize)
+	setEnvIntValue("LOGSIGHT_SYSLOG_FLUSH_INTERVAL", &cfg.Syslogd.FlushInterval)
+	setEnvValue("LOGSIGHT_SYSLOG_CLOCK_POLICY", &cfg.Syslogd.ClockPolicy)
+	setEnvIntValue("LOGSIGHT_SYSLOG_MAX_CLOCK_SKEW", &cfg.Syslogd.MaxClockSkew)
+	setEnvIntValue("LOGSIGHT_SYSLOG_MAX_MESSAGE_SIZE", &cfg.Syslogd.MaxMessageSize)
+	setEnvBoolValue("LOGSIGHT_SYSLOG_MULTILINE_ENABLE", &cfg.Syslogd.MultilineEnable)
+	setEnvValue("LOGSIGHT_SYSLOG_MULTILINE_START_PATTERN", &cfg.Syslogd.MultilineStartPattern)
+	setEnvIntValue("LOGSIGHT_SYSLOG_MULTILINE_TIMEOUT", &cfg.Syslogd.MultilineTimeout)
+	setEnvIntValue("LOGSIGHT_SYSLOG_MULTILINE_MAX_LINES", &cfg.Syslogd.MultilineMaxLines)
+	setEnvIntValue("LOGSIGHT_SYSLOG_RETENTION_DAYS", &cfg.Syslogd.RetentionDays)
+	setEnvValue("LOGSIGHT_SYSLOG_RETENTION_SCHEDULE", &cfg.Syslogd.RetentionSchedule)
 	setEnvBoolValue("LOGSIGHT_SYSLOG_DEBUG", &cfg.Syslogd.Debug)
 
+	setEnvBoolValue("LOGSIGHT_RADIUS_ENABLED", &cfg.Radiusd.Enabled)
+	setEnvValue("LOGSIGHT_RADIUS_HOST", &cfg.Radiusd.Host)
+	setEnvIntValue("LOGSIGHT_RADIUS_ACCT_PORT", &cfg.Radiusd.AcctPort)
+	setEnvIntValue("LOGSIGHT_RADIUS_WORKERS", &cfg.Radiusd.Workers)
+	setEnvIntValue("LOGSIGHT_RADIUS_INTERIM_INTERVAL", &cfg.Radiusd.InterimInterval)
+	setEnvIntValue("LOGSIGHT_RADIUS_STALE_MULTIPLE", &cfg.Radiusd.StaleMultiple)
+	setEnvBoolValue("LOGSIGHT_RADIUS_DEBUG", &cfg.Radiusd.Debug)
+
+	// SNMP
+	setEnvBoolValue("LOGSIGHT_SNMP_ENABLED", &cfg.Snmp.Enabled)
+	setEnvIntValue("LOGSIGHT_SNMP_WORKERS", &cfg.Snmp.Workers)
+	setEnvBoolValue("LOGSIGHT_SNMP_TRAP_ENABLED", &cfg.Snmp.TrapEnabled)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_HOST", &cfg.Snmp.TrapHost)
+	setEnvIntValue("LOGSIGHT_SNMP_TRAP_PORT", &cfg.Snmp.TrapPort)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_COMMUNITY", &cfg.Snmp.TrapCommunity)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_ENGINE_ID", &cfg.Snmp.TrapEngineID)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_USERNAME", &cfg.Snmp.TrapUsername)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_SECURITY_LEVEL", &cfg.Snmp.TrapSecurityLevel)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_AUTH_PROTOCOL", &cfg.Snmp.TrapAuthProtocol)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_AUTH_PASSWORD", &cfg.Snmp.TrapAuthPassword)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_PRIV_PROTOCOL", &cfg.Snmp.TrapPrivProtocol)
+	setEnvValue("LOGSIGHT_SNMP_TRAP_PRIV_PASSWORD", &cfg.Snmp.TrapPrivPassword)
+	setEnvBoolValue("LOGSIGHT_SNMP_DEBUG", &cfg.Snmp.Debug)
+
 	// WEB
 	setEnvValue("LOGSIGHT_WEB_HOST", &cfg.Web.Host)
 	setEnvValue("LOGSIGHT_WEB_SECRET", &cfg.Web.Secret)
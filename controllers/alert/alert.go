@@ -0,0 +1,7 @@
+package alert
+
+func InitRouter() {
+	initAlertRuleRouter()
+	initAlertSilenceRouter()
+	initAlertHistoryRouter()
+}
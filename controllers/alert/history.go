@@ -0,0 +1,54 @@
+package alert
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 告警历史
+
+func initAlertHistoryRouter() {
+
+	webserver.GET("/admin/alert/history", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "alert_history", nil)
+	})
+
+	webserver.GET("/admin/alert/history/query", func(c echo.Context) error {
+		var count, start int
+		web.NewParamReader(c).
+			ReadInt(&start, "start", 0).
+			ReadInt(&count, "count", 40)
+		var data []models.AlertHistory
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("fired_at desc").
+			QueryField("hostname", "hostname").
+			QueryField("level", "level").
+			DateRange2("starttime", "endtime", "fired_at", time.Now().Add(-time.Hour*24*7), time.Now()).
+			KeyFields("rule_name", "message")
+
+		var total int64
+		common.Must(prequery.Query(app.GDB().Model(&models.AlertHistory{})).Count(&total).Error)
+
+		query := prequery.Query(app.GDB().Model(&models.AlertHistory{})).Offset(start).Limit(count)
+		if query.Find(&data).Error != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
+	})
+
+	webserver.GET("/admin/alert/history/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.AlertHistory{}, strings.Split(ids, ",")).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Delete alert history：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
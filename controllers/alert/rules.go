@@ -0,0 +1,132 @@
+package alert
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/alerting"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 告警规则
+
+func initAlertRuleRouter() {
+
+	webserver.GET("/admin/alert/rules", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "alert_rules", nil)
+	})
+
+	webserver.GET("/admin/alert/rules/query", func(c echo.Context) error {
+		var data []models.AlertRule
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("name asc").
+			KeyFields("name", "hostname", "appname", "pattern", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.AlertRule{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Rule options for the silence form
+	webserver.GET("/admin/alert/rules/options", func(c echo.Context) error {
+		var data []models.AlertRule
+		common.Must(app.GDB().Order("name asc").Find(&data).Error)
+		var options = []web.JsonOptions{{Id: "0", Value: "全部规则"}}
+		for _, item := range data {
+			options = append(options, web.JsonOptions{Id: fmt.Sprint(item.ID), Value: item.Name})
+		}
+		return c.JSON(http.StatusOK, options)
+	})
+
+	webserver.GET("/admin/alert/rules/stats", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, app.GApp().AlertStats())
+	})
+
+	webserver.POST("/admin/alert/rules/add", func(c echo.Context) error {
+		form := new(models.AlertRule)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkAlertRule(form, webserver.GetCurrUser(c).ID, 0); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Create alert rule：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/alert/rules/update", func(c echo.Context) error {
+		form := new(models.AlertRule)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		var linked int64
+		app.GDB().Model(&models.AlertRule{}).Select("search_id").Where("id = ?", form.ID).Scan(&linked)
+		if err := checkAlertRule(form, webserver.GetCurrUser(c).ID, linked); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.AlertRule{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"name":       form.Name,
+			"rule_type":  form.RuleType,
+			"level":      form.Level,
+			"hostname":   form.Hostname,
+			"appname":    form.Appname,
+			"pattern":    form.Pattern,
+			"search_id":  form.SearchID,
+			"severity":   form.Severity,
+			"group_by":   form.GroupBy,
+			"threshold":  form.Threshold,
+			"window":     form.Window,
+			"dedup":      form.Dedup,
+			"status":     form.Status,
+			"remark":     form.Remark,
+			"updated_at": form.UpdatedAt,
+		}).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Update alert rule：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/alert/rules/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.AlertRule{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete alert rule：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+// checkAlertRule Validate a rule, the operator may only link a saved search visible to them
+// unless the rule already links it
+func checkAlertRule(form *models.AlertRule, oprID, linked int64) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	if form.Level == "" {
+		form.Level = "warning"
+	}
+	if form.Dedup < 0 || form.Window < 0 {
+		return fmt.Errorf("window and dedup must not be negative")
+	}
+	if form.SearchID != 0 && form.SearchID != linked {
+		search, err := app.GApp().GetSyslogSearch(form.SearchID)
+		if err != nil || !app.SyslogSearchVisible(*search, oprID) {
+			return fmt.Errorf("saved search %d not found", form.SearchID)
+		}
+	}
+	rule, err := app.GApp().AlertRuleWithSearch(*form)
+	if err != nil {
+		return err
+	}
+	return alerting.ValidateRule(rule)
+}
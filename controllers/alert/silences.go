@@ -0,0 +1,89 @@
+package alert
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 告警静默
+
+func initAlertSilenceRouter() {
+
+	webserver.GET("/admin/alert/silences", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "alert_silences", nil)
+	})
+
+	webserver.GET("/admin/alert/silences/query", func(c echo.Context) error {
+		var data []models.AlertSilence
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("end_time desc").
+			KeyFields("hostname", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.AlertSilence{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.POST("/admin/alert/silences/add", func(c echo.Context) error {
+		form := new(models.AlertSilence)
+		common.Must(c.Bind(form))
+		if err := readSilenceTimes(c, form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Create alert silence：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/alert/silences/update", func(c echo.Context) error {
+		form := new(models.AlertSilence)
+		common.Must(c.Bind(form))
+		if err := readSilenceTimes(c, form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		common.Must(app.GDB().Model(&models.AlertSilence{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"rule_id":    form.RuleID,
+			"hostname":   form.Hostname,
+			"start_time": form.StartTime,
+			"end_time":   form.EndTime,
+			"remark":     form.Remark,
+		}).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Update alert silence：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/alert/silences/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.AlertSilence{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete alert silence：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+// readSilenceTimes The date pickers post local times without a zone
+func readSilenceTimes(c echo.Context, form *models.AlertSilence) (err error) {
+	if form.StartTime, err = web.ParseFormTime(c.FormValue("start_time")); err != nil {
+		return fmt.Errorf("invalid start time %s", c.FormValue("start_time"))
+	}
+	if form.EndTime, err = web.ParseFormTime(c.FormValue("end_time")); err != nil {
+		return fmt.Errorf("invalid end time %s", c.FormValue("end_time"))
+	}
+	if !form.EndTime.After(form.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	return nil
+}
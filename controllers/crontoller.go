@@ -1,13 +1,16 @@
 package controllers
 
 import (
+	"github.com/talkincode/logsight/controllers/alert"
 	"github.com/talkincode/logsight/controllers/dashboard"
 	"github.com/talkincode/logsight/controllers/index"
 	"github.com/talkincode/logsight/controllers/logs"
 	"github.com/talkincode/logsight/controllers/metrics"
 	"github.com/talkincode/logsight/controllers/opr"
 	"github.com/talkincode/logsight/controllers/radius"
+	"github.com/talkincode/logsight/controllers/report"
 	"github.com/talkincode/logsight/controllers/settings"
+	"github.com/talkincode/logsight/controllers/snmp"
 )
 
 // Init web 控制器初始化
@@ -18,5 +21,8 @@ func Init() {
 	dashboard.InitRouter()
 	logs.InitRouter()
 	radius.InitRouter()
+	snmp.InitRouter()
 	metrics.InitRouter()
+	alert.InitRouter()
+	report.InitRouter()
 }
@@ -16,4 +16,5 @@ func InitRouter() {
 	})
 
 	initSystemMetricsRouter()
+	initSyslogStatsRouter()
 }
@@ -0,0 +1,123 @@
+package dashboard
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	sdcommon "github.com/influxdata/go-syslog/v3/common"
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/echarts"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// readStatsRange The time range of the last hours, capped at 30 days
+func readStatsRange(c echo.Context, defaultHours int) (time.Time, time.Time) {
+	var hours int
+	web.NewParamReader(c).ReadInt(&hours, "hours", defaultHours)
+	if hours <= 0 || hours > 24*30 {
+		hours = defaultHours
+	}
+	end := time.Now()
+	return end.Add(-time.Duration(hours) * time.Hour), end
+}
+
+func toNameValuePairs(items []app.SyslogNameCount) []*echarts.NameValuePair {
+	result := make([]*echarts.NameValuePair, 0, len(items))
+	for _, item := range items {
+		name := item.Name
+		if name == "" {
+			name = "unknown"
+		}
+		result = append(result, echarts.NewNameValuePair(name, item.Count))
+	}
+	return result
+}
+
+func initSyslogStatsRouter() {
+
+	// Overview tiles
+	webserver.GET("/admin/overview/data", func(c echo.Context) error {
+		end := time.Now()
+		hourAgo := end.Add(-time.Hour)
+		var alerts int64
+		app.GDB().Model(&models.AlertHistory{}).Where("fired_at >= ?", end.Add(-24*time.Hour)).Count(&alerts)
+		return c.JSON(http.StatusOK, []echarts.Dict{
+			{"icon": "mdi mdi-text-box-multiple", "name": "近1小时日志", "value": app.GApp().SyslogCount(hourAgo, end, -1)},
+			{"icon": "mdi mdi-alert-circle", "name": "近1小时错误 (err 及以上)", "value": app.GApp().SyslogCount(hourAgo, end, 3)},
+			{"icon": "mdi mdi-server-network", "name": "近1小时活跃设备", "value": app.GApp().SyslogSourceCount(hourAgo, end)},
+			{"icon": "mdi mdi-bell-ring", "name": "近24小时告警", "value": alerts},
+		})
+	})
+
+	// Stacked message counts per time bucket by severity
+	webserver.GET("/admin/overview/syslog/histogram", func(c echo.Context) error {
+		start, end := readStatsRange(c, 24)
+		items, err := app.GApp().SyslogHistogram(start, end, app.SyslogBucketSize(start, end))
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		values := make(map[int]*echarts.TimeValues)
+		for _, item := range items {
+			if _, ok := values[item.Severity]; !ok {
+				values[item.Severity] = echarts.NewTimeValues()
+			}
+			values[item.Severity].AddData(item.Bucket.UnixMilli(), item.Count)
+		}
+		severities := make([]int, 0, len(values))
+		for severity := range values {
+			severities = append(severities, severity)
+		}
+		sort.Ints(severities)
+		series := make([]*echarts.SeriesObject, 0, len(severities))
+		for _, severity := range severities {
+			so := echarts.NewSeriesObject("bar")
+			so.SetAttr("name", sdcommon.SeverityLevelsShort[uint8(severity)])
+			so.SetAttr("stack", "total")
+			so.SetAttr("data", values[severity])
+			series = append(series, so)
+		}
+		return c.JSON(http.StatusOK, echarts.Series(series...))
+	})
+
+	// Noisiest hosts, apps or source addresses as a horizontal bar chart
+	webserver.GET("/admin/overview/syslog/top/:field", func(c echo.Context) error {
+		var limit int
+		web.NewParamReader(c).ReadInt(&limit, "limit", 10)
+		if limit <= 0 || limit > 100 {
+			limit = 10
+		}
+		start, end := readStatsRange(c, 1)
+		items, err := app.GApp().SyslogTopN(c.Param("field"), start, end, limit)
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		pairs := toNameValuePairs(items)
+		names := make([]string, 0, len(pairs))
+		for _, pair := range pairs {
+			names = append(names, pair.Name)
+		}
+		so := echarts.NewSeriesObject("bar")
+		so.Data = pairs
+		return c.JSON(http.StatusOK, echarts.Dict{
+			"yAxis":  echarts.Dict{"type": "category", "inverse": true, "data": names},
+			"series": []*echarts.SeriesObject{so},
+		})
+	})
+
+	// Facility or severity breakdown as a pie chart
+	webserver.GET("/admin/overview/syslog/pie/:field", func(c echo.Context) error {
+		start, end := readStatsRange(c, 24)
+		items, err := app.GApp().SyslogTopN(c.Param("field"), start, end, 24)
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		so := echarts.NewSeriesObject("pie")
+		so.Data = toNameValuePairs(items)
+		return c.JSON(http.StatusOK, echarts.Series(so))
+	})
+}
@@ -0,0 +1,89 @@
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 日志来源时钟策略
+
+func initClockPolicyRouter() {
+
+	webserver.GET("/admin/syslog/clockpolicy", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "syslog_clockpolicy", nil)
+	})
+
+	webserver.GET("/admin/syslog/clockpolicy/query", func(c echo.Context) error {
+		var data []models.SyslogClockPolicy
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("source asc").
+			KeyFields("source", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.SyslogClockPolicy{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.POST("/admin/syslog/clockpolicy/add", func(c echo.Context) error {
+		form := new(models.SyslogClockPolicy)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("source", form.Source)
+		if err := checkClockPolicy(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadSyslogClockPolicies()
+		webserver.PubOpLog(c, fmt.Sprintf("Create syslog clock policy：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/syslog/clockpolicy/update", func(c echo.Context) error {
+		form := new(models.SyslogClockPolicy)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("source", form.Source)
+		if err := checkClockPolicy(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.SyslogClockPolicy{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"source":     form.Source,
+			"policy":     form.Policy,
+			"max_skew":   form.MaxSkew,
+			"remark":     form.Remark,
+			"updated_at": form.UpdatedAt,
+		}).Error)
+		app.GApp().LoadSyslogClockPolicies()
+		webserver.PubOpLog(c, fmt.Sprintf("Update syslog clock policy：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/syslog/clockpolicy/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.SyslogClockPolicy{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadSyslogClockPolicies()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete syslog clock policy：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+func checkClockPolicy(form *models.SyslogClockPolicy) error {
+	if !common.InSlice(form.Policy, []string{app.ClockPolicyDevice, app.ClockPolicyReceive, app.ClockPolicyAuto}) {
+		return fmt.Errorf("unsupported clock policy %s", form.Policy)
+	}
+	if form.Policy == app.ClockPolicyAuto && form.MaxSkew <= 0 {
+		return fmt.Errorf("max skew must be greater than 0")
+	}
+	return nil
+}
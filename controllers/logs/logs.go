@@ -4,4 +4,10 @@ func InitRouter() {
 	initOplogRouter()
 	initLokiRouter()
 	initSyslogRouter()
+	initClockPolicyRouter()
+	initSyslogRuleRouter()
+	initSyslogTailRouter()
+	initRetentionRouter()
+	initSyslogSearchRouter()
+	initSyslogSearchesRouter()
 }
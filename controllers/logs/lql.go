@@ -0,0 +1,27 @@
+package logs
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/webserver"
+)
+
+func initSyslogSearchRouter() {
+	// LQL query, e.g. host:core-sw* AND severity<=err | stats count by host
+	webserver.GET("/admin/syslog/search", func(c echo.Context) error {
+		var query string
+		var start, count int
+		web.NewParamReader(c).
+			ReadString(&query, "q").
+			ReadInt(&start, "start", 0).
+			ReadInt(&count, "count", 100)
+		result, err := app.GApp().SearchSyslog(query, start, count)
+		if err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		return c.JSON(http.StatusOK, web.RestResult(result))
+	})
+}
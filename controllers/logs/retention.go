@@ -0,0 +1,107 @@
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 日志保留策略
+
+func initRetentionRouter() {
+
+	webserver.GET("/admin/syslog/retention", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "syslog_retention", nil)
+	})
+
+	webserver.GET("/admin/syslog/retention/query", func(c echo.Context) error {
+		var data []models.SyslogRetention
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("sort asc").
+			KeyFields("name", "hostname", "appname", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.SyslogRetention{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Manager state and table sizes
+	webserver.GET("/admin/syslog/retention/status", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, map[string]interface{}{
+			"status": app.GApp().GetRetentionStatus(),
+			"tables": app.GApp().TableSizes(),
+		})
+	})
+
+	// Start a purge now, it runs in the background
+	webserver.POST("/admin/syslog/retention/run", func(c echo.Context) error {
+		if app.GApp().GetRetentionStatus().Running {
+			return c.JSON(http.StatusOK, web.RestError("syslog retention is already running"))
+		}
+		go func() {
+			_ = app.GApp().RunSyslogRetention()
+		}()
+		webserver.PubOpLog(c, "Run syslog retention")
+		return c.JSON(http.StatusOK, web.RestSucc("syslog retention started"))
+	})
+
+	webserver.POST("/admin/syslog/retention/add", func(c echo.Context) error {
+		form := new(models.SyslogRetention)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkRetention(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Create syslog retention：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/syslog/retention/update", func(c echo.Context) error {
+		form := new(models.SyslogRetention)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkRetention(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.SyslogRetention{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"sort":       form.Sort,
+			"name":       form.Name,
+			"hostname":   form.Hostname,
+			"appname":    form.Appname,
+			"severity":   form.Severity,
+			"days":       form.Days,
+			"status":     form.Status,
+			"remark":     form.Remark,
+			"updated_at": form.UpdatedAt,
+		}).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Update syslog retention：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/syslog/retention/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.SyslogRetention{}, strings.Split(ids, ",")).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Delete syslog retention：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+func checkRetention(form *models.SyslogRetention) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	return app.SyslogRetentionToRule(*form).Validate()
+}
@@ -0,0 +1,126 @@
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/logparse"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 日志解析规则
+
+func initSyslogRuleRouter() {
+
+	webserver.GET("/admin/syslog/rules", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "syslog_rules", nil)
+	})
+
+	webserver.GET("/admin/syslog/rules/query", func(c echo.Context) error {
+		var data []models.SyslogRule
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("sort asc").
+			KeyFields("name", "hostname", "appname", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.SyslogRule{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Built-in pattern library
+	webserver.GET("/admin/syslog/rules/patterns", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, logparse.Library())
+	})
+
+	// Try a rule against a sample message without saving it
+	webserver.POST("/admin/syslog/rules/test", func(c echo.Context) error {
+		form := new(models.SyslogRule)
+		common.Must(c.Bind(form))
+		var hostname, appname, message string
+		web.NewParamReader(c).
+			ReadString(&hostname, "test_hostname").
+			ReadString(&appname, "test_appname").
+			ReadString(&message, "test_message")
+		engine, errs := logparse.NewEngine([]logparse.Rule{app.SyslogRuleToLogparse(*form)})
+		if len(errs) > 0 {
+			return c.JSON(http.StatusOK, web.RestError(errs[0].Error()))
+		}
+		return c.JSON(http.StatusOK, web.RestResult(engine.Apply(hostname, appname, message)))
+	})
+
+	webserver.POST("/admin/syslog/rules/add", func(c echo.Context) error {
+		form := new(models.SyslogRule)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkSyslogRule(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadSyslogRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Create syslog rule：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/syslog/rules/update", func(c echo.Context) error {
+		form := new(models.SyslogRule)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkSyslogRule(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.SyslogRule{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"sort":         form.Sort,
+			"name":         form.Name,
+			"hostname":     form.Hostname,
+			"appname":      form.Appname,
+			"pattern_type": form.PatternType,
+			"pattern":      form.Pattern,
+			"action":       form.Action,
+			"severity":     form.Severity,
+			"tags":         form.Tags,
+			"namespace":    form.Namespace,
+			"status":       form.Status,
+			"remark":       form.Remark,
+			"updated_at":   form.UpdatedAt,
+		}).Error)
+		app.GApp().LoadSyslogRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Update syslog rule：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/syslog/rules/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.SyslogRule{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadSyslogRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete syslog rule：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+func checkSyslogRule(form *models.SyslogRule) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	if !common.InSlice(form.PatternType, []string{logparse.PatternRegex, logparse.PatternGrok}) {
+		return fmt.Errorf("unsupported pattern type %s", form.PatternType)
+	}
+	if !common.InSlice(form.Action, []string{logparse.ActionExtract, logparse.ActionDrop}) {
+		return fmt.Errorf("unsupported action %s", form.Action)
+	}
+	if form.Severity < logparse.SeverityKeep || form.Severity > 7 {
+		return fmt.Errorf("severity must be between 0 and 7")
+	}
+	_, err := logparse.CompileRule(logparse.NewGrok(), app.SyslogRuleToLogparse(*form))
+	return err
+}
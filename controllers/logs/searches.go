@@ -0,0 +1,181 @@
+package logs
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/fts"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+	"gorm.io/gorm"
+)
+
+// 保存的搜索
+
+func initSyslogSearchesRouter() {
+
+	// Searches of the current operator and the shared ones
+	webserver.GET("/admin/syslog/searches/query", func(c echo.Context) error {
+		opr := webserver.GetCurrUser(c)
+		var data []models.SyslogSearch
+		err := app.GDB().Where("opr_id = ? OR visibility = ?", opr.ID, app.SearchShared).
+			Order("name asc").Find(&data).Error
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Search options for the alert rule form
+	webserver.GET("/admin/syslog/searches/options", func(c echo.Context) error {
+		opr := webserver.GetCurrUser(c)
+		var data []models.SyslogSearch
+		common.Must(app.GDB().Where("opr_id = ? OR visibility = ?", opr.ID, app.SearchShared).
+			Order("name asc").Find(&data).Error)
+		var options = []web.JsonOptions{{Id: "0", Value: "不使用"}}
+		for _, item := range data {
+			options = append(options, web.JsonOptions{Id: fmt.Sprint(item.ID), Value: item.Name})
+		}
+		return c.JSON(http.StatusOK, options)
+	})
+
+	// A search by its permalink code with the time range resolved for the query form
+	webserver.GET("/admin/syslog/searches/get", func(c echo.Context) error {
+		opr := webserver.GetCurrUser(c)
+		var search models.SyslogSearch
+		if err := app.GDB().Where("token = ?", c.QueryParam("token")).First(&search).Error; err != nil {
+			return c.JSON(http.StatusOK, web.RestError("saved search not found"))
+		}
+		if !app.SyslogSearchVisible(search, opr.ID) {
+			return c.JSON(http.StatusOK, web.RestError("saved search is private"))
+		}
+		start, end := app.SyslogSearchRange(search, time.Now())
+		return c.JSON(http.StatusOK, web.RestResult(map[string]interface{}{
+			"search":    search,
+			"starttime": start.Format("2006-01-02 15:04:05"),
+			"endtime":   end.Format("2006-01-02 15:04:05"),
+		}))
+	})
+
+	// Permalink, opens the syslog view inside the console
+	webserver.GET("/admin/syslog/s/:token", func(c echo.Context) error {
+		page := "/admin/syslog?search=" + url.QueryEscape(c.Param("token"))
+		return c.Redirect(http.StatusTemporaryRedirect, "/?page="+url.QueryEscape(page))
+	})
+
+	webserver.POST("/admin/syslog/searches/add", func(c echo.Context) error {
+		form := new(models.SyslogSearch)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkSyslogSearch(c, form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		opr := webserver.GetCurrUser(c)
+		form.ID = common.UUIDint64()
+		form.OprID = opr.ID
+		form.OprName = opr.Username
+		form.Token = app.NewSyslogSearchToken()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Create saved search：%v", form))
+		return c.JSON(http.StatusOK, web.RestResult(form))
+	})
+
+	webserver.POST("/admin/syslog/searches/update", func(c echo.Context) error {
+		form := new(models.SyslogSearch)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkSyslogSearch(c, form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		result := ownedSearches(c).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"name":       form.Name,
+			"visibility": form.Visibility,
+			"hostname":   form.Hostname,
+			"keyword":    form.Keyword,
+			"severity":   form.Severity,
+			"time_range": form.TimeRange,
+			"start_time": form.StartTime,
+			"end_time":   form.EndTime,
+			"remark":     form.Remark,
+			"updated_at": form.UpdatedAt,
+		})
+		common.Must(result.Error)
+		if result.RowsAffected == 0 {
+			return c.JSON(http.StatusOK, web.RestError("only the owner can change a saved search"))
+		}
+		app.GApp().LoadAlertRules()
+		webserver.PubOpLog(c, fmt.Sprintf("Update saved search：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/syslog/searches/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		var used int64
+		app.GDB().Model(&models.AlertRule{}).Where("search_id IN ?", strings.Split(ids, ",")).Count(&used)
+		if used > 0 {
+			return c.JSON(http.StatusOK, web.RestError("the saved search is used by alert rules"))
+		}
+		app.GDB().Model(&models.Report{}).Where("search_id IN ?", strings.Split(ids, ",")).Count(&used)
+		if used > 0 {
+			return c.JSON(http.StatusOK, web.RestError("the saved search is used by reports"))
+		}
+		common.Must(ownedSearches(c).Delete(models.SyslogSearch{}, strings.Split(ids, ",")).Error)
+		webserver.PubOpLog(c, fmt.Sprintf("Delete saved search：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+// ownedSearches The searches the current operator may change, super operators may change all
+func ownedSearches(c echo.Context) *gorm.DB {
+	query := app.GDB().Model(&models.SyslogSearch{})
+	if webserver.GetCurrUserlevel(c) != "super" {
+		query = query.Where("opr_id = ?", webserver.GetCurrUser(c).ID)
+	}
+	return query
+}
+
+func checkSyslogSearch(c echo.Context, form *models.SyslogSearch) (err error) {
+	if form.Visibility != app.SearchShared {
+		form.Visibility = app.SearchPrivate
+	}
+	node, err := fts.Parse(form.Keyword)
+	if err != nil {
+		return err
+	}
+	if node != nil {
+		if err = node.Validate(); err != nil {
+			return err
+		}
+	}
+	if form.Severity < -1 || form.Severity > 7 {
+		return fmt.Errorf("severity must be between -1 and 7")
+	}
+	if form.TimeRange == "" {
+		form.TimeRange = "8h"
+	}
+	if form.TimeRange != app.SearchRangeCustom {
+		_, err = app.ParseSearchRange(form.TimeRange)
+		return err
+	}
+	// the date pickers post local times without a zone
+	if form.StartTime, err = web.ParseFormTime(c.FormValue("start_time")); err != nil {
+		return fmt.Errorf("invalid start time %s", c.FormValue("start_time"))
+	}
+	if form.EndTime, err = web.ParseFormTime(c.FormValue("end_time")); err != nil {
+		return fmt.Errorf("invalid end time %s", c.FormValue("end_time"))
+	}
+	if !form.EndTime.After(form.StartTime) {
+		return fmt.Errorf("end time must be after start time")
+	}
+	return nil
+}
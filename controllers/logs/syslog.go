@@ -10,6 +10,7 @@ import (
 	"github.com/talkincode/logsight/common/web"
 	"github.com/talkincode/logsight/models"
 	"github.com/talkincode/logsight/webserver"
+	"gorm.io/gorm"
 )
 
 func initSyslogRouter() {
@@ -23,19 +24,47 @@ func initSyslogRouter() {
 			ReadInt(&start, "start", 0).
 			ReadInt(&count, "count", 40)
 		var data []models.TsSyslog
-		prequery := web.NewPreQuery(c).
-			DefaultOrderBy("timestamp desc").
-			QueryField("hostname", "hostname").
-			DateRange2("starttime", "endtime", "timestamp", time.Now().Add(-time.Hour*8), time.Now()).
-			KeyFields("message")
+		buildQuery := syslogQuery(c)
 
 		var total int64
-		common.Must(prequery.Query(app.GDB().Model(&models.TsSyslog{})).Count(&total).Error)
+		common.Must(buildQuery(app.GDB()).Count(&total).Error)
 
-		query := prequery.Query(app.GDB().Debug().Model(&models.TsSyslog{})).Offset(start).Limit(count)
+		query := buildQuery(app.GDB().Debug()).Offset(start).Limit(count)
 		if query.Find(&data).Error != nil {
 			return c.JSON(http.StatusOK, common.EmptyList)
 		}
 		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
 	})
+
+	// Export all messages matching the query form, format is csv, ndjson or xlsx
+	webserver.GET("/admin/syslog/export", func(c echo.Context) error {
+		webserver.PubOpLog(c, "Export syslog "+c.QueryString())
+		return webserver.ExportStream(c, "syslog", syslogQuery(c)(app.GDB()), app.SyslogExportColumns, app.SyslogExportRow)
+	})
+
+	// Syslog persistence queue counters
+	webserver.GET("/admin/syslog/stats", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, app.GApp().SyslogQueueStats())
+	})
+}
+
+// syslogQuery The filters of the syslog query form, shared by the list and the export
+func syslogQuery(c echo.Context) func(db *gorm.DB) *gorm.DB {
+	var severity int
+	web.NewParamReader(c).ReadInt(&severity, "severity", -1)
+	prequery := web.NewPreQuery(c).
+		DefaultOrderBy("timestamp desc").
+		QueryField("hostname", "hostname").
+		DateRange2("starttime", "endtime", "timestamp", time.Now().Add(-time.Hour*8), time.Now()).
+		JsonPathField("sd", "structured_data").
+		FullTextField("message", app.GApp().SyslogFullTextEnabled())
+
+	// severity selects this level and the more severe ones
+	return func(db *gorm.DB) *gorm.DB {
+		query := prequery.Query(db.Model(&models.TsSyslog{}))
+		if severity >= 0 {
+			query = query.Where("severity <= ?", severity)
+		}
+		return query
+	}
 }
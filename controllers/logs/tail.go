@@ -0,0 +1,108 @@
+package logs
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/webserver"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	tailDefaultRate   = 50
+	tailMaxRate       = 1000
+	tailHeartbeat     = time.Second * 15
+	tailWriteDeadline = time.Second * 10
+)
+
+// 实时日志
+
+func initSyslogTailRouter() {
+
+	// Server-Sent Events stream, each record is sent as a JSON data line
+	webserver.GET("/admin/syslog/tail", func(c echo.Context) error {
+		sub := app.GApp().SubscribeSyslog(readTailParams(c))
+		defer sub.Close()
+		sse := web.NewSSE(c)
+		c.Response().WriteHeader(http.StatusOK)
+		c.Response().Flush()
+		ticker := time.NewTicker(tailHeartbeat)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-sse.Done():
+				return nil
+			case <-ticker.C:
+				if err := sse.WriteEvent("ping", []byte(time.Now().Format(time.RFC3339))); err != nil {
+					return nil
+				}
+			case item, ok := <-sub.C():
+				if !ok {
+					_ = sse.WriteEvent("close", []byte(sub.Reason()))
+					return nil
+				}
+				if err := sse.WriteJSON(item); err != nil {
+					return nil
+				}
+			}
+		}
+	})
+
+	// WebSocket stream, each record is sent as a JSON text frame
+	webserver.GET("/admin/syslog/tail/ws", func(c echo.Context) error {
+		filter, rate := readTailParams(c)
+		websocket.Handler(func(ws *websocket.Conn) {
+			defer ws.Close()
+			sub := app.GApp().SubscribeSyslog(filter, rate)
+			defer sub.Close()
+			// the client is not expected to send anything, reading detects the disconnect
+			closed := make(chan struct{})
+			go func() {
+				defer close(closed)
+				var discard string
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+			}()
+			for {
+				select {
+				case <-closed:
+					return
+				case item, ok := <-sub.C():
+					if !ok {
+						_ = ws.SetWriteDeadline(time.Now().Add(tailWriteDeadline))
+						_ = websocket.JSON.Send(ws, map[string]string{"event": "close", "reason": sub.Reason()})
+						return
+					}
+					_ = ws.SetWriteDeadline(time.Now().Add(tailWriteDeadline))
+					if err := websocket.JSON.Send(ws, item); err != nil {
+						return
+					}
+				}
+			}
+		}).ServeHTTP(c.Response(), c.Request())
+		return nil
+	})
+
+	webserver.GET("/admin/syslog/tail/stats", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, app.GApp().SyslogTailStats())
+	})
+}
+
+// readTailParams Filter and per subscriber rate limit from the query string
+func readTailParams(c echo.Context) (app.SyslogTailFilter, float64) {
+	var filter app.SyslogTailFilter
+	var rate int
+	web.NewParamReader(c).
+		ReadString(&filter.Hostname, "hostname").
+		ReadString(&filter.Appname, "appname").
+		ReadString(&filter.Keyword, "keyword").
+		ReadInt(&filter.Severity, "severity", -1).
+		ReadInt(&rate, "rate", tailDefaultRate)
+	if rate <= 0 || rate > tailMaxRate {
+		rate = tailMaxRate
+	}
+	return filter, float64(rate)
+}
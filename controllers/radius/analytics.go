@@ -0,0 +1,178 @@
+package radius
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/echarts"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+	"gorm.io/gorm"
+)
+
+// readDaysRange The range of the last days up to now, capped at a year
+func readDaysRange(c echo.Context, defaultDays int) (time.Time, time.Time) {
+	var days int
+	web.NewParamReader(c).ReadInt(&days, "days", defaultDays)
+	if days <= 0 || days > 366 {
+		days = defaultDays
+	}
+	end := time.Now()
+	return end.AddDate(0, 0, -days), end
+}
+
+// RADIUS 使用分析, 数据来自 app.RollupRadiusAccounting 的汇总表
+
+func InitAnalyticsRouter() {
+
+	webserver.GET("/admin/radius/analytics", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "radius_analytics", nil)
+	})
+
+	// Daily upload and download of all users or one user
+	webserver.GET("/admin/radius/analytics/traffic", func(c echo.Context) error {
+		start, end := readDaysRange(c, 30)
+		items, err := app.GApp().RadiusTrafficDaily(start, end, c.QueryParam("username"))
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		input, output := echarts.NewTimeValues(), echarts.NewTimeValues()
+		for _, item := range items {
+			input.AddData(item.Day.UnixMilli(), item.InputTotal)
+			output.AddData(item.Day.UnixMilli(), item.OutputTotal)
+		}
+		inso := echarts.NewSeriesObject("bar")
+		inso.SetAttr("name", "上行")
+		inso.SetAttr("stack", "total")
+		inso.SetAttr("data", input)
+		outso := echarts.NewSeriesObject("bar")
+		outso.SetAttr("name", "下行")
+		outso.SetAttr("stack", "total")
+		outso.SetAttr("data", output)
+		return c.JSON(http.StatusOK, echarts.Series(inso, outso))
+	})
+
+	// Users with the most traffic as a horizontal bar chart
+	webserver.GET("/admin/radius/analytics/top", func(c echo.Context) error {
+		var limit int
+		web.NewParamReader(c).ReadInt(&limit, "limit", 10)
+		if limit <= 0 || limit > 100 {
+			limit = 10
+		}
+		start, end := readDaysRange(c, 7)
+		items, err := app.GApp().RadiusTopUsers(start, end, limit)
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		names := make([]string, 0, len(items))
+		input := make([]int64, 0, len(items))
+		output := make([]int64, 0, len(items))
+		for _, item := range items {
+			names = append(names, item.Name)
+			input = append(input, item.InputTotal)
+			output = append(output, item.OutputTotal)
+		}
+		inso := echarts.NewSeriesObject("bar")
+		inso.Data = input
+		inso.SetAttr("name", "上行")
+		inso.SetAttr("stack", "total")
+		outso := echarts.NewSeriesObject("bar")
+		outso.Data = output
+		outso.SetAttr("name", "下行")
+		outso.SetAttr("stack", "total")
+		return c.JSON(http.StatusOK, echarts.Dict{
+			"yAxis":  echarts.Dict{"type": "category", "inverse": true, "data": names},
+			"series": []*echarts.SeriesObject{inso, outso},
+		})
+	})
+
+	// Online sessions over time, of all NAS or of nas_addr
+	webserver.GET("/admin/radius/analytics/concurrency", func(c echo.Context) error {
+		var hours int
+		web.NewParamReader(c).ReadInt(&hours, "hours", 24)
+		if hours <= 0 || hours > 24*31 {
+			hours = 24
+		}
+		end := time.Now()
+		items, err := app.GApp().RadiusConcurrency(end.Add(-time.Duration(hours)*time.Hour), end, c.QueryParam("nas_addr"))
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		values := echarts.NewTimeValues()
+		for _, item := range items {
+			values.AddData(item.Time.UnixMilli(), item.Sessions)
+		}
+		so := echarts.NewSeriesObject("line")
+		so.SetAttr("name", "在线会话")
+		so.SetAttr("showSymbol", false)
+		so.SetAttr("areaStyle", echarts.Dict{})
+		so.SetAttr("data", values)
+		return c.JSON(http.StatusOK, echarts.Series(so))
+	})
+
+	// Distribution of the session lengths
+	webserver.GET("/admin/radius/analytics/duration", func(c echo.Context) error {
+		start, end := readDaysRange(c, 30)
+		items, err := app.GApp().RadiusLengthDistribution(start, end)
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		labels := make([]string, 0, len(items))
+		values := make([]int64, 0, len(items))
+		for _, item := range items {
+			labels = append(labels, app.RadiusLengthBuckets[item.Bucket].Label)
+			values = append(values, item.Sessions)
+		}
+		so := echarts.NewSeriesObject("bar")
+		so.Data = values
+		so.SetAttr("name", "会话数")
+		return c.JSON(http.StatusOK, echarts.Dict{
+			"xAxis":  echarts.Dict{"type": "category", "data": labels},
+			"series": []*echarts.SeriesObject{so},
+		})
+	})
+
+	// Sessions, traffic and peak concurrency per NAS
+	webserver.GET("/admin/radius/analytics/nas", func(c echo.Context) error {
+		start, end := readDaysRange(c, 7)
+		items, err := app.GApp().RadiusNasLoads(start, end)
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, items)
+	})
+
+	// Traffic per user per day
+	webserver.GET("/admin/radius/analytics/users", func(c echo.Context) error {
+		var count, start int
+		web.NewParamReader(c).
+			ReadInt(&start, "start", 0).
+			ReadInt(&count, "count", 40)
+		begin, end := readDaysRange(c, 30)
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("day desc, input_total + output_total desc").
+			KeyFields("username")
+		scope := func() *gorm.DB {
+			return prequery.Query(app.GDB().Model(&models.RadiusUserDaily{})).
+				Where("day >= date_trunc('day', ?::timestamptz) AND day < ?", begin, end)
+		}
+		var total int64
+		common.Must(scope().Count(&total).Error)
+		var data []models.RadiusUserDaily
+		if scope().Offset(start).Limit(count).Find(&data).Error != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
+	})
+
+	// Roll up the sessions updated since the last run now instead of waiting for the job
+	webserver.POST("/admin/radius/analytics/refresh", func(c echo.Context) error {
+		go app.GApp().RollupRadiusAccounting()
+		webserver.PubOpLog(c, "Refresh radius analytics")
+		return c.JSON(http.StatusOK, web.RestSucc("刷新任务已提交"))
+	})
+}
@@ -0,0 +1,45 @@
+package radius
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// IP 溯源, 查询某一时刻持有某个 IP 的用户会话
+
+func InitIPLookupRouter() {
+
+	webserver.GET("/admin/radius/iplookup", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "radius_iplookup", nil)
+	})
+
+	webserver.GET("/admin/radius/iplookup/query", func(c echo.Context) error {
+		ip := net.ParseIP(strings.TrimSpace(c.QueryParam("ip")))
+		if ip == nil || ip.To4() == nil {
+			return c.JSON(http.StatusOK, web.RestError("invalid ipv4 address"))
+		}
+		at := time.Now()
+		if value := c.QueryParam("time"); value != "" {
+			var err error
+			if at, err = web.ParseFormTime(value); err != nil {
+				return c.JSON(http.StatusOK, web.RestError("invalid time "+value))
+			}
+		}
+		result, err := app.GApp().LookupRadiusIP(ip.String(), at)
+		if err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		// lookups of subscriber identities are audited
+		webserver.PubOpLog(c, fmt.Sprintf("Lookup radius ip %s at %s, %d sessions",
+			ip, at.Format(time.DateTime), len(result.Sessions)))
+		return c.JSON(http.StatusOK, web.RestResult(result))
+	})
+}
@@ -33,10 +33,7 @@ func InitLogsRouter() {
 			ReadInt(&start, "start", 0).
 			ReadInt(&count, "count", 40)
 		var data []models.TsRadiusAccounting
-		prequery := web.NewPreQuery(c).
-			DefaultOrderBy("acct_stop_time desc").
-			DateRange2("starttime", "endtime", "acct_stop_time", time.Now().Add(-time.Hour*8), time.Now()).
-			KeyFields("username", "framed_ipaddr", "mac_addr")
+		prequery := accountingPreQuery(c)
 
 		var total int64
 		common.Must(prequery.Query(app.GDB().Model(&models.TsRadiusAccounting{})).Count(&total).Error)
@@ -48,6 +45,21 @@ func InitLogsRouter() {
 		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
 	})
 
+	// 记账日志导出, format 为 csv, ndjson 或 xlsx
+	webserver.GET("/admin/radius/accounting/export", func(c echo.Context) error {
+		webserver.PubOpLog(c, "Export radius accounting "+c.QueryString())
+		query := accountingPreQuery(c).Query(app.GDB().Model(&models.TsRadiusAccounting{}))
+		return webserver.ExportStream(c, "radius-accounting", query, accountingExportColumns,
+			func(item *models.TsRadiusAccounting) []interface{} {
+				return []interface{}{
+					item.Username, item.AcctSessionId, item.AcctStartTime, item.AcctStopTime,
+					item.AcctSessionTime, item.NasId, item.NasAddr, item.NasPortId,
+					item.FramedIpaddr, item.MacAddr, item.AcctInputTotal, item.AcctOutputTotal,
+					item.AcctInputPackets, item.AcctOutputPackets, item.AcctTerminateCause, item.LastUpdate,
+				}
+			})
+	})
+
 	webserver.POST("/radius/accounting/add", func(c echo.Context) error {
 		form := new(models.TsRadiusAccounting)
 		body, err := io.ReadAll(c.Request().Body)
@@ -64,20 +76,30 @@ func InitLogsRouter() {
 				form.Username, form.AcctSessionId,
 			).First(&acct).Error
 		if err == nil {
-			// update
-			common.Must(app.GDB().
-				Model(&models.TsRadiusAccounting{}).
-				Where("username = ? and acct_session_id = ?",
-					form.Username, form.AcctSessionId).Updates(map[string]interface{}{
+			// a stopped session is not reopened by a late interim update
+			if !acct.AcctStopTime.IsZero() && form.AcctStopTime.IsZero() {
+				return c.JSON(http.StatusOK, web.RestSucc("success"))
+			}
+			values := map[string]interface{}{
 				"acct_session_time":   form.AcctSessionTime,
 				"acct_input_total":    form.AcctInputTotal,
 				"acct_output_total":   form.AcctOutputTotal,
 				"acct_input_packets":  form.AcctInputPackets,
 				"acct_output_packets": form.AcctOutputPackets,
-				"acct_stop_time":      form.AcctStopTime,
 				"session_timeout":     form.SessionTimeout,
 				"last_update":         time.Now(),
-			}).Error)
+			}
+			if form.AcctInterimInterval > 0 {
+				values["acct_interim_interval"] = form.AcctInterimInterval
+			}
+			if !form.AcctStopTime.IsZero() {
+				values["acct_stop_time"] = form.AcctStopTime
+				values["acct_terminate_cause"] = form.AcctTerminateCause
+			}
+			common.Must(app.GDB().
+				Model(&models.TsRadiusAccounting{}).
+				Where("username = ? and acct_session_id = ?",
+					form.Username, form.AcctSessionId).Updates(values).Error)
 		} else {
 			form.ID = common.UUID()
 			form.LastUpdate = time.Now()
@@ -87,3 +109,18 @@ func InitLogsRouter() {
 	})
 
 }
+
+var accountingExportColumns = []string{
+	"username", "acct_session_id", "acct_start_time", "acct_stop_time",
+	"acct_session_time", "nas_id", "nas_addr", "nas_port_id",
+	"framed_ipaddr", "mac_addr", "acct_input_total", "acct_output_total",
+	"acct_input_packets", "acct_output_packets", "acct_terminate_cause", "last_update",
+}
+
+// accountingPreQuery The filters of the accounting query form, shared by the list and the export
+func accountingPreQuery(c echo.Context) *web.PreQuery {
+	return web.NewPreQuery(c).
+		DefaultOrderBy("acct_stop_time desc").
+		DateRange2("starttime", "endtime", "acct_stop_time", time.Now().Add(-time.Hour*8), time.Now()).
+		KeyFields("username", "framed_ipaddr", "mac_addr")
+}
@@ -0,0 +1,107 @@
+package radius
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// NAS 设备, 内置 RADIUS 记账服务只接受已登记设备的请求
+
+func InitNasRouter() {
+
+	webserver.GET("/admin/radius/nas", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "radius_nas", nil)
+	})
+
+	webserver.GET("/admin/radius/nas/query", func(c echo.Context) error {
+		var data []models.RadiusNas
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("name asc").
+			KeyFields("name", "ipaddr", "identifier", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.RadiusNas{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		// secrets are write only, an empty value keeps the stored one
+		for i := range data {
+			data[i].Secret = ""
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.POST("/admin/radius/nas/add", func(c echo.Context) error {
+		form := new(models.RadiusNas)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		common.MustNotEmpty("secret", form.Secret)
+		if err := checkNas(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadRadiusNas()
+		webserver.PubOpLog(c, fmt.Sprintf("Create radius nas：%s %s", form.Name, form.Ipaddr))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/radius/nas/update", func(c echo.Context) error {
+		form := new(models.RadiusNas)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkNas(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		values := map[string]interface{}{
+			"name":        form.Name,
+			"ipaddr":      form.Ipaddr,
+			"identifier":  form.Identifier,
+			"vendor_code": form.VendorCode,
+			"status":      form.Status,
+			"remark":      form.Remark,
+			"updated_at":  time.Now(),
+		}
+		if form.Secret != "" {
+			values["secret"] = form.Secret
+		}
+		common.Must(app.GDB().Model(&models.RadiusNas{}).Where("id = ?", form.ID).Updates(values).Error)
+		app.GApp().LoadRadiusNas()
+		webserver.PubOpLog(c, fmt.Sprintf("Update radius nas：%s %s", form.Name, form.Ipaddr))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/radius/nas/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.RadiusNas{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadRadiusNas()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete radius nas：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+func checkNas(form *models.RadiusNas) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	ip := net.ParseIP(strings.TrimSpace(form.Ipaddr))
+	if ip == nil {
+		return fmt.Errorf("invalid nas address %s", form.Ipaddr)
+	}
+	form.Ipaddr = ip.String()
+	var count int64
+	app.GDB().Model(&models.RadiusNas{}).Where("ipaddr = ? AND id <> ?", form.Ipaddr, form.ID).Count(&count)
+	if count > 0 {
+		return fmt.Errorf("nas %s already exists", form.Ipaddr)
+	}
+	return nil
+}
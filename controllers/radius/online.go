@@ -0,0 +1,54 @@
+package radius
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 在线会话, 未下线且仍在发送计费更新的会话
+
+func InitOnlineRouter() {
+
+	webserver.GET("/admin/radius/online", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "radius_online", nil)
+	})
+
+	webserver.GET("/admin/radius/online/query", func(c echo.Context) error {
+		var count, start int
+		web.NewParamReader(c).
+			ReadInt(&start, "start", 0).
+			ReadInt(&count, "count", 40)
+		var data []models.TsRadiusAccounting
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("acct_start_time desc").
+			QueryField("nas_addr", "nas_addr").
+			QueryField("username", "username").
+			KeyFields("username", "framed_ipaddr", "mac_addr", "nas_port_id")
+
+		var total int64
+		common.Must(prequery.Query(app.GApp().RadiusOnlineScope(app.GDB().Model(&models.TsRadiusAccounting{}))).
+			Count(&total).Error)
+
+		query := prequery.Query(app.GApp().RadiusOnlineScope(app.GDB().Model(&models.TsRadiusAccounting{}))).
+			Offset(start).Limit(count)
+		if query.Find(&data).Error != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
+	})
+
+	// 在线数统计, 按 NAS 和用户
+	webserver.GET("/admin/radius/online/stats", func(c echo.Context) error {
+		stats, err := app.GApp().RadiusOnlineStats(50)
+		if err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		return c.JSON(http.StatusOK, web.RestResult(stats))
+	})
+}
@@ -3,5 +3,9 @@ package radius
 func InitRouter() {
 
 	InitLogsRouter()
+	InitNasRouter()
+	InitOnlineRouter()
+	InitAnalyticsRouter()
+	InitIPLookupRouter()
 
 }
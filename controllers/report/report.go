@@ -0,0 +1,196 @@
+package report
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 定时报表
+
+func InitRouter() {
+
+	webserver.GET("/admin/reports", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "reports", nil)
+	})
+
+	webserver.GET("/admin/reports/query", func(c echo.Context) error {
+		var data []models.Report
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("name asc").
+			KeyFields("name", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.Report{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		for i := range data {
+			data[i].NextRunAt = app.GApp().ReportNextRun(data[i].ID)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.POST("/admin/reports/add", func(c echo.Context) error {
+		form := new(models.Report)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkReport(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadReports()
+		webserver.PubOpLog(c, fmt.Sprintf("Create report：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/reports/update", func(c echo.Context) error {
+		form := new(models.Report)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkReport(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.Report{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"name":        form.Name,
+			"report_type": form.ReportType,
+			"search_id":   form.SearchID,
+			"time_range":  form.TimeRange,
+			"format":      form.Format,
+			"schedule":    form.Schedule,
+			"top_n":       form.TopN,
+			"channel_id":  form.ChannelID,
+			"keep_runs":   form.KeepRuns,
+			"status":      form.Status,
+			"remark":      form.Remark,
+			"updated_at":  form.UpdatedAt,
+		}).Error)
+		app.GApp().LoadReports()
+		webserver.PubOpLog(c, fmt.Sprintf("Update report：%v", form))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	// Delete reports with their runs and files
+	webserver.GET("/admin/reports/delete", func(c echo.Context) error {
+		ids := strings.Split(c.QueryParam("ids"), ",")
+		var runs []models.ReportRun
+		common.Must(app.GDB().Where("report_id IN ?", ids).Find(&runs).Error)
+		for _, run := range runs {
+			common.Must(app.GApp().DeleteReportRun(run))
+		}
+		common.Must(app.GDB().Delete(models.Report{}, ids).Error)
+		app.GApp().LoadReports()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete report：%s", c.QueryParam("ids")))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	// Run a report now, the run shows up in the history when it is done
+	webserver.GET("/admin/reports/run", func(c echo.Context) error {
+		var item models.Report
+		if err := app.GDB().Where("id = ?", c.QueryParam("id")).First(&item).Error; err != nil {
+			return c.JSON(http.StatusOK, web.RestError("report not found"))
+		}
+		go func() {
+			if _, err := app.GApp().RunReport(item.ID, app.ReportTriggerManual); err != nil {
+				log.Errorf("report %s error %s", item.Name, err.Error())
+			}
+		}()
+		webserver.PubOpLog(c, fmt.Sprintf("Run report：%s", item.Name))
+		return c.JSON(http.StatusOK, web.RestSucc("the report is being generated"))
+	})
+
+	webserver.GET("/admin/reports/runs/query", func(c echo.Context) error {
+		var count, start int
+		web.NewParamReader(c).
+			ReadInt(&start, "start", 0).
+			ReadInt(&count, "count", 40)
+		var data []models.ReportRun
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("created_at desc").
+			QueryField("report_id", "report_id").
+			KeyFields("report_name", "message")
+
+		var total int64
+		common.Must(prequery.Query(app.GDB().Model(&models.ReportRun{})).Count(&total).Error)
+
+		query := prequery.Query(app.GDB().Model(&models.ReportRun{})).Offset(start).Limit(count)
+		if query.Find(&data).Error != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, &web.PageResult{TotalCount: total, Pos: int64(start), Data: data})
+	})
+
+	webserver.GET("/admin/reports/runs/download", func(c echo.Context) error {
+		var run models.ReportRun
+		if err := app.GDB().Where("id = ?", c.QueryParam("id")).First(&run).Error; err != nil || run.Filename == "" {
+			return echo.NewHTTPError(http.StatusNotFound, "report file not found")
+		}
+		filename := app.GApp().ReportFile(run)
+		if _, err := os.Stat(filename); err != nil {
+			return echo.NewHTTPError(http.StatusNotFound, "report file not found")
+		}
+		// html reports open in the browser, the other formats are downloaded
+		if strings.HasSuffix(run.Filename, "."+app.ReportFormatHtml) && c.QueryParam("view") == "1" {
+			return c.File(filename)
+		}
+		return c.Attachment(filename, run.Filename)
+	})
+
+	webserver.GET("/admin/reports/runs/delete", func(c echo.Context) error {
+		var runs []models.ReportRun
+		common.Must(app.GDB().Where("id IN ?", strings.Split(c.QueryParam("ids"), ",")).Find(&runs).Error)
+		for _, run := range runs {
+			common.Must(app.GApp().DeleteReportRun(run))
+		}
+		webserver.PubOpLog(c, fmt.Sprintf("Delete report runs：%s", c.QueryParam("ids")))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+func checkReport(form *models.Report) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	switch form.ReportType {
+	case app.ReportSyslog, app.ReportTopHosts:
+	case app.ReportRadiusUsage:
+		form.SearchID = 0
+	default:
+		return fmt.Errorf("unknown report type %s", form.ReportType)
+	}
+	if form.Format == "" {
+		form.Format = app.ReportFormatHtml
+	}
+	if !app.ValidReportFormat(form.Format) {
+		return fmt.Errorf("unsupported report format %s", form.Format)
+	}
+	if err := app.ValidateSchedule(form.Schedule); err != nil {
+		return fmt.Errorf("bad schedule %s: %s", form.Schedule, err.Error())
+	}
+	if form.TimeRange == "" {
+		form.TimeRange = "24h"
+	}
+	if _, err := app.ParseSearchRange(form.TimeRange); err != nil {
+		return err
+	}
+	if form.SearchID != 0 {
+		if _, err := app.GApp().GetSyslogSearch(form.SearchID); err != nil {
+			return fmt.Errorf("saved search %d not found", form.SearchID)
+		}
+	}
+	if form.TopN < 0 || form.KeepRuns < 0 {
+		return fmt.Errorf("rows and kept runs must not be negative")
+	}
+	return nil
+}
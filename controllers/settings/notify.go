@@ -0,0 +1,150 @@
+package settings
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// 通知渠道
+
+func initNotifyRouter() {
+
+	webserver.GET("/admin/settings/notify", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "notify_channels", nil)
+	})
+
+	webserver.GET("/admin/settings/notify/query", func(c echo.Context) error {
+		var data []models.NotifyChannel
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("name asc").
+			KeyFields("name", "webhook", "receivers", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.NotifyChannel{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		// credentials are write only, an empty value keeps the stored one
+		for i := range data {
+			data[i].SmtpPwd = ""
+			data[i].Secret = ""
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Channel options for the report form
+	webserver.GET("/admin/settings/notify/options", func(c echo.Context) error {
+		var data []models.NotifyChannel
+		common.Must(app.GDB().Order("name asc").Find(&data).Error)
+		var options = []web.JsonOptions{{Id: "0", Value: "不通知"}}
+		for _, item := range data {
+			options = append(options, web.JsonOptions{Id: fmt.Sprint(item.ID), Value: item.Name})
+		}
+		return c.JSON(http.StatusOK, options)
+	})
+
+	webserver.POST("/admin/settings/notify/add", func(c echo.Context) error {
+		form := new(models.NotifyChannel)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkNotifyChannel(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadNotifyChannels()
+		webserver.PubOpLog(c, fmt.Sprintf("Create notify channel：%s", form.Name))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/settings/notify/update", func(c echo.Context) error {
+		form := new(models.NotifyChannel)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		fillNotifyCredentials(form)
+		if err := checkNotifyChannel(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Model(&models.NotifyChannel{}).Where("id = ?", form.ID).Updates(map[string]interface{}{
+			"name":          form.Name,
+			"channel_type":  form.ChannelType,
+			"webhook":       form.Webhook,
+			"secret":        form.Secret,
+			"method":        form.Method,
+			"headers":       form.Headers,
+			"body_template": form.BodyTemplate,
+			"smtp_host":     form.SmtpHost,
+			"smtp_port":     form.SmtpPort,
+			"smtp_user":     form.SmtpUser,
+			"smtp_pwd":      form.SmtpPwd,
+			"smtp_from":     form.SmtpFrom,
+			"smtp_tls":      form.SmtpTls,
+			"receivers":     form.Receivers,
+			"levels":        form.Levels,
+			"rate_limit":    form.RateLimit,
+			"retries":       form.Retries,
+			"status":        form.Status,
+			"remark":        form.Remark,
+			"updated_at":    form.UpdatedAt,
+		}).Error)
+		app.GApp().LoadNotifyChannels()
+		webserver.PubOpLog(c, fmt.Sprintf("Update notify channel：%s", form.Name))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	// Send a test message with the posted settings, the channel does not need to be saved
+	webserver.POST("/admin/settings/notify/test", func(c echo.Context) error {
+		form := new(models.NotifyChannel)
+		common.Must(c.Bind(form))
+		fillNotifyCredentials(form)
+		if err := app.GApp().SendNotifyTest(*form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError("send test message failed: "+err.Error()))
+		}
+		return c.JSON(http.StatusOK, web.RestSucc("test message sent"))
+	})
+
+	webserver.GET("/admin/settings/notify/delete", func(c echo.Context) error {
+		ids := c.QueryParam("ids")
+		common.Must(app.GDB().Delete(models.NotifyChannel{}, strings.Split(ids, ",")).Error)
+		app.GApp().LoadNotifyChannels()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete notify channel：%s", ids))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+}
+
+// fillNotifyCredentials Keep the stored password and secret when the form leaves them empty
+func fillNotifyCredentials(form *models.NotifyChannel) {
+	if form.ID == 0 || (form.SmtpPwd != "" && form.Secret != "") {
+		return
+	}
+	var stored models.NotifyChannel
+	if app.GDB().Where("id = ?", form.ID).First(&stored).Error != nil {
+		return
+	}
+	if form.SmtpPwd == "" {
+		form.SmtpPwd = stored.SmtpPwd
+	}
+	if form.Secret == "" {
+		form.Secret = stored.Secret
+	}
+}
+
+func checkNotifyChannel(form *models.NotifyChannel) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	if form.RateLimit < 0 || form.Retries < 0 || form.Retries > 10 {
+		return fmt.Errorf("rate limit must not be negative and retries must be between 0 and 10")
+	}
+	_, err := app.NewNotifyChannel(*form)
+	return err
+}
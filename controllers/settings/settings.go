@@ -107,4 +107,5 @@ func InitRouter() {
 		return c.JSON(http.StatusOK, web.RestSucc("success"))
 	})
 
+	initNotifyRouter()
 }
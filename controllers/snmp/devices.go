@@ -0,0 +1,253 @@
+package snmp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/nakabonne/tstorage"
+	"github.com/talkincode/logsight/app"
+	"github.com/talkincode/logsight/common"
+	"github.com/talkincode/logsight/common/echarts"
+	"github.com/talkincode/logsight/common/snmp"
+	"github.com/talkincode/logsight/common/web"
+	"github.com/talkincode/logsight/common/zaplog"
+	"github.com/talkincode/logsight/common/zaplog/log"
+	"github.com/talkincode/logsight/models"
+	"github.com/talkincode/logsight/webserver"
+)
+
+// SNMP 设备, 按各自的采集间隔轮询
+
+func InitDevicesRouter() {
+
+	webserver.GET("/admin/snmp/devices", func(c echo.Context) error {
+		return c.Render(http.StatusOK, "snmp_devices", nil)
+	})
+
+	webserver.GET("/admin/snmp/devices/query", func(c echo.Context) error {
+		var data []models.SnmpDevice
+		prequery := web.NewPreQuery(c).
+			DefaultOrderBy("name asc").
+			QueryField("poll_status", "poll_status").
+			KeyFields("name", "ipaddr", "system_name", "model", "sn", "remark")
+		if err := prequery.Query(app.GDB().Model(&models.SnmpDevice{})).Find(&data).Error; err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		// communities and passwords are write only, an empty value keeps the stored one
+		for i := range data {
+			data[i].Community = ""
+			data[i].AuthPassword = ""
+			data[i].PrivPassword = ""
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.POST("/admin/snmp/devices/add", func(c echo.Context) error {
+		form := new(models.SnmpDevice)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkDevice(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		form.ID = common.UUIDint64()
+		form.CreatedAt = time.Now()
+		form.UpdatedAt = time.Now()
+		common.Must(app.GDB().Create(form).Error)
+		app.GApp().LoadSnmpDevices()
+		webserver.PubOpLog(c, fmt.Sprintf("Create snmp device：%s %s", form.Name, form.Ipaddr))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.POST("/admin/snmp/devices/update", func(c echo.Context) error {
+		form := new(models.SnmpDevice)
+		common.Must(c.Bind(form))
+		common.MustNotEmpty("name", form.Name)
+		if err := checkDevice(form); err != nil {
+			return c.JSON(http.StatusOK, web.RestError(err.Error()))
+		}
+		values := map[string]interface{}{
+			"name":           form.Name,
+			"ipaddr":         form.Ipaddr,
+			"port":           form.Port,
+			"snmp_version":   form.SnmpVersion,
+			"security_level": form.SecurityLevel,
+			"username":       form.Username,
+			"auth_protocol":  form.AuthProtocol,
+			"priv_protocol":  form.PrivProtocol,
+			"vendor_code":    form.VendorCode,
+			"poll_interval":  form.PollInterval,
+			"status":         form.Status,
+			"remark":         form.Remark,
+			"updated_at":     time.Now(),
+		}
+		for column, value := range map[string]string{
+			"community":     form.Community,
+			"auth_password": form.AuthPassword,
+			"priv_password": form.PrivPassword,
+		} {
+			if value != "" {
+				values[column] = value
+			}
+		}
+		common.Must(app.GDB().Model(&models.SnmpDevice{}).Where("id = ?", form.ID).Updates(values).Error)
+		app.GApp().LoadSnmpDevices()
+		webserver.PubOpLog(c, fmt.Sprintf("Update snmp device：%s %s", form.Name, form.Ipaddr))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	webserver.GET("/admin/snmp/devices/delete", func(c echo.Context) error {
+		ids := strings.Split(c.QueryParam("ids"), ",")
+		common.Must(app.GDB().Where("device_id in ?", ids).Delete(&models.SnmpDeviceIface{}).Error)
+		common.Must(app.GDB().Where("device_id in ?", ids).Delete(&models.SnmpDeviceSensor{}).Error)
+		common.Must(app.GDB().Where("device_id in ?", ids).Delete(&models.SnmpDeviceOptical{}).Error)
+		common.Must(app.GDB().Delete(models.SnmpDevice{}, ids).Error)
+		app.GApp().LoadSnmpDevices()
+		webserver.PubOpLog(c, fmt.Sprintf("Delete snmp device：%s", c.QueryParam("ids")))
+		return c.JSON(http.StatusOK, web.RestSucc("success"))
+	})
+
+	// Poll a device now, its status is updated when the poll is done
+	webserver.GET("/admin/snmp/devices/poll", func(c echo.Context) error {
+		var item models.SnmpDevice
+		if err := app.GDB().Where("id = ?", c.QueryParam("id")).First(&item).Error; err != nil {
+			return c.JSON(http.StatusOK, web.RestError("device not found"))
+		}
+		go func() {
+			err := app.GApp().PollSnmpDevice(item.ID)
+			if err != nil && !errors.Is(err, app.ErrSnmpPollRunning) {
+				log.Errorf("snmp device %s poll error %s", item.Name, err.Error())
+			}
+		}()
+		webserver.PubOpLog(c, fmt.Sprintf("Poll snmp device：%s %s", item.Name, item.Ipaddr))
+		return c.JSON(http.StatusOK, web.RestSucc("the device is being polled"))
+	})
+
+	webserver.GET("/admin/snmp/devices/ifaces", func(c echo.Context) error {
+		var data []models.SnmpDeviceIface
+		err := app.GDB().Where("device_id = ?", c.QueryParam("id")).
+			Order("if_index").Find(&data).Error
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.GET("/admin/snmp/devices/sensors", func(c echo.Context) error {
+		var data []models.SnmpDeviceSensor
+		err := app.GDB().Where("device_id = ?", c.QueryParam("id")).
+			Order("kind, name").Find(&data).Error
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	webserver.GET("/admin/snmp/devices/opticals", func(c echo.Context) error {
+		var data []models.SnmpDeviceOptical
+		err := app.GDB().Where("device_id = ?", c.QueryParam("id")).
+			Order("name").Find(&data).Error
+		if err != nil {
+			return c.JSON(http.StatusOK, common.EmptyList)
+		}
+		return c.JSON(http.StatusOK, data)
+	})
+
+	// Traffic of an interface in bits per second, from the time series storage
+	webserver.GET("/admin/snmp/devices/traffic", func(c echo.Context) error {
+		var hours int
+		web.NewParamReader(c).ReadInt(&hours, "hours", 24)
+		if hours <= 0 || hours > 720 {
+			hours = 24
+		}
+		labels := []tstorage.Label{{Name: "device", Value: c.QueryParam("id")}, {Name: "ifindex", Value: c.QueryParam("ifindex")}}
+		end := time.Now()
+		start := end.Add(-time.Duration(hours) * time.Hour)
+		series := make([]*echarts.SeriesObject, 0, 2)
+		for _, metric := range []struct{ name, title string }{
+			{app.SnmpIfInBpsMetric, "入流量"},
+			{app.SnmpIfOutBpsMetric, "出流量"},
+		} {
+			points, err := zaplog.TSDB().Select(metric.name, labels, start.Unix(), end.Unix())
+			if err != nil && !errors.Is(err, tstorage.ErrNoDataPoints) {
+				return c.JSON(http.StatusOK, common.EmptyList)
+			}
+			so := echarts.NewSeriesObject("line")
+			so.SetAttr("name", metric.title)
+			so.SetAttr("showSymbol", false)
+			so.SetAttr("smooth", true)
+			if len(points) == 0 {
+				so.SetAttr("data", []interface{}{})
+			} else {
+				tsdata := echarts.NewTimeValues()
+				for _, p := range points {
+					tsdata.AddData(p.Timestamp*1000, p.Value)
+				}
+				so.SetAttr("data", tsdata)
+			}
+			series = append(series, so)
+		}
+		return c.JSON(http.StatusOK, echarts.Series(series...))
+	})
+}
+
+func checkDevice(form *models.SnmpDevice) error {
+	if common.IsEmptyOrNA(form.Status) {
+		form.Status = common.ENABLED
+	}
+	if form.Port <= 0 {
+		form.Port = 161
+	}
+	if form.Port > 65535 {
+		return fmt.Errorf("invalid snmp port %d", form.Port)
+	}
+	form.PollInterval = app.SnmpPollInterval(form.PollInterval)
+	if err := checkDeviceAuth(form); err != nil {
+		return err
+	}
+	ip := net.ParseIP(strings.TrimSpace(form.Ipaddr))
+	if ip == nil {
+		return fmt.Errorf("invalid device address %s", form.Ipaddr)
+	}
+	form.Ipaddr = ip.String()
+	var count int64
+	app.GDB().Model(&models.SnmpDevice{}).Where("ipaddr = ? AND id <> ?", form.Ipaddr, form.ID).Count(&count)
+	if count > 0 {
+		return fmt.Errorf("device %s already exists", form.Ipaddr)
+	}
+	return nil
+}
+
+// checkDeviceAuth Check the credentials of the SNMP version, empty secrets of
+// an existing device are the stored ones
+func checkDeviceAuth(form *models.SnmpDevice) error {
+	device := *form
+	var stored models.SnmpDevice
+	if form.ID != 0 && app.GDB().Where("id = ?", form.ID).First(&stored).Error == nil {
+		if device.Community == "" {
+			device.Community = stored.Community
+		}
+		if device.AuthPassword == "" {
+			device.AuthPassword = stored.AuthPassword
+		}
+		if device.PrivPassword == "" {
+			device.PrivPassword = stored.PrivPassword
+		}
+	}
+	switch form.SnmpVersion {
+	case snmp.VersionV3:
+		_, _, err := app.SnmpDeviceAuth(&device).UsmParameters()
+		return err
+	case snmp.VersionV2c, "":
+		form.SnmpVersion = snmp.VersionV2c
+		if device.Community == "" {
+			return fmt.Errorf("community is required")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported snmp version %s", form.SnmpVersion)
+}
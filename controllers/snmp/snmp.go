@@ -0,0 +1,7 @@
+package snmp
+
+func InitRouter() {
+
+	InitDevicesRouter()
+
+}
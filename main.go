@@ -133,6 +133,26 @@ func main() {
 		syslogd := app.NewSyslogServer()
 		return syslogd.StartSyslogServer()
 	})
+	// a listener that fails, e.g. a privileged port without root, is reported
+	// right away instead of when the web server exits
+	if _config.Radiusd.Enabled {
+		g.Go(func() error {
+			err := app.GApp().StartRadiusServer()
+			if err != nil {
+				log.Errorf("radius server error %s", err.Error())
+			}
+			return err
+		})
+	}
+	if _config.Snmp.TrapEnabled {
+		g.Go(func() error {
+			err := app.GApp().StartSnmpTrapServer()
+			if err != nil {
+				log.Errorf("snmp trap server error %s", err.Error())
+			}
+			return err
+		})
+	}
 	g.Go(func() error {
 		webserver.Init()
 		controllers.Init()
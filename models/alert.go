@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// AlertRule An alert rule evaluated on incoming syslog messages, see common/alerting
+type AlertRule struct {
+	ID        int64     `json:"id,string" form:"id"`
+	Name      string    `json:"name" form:"name"`
+	RuleType  string    `json:"rule_type" form:"rule_type"`        // keyword, regex, severity, threshold or search
+	Level     string    `json:"level" form:"level"`                // info, warning or critical
+	Hostname  string    `json:"hostname" form:"hostname"`          // glob, empty matches all
+	Appname   string    `json:"appname" form:"appname"`            // glob, empty matches all
+	Pattern   string    `json:"pattern" form:"pattern"`            // the search query of search rules
+	SearchID  int64     `json:"search_id,string" form:"search_id"` // saved search narrowing the rule, 0 for none
+	Severity  int       `json:"severity" form:"severity"`          // -1 matches any severity
+	GroupBy   string    `json:"group_by" form:"group_by"`          // host, app or host_app
+	Threshold int       `json:"threshold" form:"threshold"`
+	Window    int       `json:"window" form:"window"` // seconds
+	Dedup     int       `json:"dedup" form:"dedup"`   // seconds
+	Status    string    `json:"status" form:"status"`
+	Remark    string    `json:"remark" form:"remark"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AlertSilence Suppresses alerts during a maintenance window
+type AlertSilence struct {
+	ID        int64     `json:"id,string" form:"id"`
+	RuleID    int64     `json:"rule_id,string" form:"rule_id"` // 0 silences all rules
+	Hostname  string    `json:"hostname" form:"hostname"`      // glob, empty matches all
+	StartTime time.Time `json:"start_time" form:"-"`
+	EndTime   time.Time `gorm:"index" json:"end_time" form:"-"`
+	Remark    string    `json:"remark" form:"remark"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AlertHistory A fired alert
+type AlertHistory struct {
+	ID       int64     `json:"id,string"`
+	RuleID   int64     `gorm:"index" json:"rule_id,string"`
+	RuleName string    `json:"rule_name"`
+	RuleType string    `json:"rule_type"`
+	Level    string    `json:"level"`
+	GroupKey string    `json:"group_key"`
+	Hostname string    `gorm:"index" json:"hostname"`
+	Appname  string    `json:"appname"`
+	Count    int       `json:"count"`
+	Message  string    `json:"message"`
+	FiredAt  time.Time `gorm:"index" json:"fired_at"`
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// StructuredData RFC 5424 structured data, SD-ID => PARAM-NAME => PARAM-VALUE
+type StructuredData map[string]map[string]string
+
+func (d StructuredData) GormDataType() string {
+	return "jsonb"
+}
+
+func (d StructuredData) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+	bs, err := json.Marshal(d)
+	return string(bs), err
+}
+
+func (d *StructuredData) Scan(value interface{}) error {
+	var bs []byte
+	switch v := value.(type) {
+	case nil:
+		*d = nil
+		return nil
+	case []byte:
+		bs = v
+	case string:
+		bs = []byte(v)
+	default:
+		return fmt.Errorf("unsupported structured data type %T", value)
+	}
+	return json.Unmarshal(bs, d)
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+)
+
+// NotifyChannel A notification channel for alerts and system events, see common/notify
+type NotifyChannel struct {
+	ID           int64     `json:"id,string" form:"id"`
+	Name         string    `json:"name" form:"name"`
+	ChannelType  string    `json:"channel_type" form:"channel_type"` // email, webhook, dingtalk, wecom, feishu or slack
+	Webhook      string    `json:"webhook" form:"webhook"`
+	Secret       string    `json:"secret" form:"secret"` // signing secret of dingtalk and feishu
+	Method       string    `json:"method" form:"method"`
+	Headers      string    `json:"headers" form:"headers"` // one "Name: value" per line
+	BodyTemplate string    `json:"body_template" form:"body_template"`
+	SmtpHost     string    `json:"smtp_host" form:"smtp_host"`
+	SmtpPort     int       `json:"smtp_port" form:"smtp_port"`
+	SmtpUser     string    `json:"smtp_user" form:"smtp_user"`
+	SmtpPwd      string    `json:"smtp_pwd" form:"smtp_pwd"`
+	SmtpFrom     string    `json:"smtp_from" form:"smtp_from"`
+	SmtpTls      int       `json:"smtp_tls" form:"smtp_tls"`     // 1 for implicit TLS
+	Receivers    string    `json:"receivers" form:"receivers"`   // comma separated email addresses
+	Levels       string    `json:"levels" form:"levels"`         // comma separated, empty receives all levels
+	RateLimit    int       `json:"rate_limit" form:"rate_limit"` // messages per minute, 0 is unlimited
+	Retries      int       `json:"retries" form:"retries"`
+	Status       string    `json:"status" form:"status"`
+	Remark       string    `json:"remark" form:"remark"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
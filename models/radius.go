@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+)
+
+// RadiusNas A NAS device allowed to send accounting to the built-in RADIUS server
+type RadiusNas struct {
+	ID         int64     `json:"id,string" form:"id"`
+	Name       string    `json:"name" form:"name"`
+	Ipaddr     string    `json:"ipaddr" form:"ipaddr" gorm:"uniqueIndex"` // source address of the accounting requests
+	Identifier string    `json:"identifier" form:"identifier"`            // NAS-Identifier, informational
+	Secret     string    `json:"secret" form:"secret"`
+	VendorCode string    `json:"vendor_code" form:"vendor_code"`
+	Status     string    `json:"status" form:"status"`
+	Remark     string    `json:"remark" form:"remark"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// Rollups of ts_radius_accounting kept by the analytics job, sessions are
+// counted in the day or hour they started, traffic and online time in the
+// day or hour the NAS reported them
+
+// RadiusUserDaily Traffic and online time of a user per day
+type RadiusUserDaily struct {
+	Day         time.Time `json:"day" gorm:"primaryKey"`
+	Username    string    `json:"username" gorm:"primaryKey"`
+	Sessions    int64     `json:"sessions"`
+	SessionTime int64     `json:"session_time"` // seconds
+	InputTotal  int64     `json:"input_total,string"`
+	OutputTotal int64     `json:"output_total,string"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RadiusNasHourly Sessions, users and traffic of a NAS per hour
+type RadiusNasHourly struct {
+	Hour        time.Time `json:"hour" gorm:"primaryKey"`
+	NasAddr     string    `json:"nas_addr" gorm:"primaryKey"`
+	Sessions    int64     `json:"sessions"`
+	Users       int64     `json:"users"`
+	InputTotal  int64     `json:"input_total,string"`
+	OutputTotal int64     `json:"output_total,string"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// RadiusSessionLength Stopped sessions per day by session length bucket
+type RadiusSessionLength struct {
+	Day       time.Time `json:"day" gorm:"primaryKey"`
+	Bucket    int       `json:"bucket" gorm:"primaryKey"` // index of the bucket, see app.RadiusLengthBuckets
+	Sessions  int64     `json:"sessions"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RadiusConcurrency Online sessions of a NAS sampled every 10 minutes
+type RadiusConcurrency struct {
+	Time      time.Time `json:"time" gorm:"primaryKey"`
+	NasAddr   string    `json:"nas_addr" gorm:"primaryKey"`
+	Sessions  int64     `json:"sessions"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+)
+
+// Report A scheduled report, each run writes a file to the reports directory, see app/report.go
+type Report struct {
+	ID         int64     `json:"id,string" form:"id"`
+	Name       string    `json:"name" form:"name"`
+	ReportType string    `json:"report_type" form:"report_type"`      // syslog, syslog_top_hosts or radius_usage
+	SearchID   int64     `json:"search_id,string" form:"search_id"`   // saved search of syslog reports, 0 for none
+	TimeRange  string    `json:"time_range" form:"time_range"`        // period before each run such as 24h or 7d, a saved search uses its own
+	Format     string    `json:"format" form:"format"`                // csv, xlsx or html
+	Schedule   string    `json:"schedule" form:"schedule"`            // cron expression
+	TopN       int       `json:"top_n" form:"top_n"`                  // rows of ranking reports
+	ChannelID  int64     `json:"channel_id,string" form:"channel_id"` // notify channel told about each run, 0 for none
+	KeepRuns   int       `json:"keep_runs" form:"keep_runs"`          // runs kept with their files
+	Status     string    `json:"status" form:"status"`
+	Remark     string    `json:"remark" form:"remark"`
+	LastRunAt  time.Time `json:"last_run_at" form:"-"`
+	NextRunAt  time.Time `json:"next_run_at" form:"-" gorm:"-"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// ReportRun A report run and its file
+type ReportRun struct {
+	ID         int64     `json:"id,string"`
+	ReportID   int64     `gorm:"index" json:"report_id,string"`
+	ReportName string    `json:"report_name"`
+	Trigger    string    `json:"trigger"`    // schedule or manual
+	Status     string    `json:"status"`     // success or failure
+	StartTime  time.Time `json:"start_time"` // the period covered
+	EndTime    time.Time `json:"end_time"`
+	Filename   string    `json:"filename"` // in the reports directory
+	Size       int64     `json:"size"`
+	Rows       int64     `json:"rows"`
+	Message    string    `json:"message"`
+	Duration   int64     `json:"duration"` // milliseconds
+	CreatedAt  time.Time `gorm:"index" json:"created_at"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+)
+
+// SyslogSearch A saved syslog search, private searches are only visible to their owner.
+// Token is the short code of the permalink that restores the search in the syslog view
+type SyslogSearch struct {
+	ID         int64     `json:"id,string" form:"id"`
+	OprID      int64     `gorm:"index" json:"opr_id,string" form:"-"`
+	OprName    string    `json:"opr_name" form:"-"`
+	Name       string    `json:"name" form:"name"`
+	Visibility string    `json:"visibility" form:"visibility"` // private or shared
+	Hostname   string    `json:"hostname" form:"hostname"`
+	Keyword    string    `json:"keyword" form:"keyword"`       // search query, see common/fts
+	Severity   int       `json:"severity" form:"severity"`     // this level or more severe, -1 matches all
+	TimeRange  string    `json:"time_range" form:"time_range"` // relative range such as 1h or 7d, custom uses StartTime and EndTime
+	StartTime  time.Time `json:"start_time" form:"-"`
+	EndTime    time.Time `json:"end_time" form:"-"`
+	Token      string    `gorm:"uniqueIndex" json:"token" form:"-"`
+	Remark     string    `json:"remark" form:"remark"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
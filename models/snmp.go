@@ -0,0 +1,78 @@
+package models
+
+import (
+	"time"
+)
+
+// SnmpDevice A network device polled over SNMP, with the result of its last poll
+type SnmpDevice struct {
+	ID            int64     `json:"id,string" form:"id"`
+	Name          string    `json:"name" form:"name"`
+	Ipaddr        string    `json:"ipaddr" form:"ipaddr" gorm:"uniqueIndex"`
+	Port          int       `json:"port" form:"port"`
+	Community     string    `json:"community" form:"community"`
+	SnmpVersion   string    `json:"snmp_version" form:"snmp_version"`     // v2c or v3
+	SecurityLevel string    `json:"security_level" form:"security_level"` // v3 USM credentials from here
+	Username      string    `json:"username" form:"username"`
+	AuthProtocol  string    `json:"auth_protocol" form:"auth_protocol"`
+	AuthPassword  string    `json:"auth_password" form:"auth_password"`
+	PrivProtocol  string    `json:"priv_protocol" form:"priv_protocol"`
+	PrivPassword  string    `json:"priv_password" form:"priv_password"`
+	VendorCode    string    `json:"vendor_code" form:"vendor_code"`     // 0 to detect it from sysObjectID
+	PollInterval  int       `json:"poll_interval" form:"poll_interval"` // seconds
+	Status        string    `json:"status" form:"status"`
+	Remark        string    `json:"remark" form:"remark"`
+	VendorName    string    `json:"vendor_name"` // of the device template used by the last poll
+	SystemName    string    `json:"system_name"`
+	Model         string    `json:"model"`
+	Sn            string    `json:"sn"`
+	Uptime        int64     `json:"uptime"` // hundredths of a second
+	CpuLoad       float64   `json:"cpu_load"`
+	MemPercent    float64   `json:"mem_percent"`
+	DiskPercent   float64   `json:"disk_percent"`
+	Temperature   float64   `json:"temperature"`  // highest in degrees Celsius, 0 when unknown
+	FanStatus     string    `json:"fan_status"`   // normal or fault, empty when unknown
+	PowerStatus   string    `json:"power_status"` // normal or fault, empty when unknown
+	IfaceCount    int       `json:"iface_count"`
+	PollStatus    string    `json:"poll_status"` // success or failure of the last poll
+	LastError     string    `json:"last_error"`
+	LastPollAt    time.Time `json:"last_poll_at"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// SnmpDeviceIface The octet counters of a device interface at the last poll
+// and its traffic since the poll before
+type SnmpDeviceIface struct {
+	DeviceId    int64     `json:"device_id,string" gorm:"primaryKey;autoIncrement:false"`
+	IfIndex     int64     `json:"if_index" gorm:"primaryKey;autoIncrement:false"`
+	Name        string    `json:"name"`
+	IfType      int64     `json:"if_type"`
+	InOctets    int64     `json:"in_octets,string"`
+	OutOctets   int64     `json:"out_octets,string"`
+	CounterBits int       `json:"counter_bits"` // 64 for the ifHC counters
+	InBps       float64   `json:"in_bps"`
+	OutBps      float64   `json:"out_bps"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SnmpDeviceSensor A temperature, fan or power supply of a device at the last poll
+type SnmpDeviceSensor struct {
+	DeviceId  int64     `json:"device_id,string" gorm:"primaryKey;autoIncrement:false"`
+	Kind      string    `json:"kind" gorm:"primaryKey"` // temperature, fan or power
+	Index     string    `json:"index" gorm:"primaryKey"`
+	Name      string    `json:"name"`
+	Value     float64   `json:"value"`  // degrees Celsius or fan rpm, 0 when only the state is known
+	Status    string    `json:"status"` // normal or fault, empty when only the value is known
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SnmpDeviceOptical The optical power of a transceiver at the last poll
+type SnmpDeviceOptical struct {
+	DeviceId  int64     `json:"device_id,string" gorm:"primaryKey;autoIncrement:false"`
+	Index     string    `json:"index" gorm:"primaryKey"`
+	Name      string    `json:"name"`
+	RxPower   float64   `json:"rx_power"` // dBm
+	TxPower   float64   `json:"tx_power"` // dBm
+	UpdatedAt time.Time `json:"updated_at"`
+}
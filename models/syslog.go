@@ -0,0 +1,52 @@
+package models
+
+import (
+	"time"
+)
+
+// SyslogClockPolicy Decides which time is authoritative for the messages of a source
+type SyslogClockPolicy struct {
+	ID        int64     `json:"id,string" form:"id"`
+	Source    string    `gorm:"index" json:"source" form:"source"` // source ip, cidr or hostname
+	Policy    string    `json:"policy" form:"policy"`              // device, receive or auto
+	MaxSkew   int       `json:"max_skew" form:"max_skew"`          // seconds, used by auto
+	Remark    string    `json:"remark" form:"remark"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// SyslogRule A parsing rule applied to incoming syslog messages, see common/logparse
+type SyslogRule struct {
+	ID          int64     `json:"id,string" form:"id"`
+	Sort        int       `json:"sort" form:"sort"`
+	Name        string    `json:"name" form:"name"`
+	Hostname    string    `json:"hostname" form:"hostname"`         // glob, empty matches all
+	Appname     string    `json:"appname" form:"appname"`           // glob, empty matches all
+	PatternType string    `json:"pattern_type" form:"pattern_type"` // regex or grok
+	Pattern     string    `json:"pattern" form:"pattern"`
+	Action      string    `json:"action" form:"action"`     // extract or drop
+	Severity    int       `json:"severity" form:"severity"` // -1 keeps the original severity
+	Tags        string    `json:"tags" form:"tags"`         // comma separated
+	Namespace   string    `json:"namespace" form:"namespace"`
+	Status      string    `json:"status" form:"status"`
+	Remark      string    `json:"remark" form:"remark"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// SyslogRetention How long the syslog records of a source are kept, the first matching rule by sort wins
+type SyslogRetention struct {
+	ID          int64     `json:"id,string" form:"id"`
+	Sort        int       `json:"sort" form:"sort"`
+	Name        string    `json:"name" form:"name"`
+	Hostname    string    `json:"hostname" form:"hostname"` // glob, empty matches all
+	Appname     string    `json:"appname" form:"appname"`   // glob, empty matches all
+	Severity    int       `json:"severity" form:"severity"` // matches this severity and less severe, -1 matches all
+	Days        int       `json:"days" form:"days"`         // 0 keeps records forever
+	Status      string    `json:"status" form:"status"`
+	Remark      string    `json:"remark" form:"remark"`
+	LastRunAt   time.Time `json:"last_run_at"`
+	LastDeleted int64     `json:"last_deleted"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
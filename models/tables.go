@@ -5,5 +5,24 @@ var Tables = []interface{}{
 	&SysOpr{},
 	&SysOprLog{},
 	&TsRadiusAccounting{},
+	&RadiusNas{},
+	&RadiusUserDaily{},
+	&RadiusNasHourly{},
+	&RadiusSessionLength{},
+	&RadiusConcurrency{},
+	&SnmpDevice{},
+	&SnmpDeviceIface{},
+	&SnmpDeviceSensor{},
+	&SnmpDeviceOptical{},
 	&TsSyslog{},
+	&SyslogClockPolicy{},
+	&SyslogRule{},
+	&SyslogRetention{},
+	&SyslogSearch{},
+	&AlertRule{},
+	&AlertSilence{},
+	&AlertHistory{},
+	&NotifyChannel{},
+	&Report{},
+	&ReportRun{},
 }
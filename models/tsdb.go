@@ -6,14 +6,14 @@ import (
 
 type TsRadiusAccounting struct {
 	ID                string    `json:"id" gorm:"primaryKey"` // 主键 ID
-	Username          string    `json:"username" gorm:"primaryKey"`
-	AcctSessionId     string    `json:"acct_session_id" gorm:"primaryKey"`
-	AcctStartTime     time.Time `json:"acct_start_time" gorm:"primaryKey"`
+	Username          string    `json:"username" gorm:"primaryKey;index:idx_ts_radius_accounting_session,priority:2"`
+	AcctSessionId     string    `json:"acct_session_id" gorm:"primaryKey;index:idx_ts_radius_accounting_session,priority:1"`
+	AcctStartTime     time.Time `json:"acct_start_time" gorm:"primaryKey;index:idx_ts_radius_accounting_ip,priority:2"`
 	NasId             string    `json:"nas_id"`
-	NasAddr           string    `json:"nas_addr"`
+	NasAddr           string    `json:"nas_addr" gorm:"index:idx_ts_radius_accounting_session,priority:3"`
 	NasPaddr          string    `json:"nas_paddr"`
 	SessionTimeout    int       `json:"session_timeout"`
-	FramedIpaddr      string    `json:"framed_ipaddr"`
+	FramedIpaddr      string    `json:"framed_ipaddr" gorm:"index:idx_ts_radius_accounting_ip,priority:1"`
 	FramedNetmask     string    `json:"framed_netmask"`
 	MacAddr           string    `json:"mac_addr"`
 	NasPort           int64     `json:"nas_port,string"`
@@ -26,24 +26,37 @@ type TsRadiusAccounting struct {
 	AcctOutputTotal   int64     `json:"acct_output_total,string"`
 	AcctInputPackets  int       `json:"acct_input_packets"`
 	AcctOutputPackets int       `json:"acct_output_packets"`
-	AcctStopTime      time.Time `json:"acct_stop_time"`
-	LastUpdate        time.Time `json:"last_update"`
+	AcctStopTime      time.Time `json:"acct_stop_time"` // zero while the session is online
+	// AcctInterimInterval Seconds between interim updates, 0 when the NAS does not announce it
+	AcctInterimInterval int       `json:"acct_interim_interval"`
+	AcctTerminateCause  int       `json:"acct_terminate_cause"`
+	LastUpdate          time.Time `json:"last_update" gorm:"index"` // when the row last changed
+	// The counters already added to the analytics rollups, see app.RollupRadiusAccounting
+	RollupSessionTime int   `json:"-"`
+	RollupInputTotal  int64 `json:"-"`
+	RollupOutputTotal int64 `json:"-"`
+	RollupState       int   `json:"-"` // 0 not rolled up, 1 the start is counted, 2 the stop too
 }
 
 type TsSyslog struct {
-	ID              string    `json:"id" gorm:"primaryKey"`
-	Timestamp       time.Time `json:"timestamp" gorm:"primaryKey"`
-	Logtype         string    `json:"logtype"`
-	MsgID           string    `json:"msg_id,omitempty"`
-	ProcID          string    `json:"proc_id,omitempty"`
-	Appname         string    `json:"appname,omitempty"`
-	Hostname        string    `json:"hostname,omitempty"`
-	Priority        int64     `json:"priority,omitempty"`
-	Facility        int64     `json:"facility,omitempty"`
-	FacilityMessage string    `json:"facility_message,omitempty"`
-	Severity        int64     `json:"severity,omitempty"`
-	SeverityMessage string    `json:"severity_message,omitempty"`
-	Version         int64     `json:"version,omitempty"`
-	Message         string    `json:"message"`
-	Tags            string    `json:"tags,omitempty"`
+	ID              string         `json:"id" gorm:"primaryKey"`
+	Timestamp       time.Time      `json:"timestamp" gorm:"primaryKey"` // authoritative time, see SyslogClockPolicy
+	EventTime       time.Time      `json:"event_time"`                  // time reported by the sender
+	ReceivedAt      time.Time      `json:"received_at"`
+	SourceIp        string         `json:"source_ip"`
+	SourcePort      int            `json:"source_port"`
+	Logtype         string         `json:"logtype"`
+	MsgID           string         `json:"msg_id,omitempty"`
+	ProcID          string         `json:"proc_id,omitempty"`
+	Appname         string         `json:"appname,omitempty"`
+	Hostname        string         `json:"hostname,omitempty"`
+	Priority        int64          `json:"priority,omitempty"`
+	Facility        int64          `json:"facility,omitempty"`
+	FacilityMessage string         `json:"facility_message,omitempty"`
+	Severity        int64          `json:"severity,omitempty"`
+	SeverityMessage string         `json:"severity_message,omitempty"`
+	Version         int64          `json:"version,omitempty"`
+	Message         string         `json:"message"`
+	StructuredData  StructuredData `json:"structured_data,omitempty" gorm:"type:jsonb;index:idx_ts_syslog_sd,type:gin"`
+	Tags            string         `json:"tags,omitempty"`
 }
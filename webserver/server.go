@@ -23,6 +23,7 @@ import (
 	"github.com/talkincode/logsight/assets"
 	"github.com/talkincode/logsight/common"
 	"github.com/talkincode/logsight/common/excel"
+	"github.com/talkincode/logsight/common/export"
 	"github.com/talkincode/logsight/common/tpl"
 	"github.com/talkincode/logsight/common/web"
 	"github.com/talkincode/logsight/common/zaplog/log"
@@ -34,6 +35,7 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	elog "github.com/labstack/gommon/log"
 	"github.com/pkg/errors"
+	"gorm.io/gorm"
 )
 
 const UserSession = "logsight_user_session"
@@ -85,7 +87,10 @@ func NewAdminServer() *AdminServer {
 	s.root.Pre(middleware.RemoveTrailingSlash())
 	s.root.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Skipper: func(c echo.Context) bool {
-			return strings.HasPrefix(c.Path(), "/metrics")
+			// streaming responses must reach the client unbuffered
+			return strings.HasPrefix(c.Path(), "/metrics") ||
+				strings.HasPrefix(c.Path(), "/admin/syslog/tail") ||
+				strings.HasSuffix(c.Path(), "/export")
 		},
 		Level: 1,
 	}))
@@ -368,6 +373,7 @@ func ExportData(c echo.Context, data []map[string]interface{}, sheet string) err
 	if err != nil {
 		return err
 	}
+	defer os.Remove(filepath)
 	c.Response().Header().Set("Content-Disposition", fmt.Sprintf("attachment;filename=%s.xlsx", sheet))
 	return c.File(filepath)
 }
@@ -376,11 +382,12 @@ func ExportCsv(c echo.Context, v interface{}, name string) error {
 	filename := fmt.Sprintf("%s-%d.csv", name, common.UUIDint64())
 	filepath := path.Join(app.GConfig().GetDataDir(), filename)
 	nfs, err := os.Create(filepath)
-	defer nfs.Close()
 	if err != nil {
 		return err
 	}
+	defer os.Remove(filepath)
 	err = gocsv.Marshal(v, nfs)
+	nfs.Close()
 	if err != nil {
 		return err
 	}
@@ -388,6 +395,59 @@ func ExportCsv(c echo.Context, v interface{}, name string) error {
 	return c.File(filepath)
 }
 
+// ExportStream Write the rows of query to the response in the format of the
+// format param (csv, ndjson or xlsx). Rows are read from a database cursor and
+// written as they arrive, so the export size is not limited by memory
+func ExportStream[T any](c echo.Context, name string, query *gorm.DB, columns []string, values func(item *T) []interface{}) error {
+	format := c.QueryParam("format")
+	if format == "" {
+		format = export.FormatCsv
+	}
+	if !export.Supported(format) {
+		return echo.NewHTTPError(http.StatusBadRequest, "unsupported export format "+format)
+	}
+	rows, err := query.Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, export.ContentType(format))
+	resp.Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment;filename=%s", export.Filename(name, format, time.Now())))
+	resp.WriteHeader(http.StatusOK)
+
+	w, err := export.New(format, resp, columns)
+	var count int
+	for err == nil && rows.Next() {
+		item := new(T)
+		if err = query.ScanRows(rows, item); err != nil {
+			break
+		}
+		if err = w.WriteRow(values(item)); err != nil {
+			break
+		}
+		count++
+		if count%1000 == 0 {
+			resp.Flush()
+		}
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	if err != nil {
+		// the response is already sent, leave the file unfinished so the
+		// client does not take a partial export for a complete one
+		log.Errorf("export %s after %d rows error %s", name, count, err.Error())
+		return nil
+	}
+	if err = w.Close(); err != nil {
+		log.Errorf("export %s error %s", name, err.Error())
+	}
+	return nil
+}
+
 func ExportJson(c echo.Context, v interface{}, name string) error {
 	bs, err := json.Marshal(v)
 	if err != nil {